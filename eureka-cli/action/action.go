@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"path/filepath"
 	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/spf13/viper"
@@ -29,6 +31,8 @@ type Action struct {
 	ConfigLspURL                       string
 	ConfigFarURL                       string
 	ConfigRegistryURL                  string
+	ConfigRegistrySnapshot             string
+	RegistrySnapshotDir                string
 	ConfigPortStart                    int
 	ConfigPortEnd                      int
 	ConfigManagementTopicSharing       bool
@@ -73,6 +77,7 @@ func New(name string, gatewayURL string, actionParam *Param) *Action {
 		ConfigLspURL:                       viper.GetString(field.LspURL),
 		ConfigFarURL:                       viper.GetString(field.FarURL),
 		ConfigRegistryURL:                  viper.GetString(field.RegistryURL),
+		ConfigRegistrySnapshot:             viper.GetString(field.RegistrySnapshot),
 		ConfigManagementTopicSharing:       viper.GetBool(field.BackendModulesManagementTopicSharing),
 		ConfigTopicSharingTenant:           viper.GetString(field.EnvTopicSharingTenant),
 		ConfigApplication:                  viper.GetStringMap(field.Application),
@@ -115,6 +120,38 @@ func (a *Action) IsChildApp() bool {
 	return len(a.ConfigApplicationDependencies) > 0
 }
 
+// ==================== Dry Run ====================
+
+func (a *Action) IsDryRun() bool {
+	return a.Param != nil && a.Param.DryRun
+}
+
+// ==================== Lock ====================
+
+// IsLocked reports whether the module versions and image digests are read from the profile lock file
+func (a *Action) IsLocked() bool {
+	return a.Param != nil && a.Param.Locked
+}
+
+// ==================== Registry Snapshot ====================
+
+// IsOffline reports whether the LSP, FAR and module descriptors are read from a registry snapshot
+func (a *Action) IsOffline() bool {
+	return a.ConfigRegistrySnapshot != "" || a.Param != nil && a.Param.Offline
+}
+
+func (a *Action) GetSnapshotPlatformDescriptorPath() string {
+	return filepath.Join(a.RegistrySnapshotDir, constant.RegistrySnapshotPlatformFile)
+}
+
+func (a *Action) GetSnapshotApplicationPath(appID string) string {
+	return filepath.Join(a.RegistrySnapshotDir, constant.RegistrySnapshotFarDir, appID+".json")
+}
+
+func (a *Action) GetSnapshotModuleDescriptorPath(moduleID string) string {
+	return filepath.Join(a.RegistrySnapshotDir, constant.RegistrySnapshotModulesDir, moduleID+".json")
+}
+
 // ==================== Environment ====================
 
 func GetSidecarModuleCmd() []string {
@@ -1,9 +1,11 @@
 package action
 
 const (
+	Apply                       = "Apply"
 	AttachCapabilitySets        = "Attach Capability Sets"
 	BuildAndPushUi              = "Build and push UI"
 	BuildSystem                 = "Build System"
+	Call                        = "Call"
 	CheckPorts                  = "Check Ports"
 	CreateConsortiums           = "Create Consortiums"
 	CreatePortProxy             = "Create Port Proxy"
@@ -18,21 +20,46 @@ const (
 	DeployModules               = "Deploy Modules"
 	DeploySystem                = "Deploy System"
 	DeployUi                    = "Deploy UI"
+	DescribeConsumerGroup       = "Describe Consumer Group"
 	DetachCapabilitySets        = "Detach Capability Sets"
+	ExportRealm                 = "Export Realm"
+	ExportTenantData            = "Export Tenant Data"
+	FindRoute                   = "Find Route"
 	GetEdgeApiKey               = "Get Edge Api Key"          //nolint:gosec // G101: Not a hardcoded credential, just an action name
 	GetKeycloakAccessToken      = "Get Keycloak Access Token" //nolint:gosec // G101: Not a hardcoded credential, just an action name
 	GetVaultRootToken           = "Get Vault Root Token"      //nolint:gosec // G101: Not a hardcoded credential, just an action name
+	ImportRealm                 = "Import Realm"
+	ImportTenantData            = "Import Tenant Data"
 	InterceptModule             = "Intercept Module"
 	ListModules                 = "List Modules"
+	ListPortProxies             = "List Port Proxies"
+	ListProfiles                = "List Profiles"
 	ListModuleVersions          = "List Module Versions"
+	ListRoutes                  = "List Routes"
 	ListSystem                  = "List System"
+	ListTopics                  = "List Topics"
+	Logout                      = "Logout"
+	Logs                        = "Logs"
+	MigrateConsortiumUsers      = "Migrate Consortium Users"
+	PublishMessage              = "Publish Message"
+	PullImages                  = "Pull Images"
 	PurgeTenants                = "Purge Tenants"
+	PurgeTopics                 = "Purge Topics"
 	ReindexIndices              = "Reindex Indices"
 	RemoveRoles                 = "Remove Roles"
 	RemoveTenantEntitlements    = "Remove Tenant Entitlements"
 	RemoveTenants               = "Remove Tenants"
 	RemoveUsers                 = "Remove Users"
+	RepairSystemUser            = "Repair System User"
+	ResetOffsets                = "Reset Offsets"
 	Root                        = "Root"
+	ShowConfig                  = "Show Config"
+	SnapshotList                = "Snapshot List"
+	SnapshotRegistry            = "Snapshot Registry"
+	SnapshotRestore             = "Snapshot Restore"
+	SnapshotSave                = "Snapshot Save"
+	Status                      = "Status"
+	TailTopic                   = "Tail Topic"
 	UndeployAdditionalSystem    = "Undeploy Additional System"
 	UndeployApplication         = "Undeploy Application"
 	UndeployManagement          = "Undeploy Management"
@@ -41,6 +68,12 @@ const (
 	UndeploySystem              = "Undeploy System"
 	UndeployUi                  = "Undeploy UI"
 	UpdateKeycloakPublicClients = "Update Keycloak Public Clients"
+	UpdateLock                  = "Update Lock"
 	UpdateModuleDiscovery       = "Update Module Discovery"
 	UpgradeModule               = "Upgrade Module"
+	ValidateConfig              = "Validate Config"
+	VaultDelete                 = "Vault Delete"
+	VaultGet                    = "Vault Get"
+	VaultList                   = "Vault List"
+	VaultSet                    = "Vault Set"
 )
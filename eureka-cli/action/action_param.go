@@ -8,28 +8,58 @@ type Param struct {
 	BuildImages           bool
 	Cleanup               bool
 	ConfigFile            string
+	Consortium            string
+	Data                  string
 	DefaultGateway        bool
+	Delete                bool
+	Direct                bool
+	DryRun                bool
 	EnableDebug           bool
 	EnableECSRequests     bool
+	File                  string
+	Filter                string
+	Follow                bool
+	Force                 bool
+	Foreground            bool
+	FromBeginning         bool
 	GatewayHostname       string
 	GatewayURL            string
+	Grep                  string
+	Group                 string
+	Headers               []string
 	ID                    string
+	IncludeUsers          bool
+	Key                   string
 	Length                int
+	Level                 string
+	Locked                bool
+	Method                string
 	ModuleName            string
+	ModuleNames           []string
 	ModulePath            string
 	ModuleType            string
 	ModuleURL             string
 	ModuleVersion         string
 	Namespace             string
+	Offline               bool
 	OnlyRequired          bool
+	Output                string
+	Overwrite             bool
 	OverwriteFiles        bool
+	Parallelism           int
+	Path                  string
 	PlatformCompleteURL   string
 	PrivatePort           int
 	Profile               string
 	PurgeSchemas          bool
 	RemoveApplication     bool
+	Resolved              bool
 	Restore               bool
+	Resume                bool
+	Reveal                bool
+	Sidecar               bool
 	SidecarURL            string
+	Since                 string
 	SingleTenant          bool
 	SkipApplication       bool
 	SkipModuleArtifact    bool
@@ -39,11 +69,17 @@ type Param struct {
 	SkipModuleDiscovery   bool
 	SkipRegistry          bool
 	SkipTenantEntitlement bool
+	SkipValidation        bool
 	Tenant                string
 	TenantIDs             []string
+	ToEarliest            bool
+	ToLatest              bool
 	TokenType             string
+	Topic                 string
 	UpdateCloned          bool
 	User                  string
+	Usernames             []string
+	Value                 string
 	Versions              int
 }
 
@@ -62,32 +98,65 @@ func (f Flag) GetName() string {
 // Flag definitions
 var (
 	All                   = Flag{"all", "a", "All modules for all profiles"}
+	AllModules            = Flag{"all", "a", "All modules of the profile"}
 	ApplicationNames      = Flag{"apps", "", "Application names"}
 	BuildImages           = Flag{"buildImages", "b", "Build Docker images"}
 	Cleanup               = Flag{"cleanup", "", "Perform a cleanup operation"}
 	ConfigFile            = Flag{"configFile", "c", "Use a specific config file"}
+	Consortium            = Flag{"consortium", "", "Consortium name, defaults to all consortiums of the profile, e.g. ecs"}
+	Data                  = Flag{"data", "", "Request body, either inline JSON or @ followed by a file path, e.g. @user.json"}
 	DefaultGateway        = Flag{"defaultGateway", "g", "Use default gateway in URLs, .e.g. http://host.docker.internal:{{port}} will be set automatically"}
+	Delete                = Flag{"delete", "", "Delete the identity providers and the migrated users instead of creating them"}
+	Direct                = Flag{"direct", "", "Send the request to the sidecar of the module serving the path instead of Kong"}
+	DryRun                = Flag{"dryRun", "", "Print planned container creations, HTTP calls and commands without executing them"}
 	EnableDebug           = Flag{"enableDebug", "d", "Enable debug"}
 	EnableECSRequests     = Flag{"enableEcsRequests", "", "Enable ECS requests"}
+	File                  = Flag{"file", "", "File with the JSON message payload, e.g. event.json"}
+	Filter                = Flag{"filter", "", "Show only the JSON messages matching a JSONPath filter, e.g. \"$.type == 'UPDATE'\""}
+	Follow                = Flag{"follow", "f", "Follow the log output"}
+	Force                 = Flag{"force", "", "Purge the topics even when they are shared by all tenants"}
+	Foreground            = Flag{"foreground", "", "Run the port proxy in the foreground until interrupted instead of as a background process"}
+	FromBeginning         = Flag{"fromBeginning", "", "Start with the retained messages instead of the new messages"}
 	GatewayHostname       = Flag{"gatewayHostname", "", "Gateway hostname"}
 	GatewayURL            = Flag{"gatewayURL", "", "Gateway URL"}
+	Grep                  = Flag{"grep", "", "Show only the log lines matching a regular expression"}
+	Group                 = Flag{"group", "", "Consumer group, defaults to the capability set consumer group, e.g. folio-mod-roles-keycloak-capability-group"}
+	Header                = Flag{"header", "", "Message header as name=value, repeat the flag for several headers, e.g. X-Okapi-Tenant=diku"}
 	ID                    = Flag{"id", "i", "Module id, e.g. mod-orders:13.1.0-SNAPSHOT.1021"}
+	IncludeUsers          = Flag{"includeUsers", "", "Include the users with their role mappings and groups, the credentials are not exported"}
+	Key                   = Flag{"key", "", "Secret key, e.g. diku-system-user"}
 	Length                = Flag{"length", "l", "Salt length"}
+	Level                 = Flag{"level", "", "Show only the log lines of this level or more severe, options: %s"}
+	Locked                = Flag{"locked", "", "Deploy the module versions, image digests and sidecar image pinned in the profile lock file"}
+	MessageKey            = Flag{"key", "", "Message key, messages with the same key are published to the same partition, e.g. a record id"}
+	Method                = Flag{"method", "", "HTTP method, e.g. GET"}
 	ModuleName            = Flag{"moduleName", "n", "Module name, e.g. mod-orders"}
 	ModulePath            = Flag{"modulePath", "", "Module path, e.g. the path of your module in IntelliJ"}
 	ModuleType            = Flag{"moduleType", "y", "Module type, e.g. management"}
 	ModuleURL             = Flag{"moduleUrl", "m", "Module URL, e.g. http://host.docker.internal:36002 or 36002 (if -g is used)"}
 	ModuleVersion         = Flag{"moduleVersion", "", "Module version, e.g. 13.1.0-SNAPSHOT.1093"}
 	Namespace             = Flag{"namespace", "", "DockerHub namespace"}
+	Offline               = Flag{"offline", "", "Read the LSP, FAR and module descriptors from a registry snapshot instead of the network"}
 	OnlyRequired          = Flag{"onlyRequired", "q", "Use only required system containers"}
+	Output                = Flag{"output", "", "Output format, options: %s"}
+	Overwrite             = Flag{"overwrite", "", "Overwrite existing resources, e.g. realm clients, roles, groups and users or a registry snapshot with the same name"}
 	OverwriteFiles        = Flag{"overwriteFiles", "o", "Overwrite files in %s home directory"}
+	Parallelism           = Flag{"parallelism", "", "Number of module images pulled and module containers deployed concurrently"}
+	Path                  = Flag{"path", "", "Secret path in the KV v2 secrets engine, e.g. folio/diku"}
 	PlatformCompleteURL   = Flag{"platformCompleteURL", "", "Platform Complete UI url"}
 	PrivatePort           = Flag{"privatePort", "", "Private port e.g. 8081"}
 	Profile               = Flag{"profile", "p", "Use a specific profile, options: %s"}
 	PurgeSchemas          = Flag{"purgeSchemas", "", "Purge schemas in PostgreSQL on uninstallation"}
+	RealmFile             = Flag{"file", "", "Keycloak realm export file, the export defaults to <tenant>-realm.json, e.g. diku-realm.json"}
 	RemoveApplication     = Flag{"removeApplication", "", "Remove application from the DB"}
+	Resolved              = Flag{"resolved", "", "Print the config with the extended profiles merged"}
 	Restore               = Flag{"restore", "r", "Restore module & sidecar"}
+	Resume                = Flag{"resume", "", "Resume from the last failed step recorded in the deployment journal"}
+	Reveal                = Flag{"reveal", "", "Show secret values and passwords instead of masking them"}
+	RoutePath             = Flag{"path", "", "Request path without the gateway URL, e.g. /orders/composite-orders/123"}
+	Sidecar               = Flag{"sidecar", "", "Include the sidecar container"}
 	SidecarURL            = Flag{"sidecarUrl", "s", "Sidecar URL e.g. http://host.docker.internal:37002 or 37002 (if -g is used)"}
+	Since                 = Flag{"since", "", "Show logs since a timestamp, e.g. 2025-01-02T15:04:05Z, or a relative time, e.g. 10m"}
 	SingleTenant          = Flag{"singleTenant", "", "Use for Single Tenant workflow"}
 	SkipApplication       = Flag{"skipApplication", "", "Skip application operations"}
 	SkipModuleArtifact    = Flag{"skipModuleArtifact", "", "Skip building module artifact, i.e. the jar and its module descriptor"}
@@ -97,10 +166,17 @@ var (
 	SkipModuleDiscovery   = Flag{"skipModuleDiscovery", "", "Skip module discovery update"}
 	SkipRegistry          = Flag{"skipRegistry", "", "Skip retrieving module registry versions"}
 	SkipTenantEntitlement = Flag{"skipTenantEntitlement", "", "Skip tenant entitlement operations"}
+	SkipValidation        = Flag{"skipValidation", "", "Skip validating the config file before running a command"}
 	Tenant                = Flag{"tenant", "t", "Tenant"}
+	TenantDataFile        = Flag{"file", "", "Tenant data archive, the export defaults to <tenant>-<timestamp>.tar.gz, e.g. diku-20250101120000.tar.gz"}
 	TenantIDs             = Flag{"ids", "", "Tenant ids"}
+	ToEarliest            = Flag{"toEarliest", "", "Reset the offsets to the earliest retained messages"}
+	ToLatest              = Flag{"toLatest", "", "Reset the offsets to the end of the partitions"}
 	TokenType             = Flag{"tokenType", "", "Token type"}
+	Topic                 = Flag{"topic", "", "Topic name without the environment and tenant prefix when a tenant is given, e.g. inventory.instance"}
 	UpdateCloned          = Flag{"updateCloned", "u", "Update Git cloned projects"}
 	User                  = Flag{"user", "x", "User"}
+	Username              = Flag{"username", "", "Usernames of the Keycloak users, e.g. diku-system-user,mod-users"}
+	Value                 = Flag{"value", "", "Secret value"}
 	Versions              = Flag{"versions", "v", "Number of versions, e.g. 5"}
 )
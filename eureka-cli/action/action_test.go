@@ -815,6 +815,33 @@ func TestIsChildApp(t *testing.T) {
 	}
 }
 
+// ==================== Dry Run Tests ====================
+
+func TestIsDryRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		param    *action.Param
+		expected bool
+	}{
+		{name: "TestIsDryRun_ReturnsTrueWhenFlagSet", param: &action.Param{DryRun: true}, expected: true},
+		{name: "TestIsDryRun_ReturnsFalseWhenFlagUnset", param: &action.Param{}, expected: false},
+		{name: "TestIsDryRun_ReturnsFalseWhenParamNil", param: nil, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			act := &action.Action{Name: "test", Param: tt.param}
+
+			// Act
+			result := act.IsDryRun()
+
+			// Assert
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 // ==================== GetTemplateEnvVars Tests ====================
 
 func TestGetTemplateEnvVars(t *testing.T) {
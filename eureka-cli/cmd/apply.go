@@ -0,0 +1,678 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply config",
+	Long: `Reconcile the live environment with the config by creating only the missing modules, application, tenants, entitlements, roles, users and consortiums
+and redeploying the modules whose containers differ from the config. Modules missing from the registered application recreate the application
+and its module discovery before deployment and re-entitle the entitled tenants.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.Apply)
+		if err != nil {
+			return err
+		}
+
+		return run.Apply()
+	},
+}
+
+func (run *Run) Apply() error {
+	plan, err := run.PlanApply()
+	if err != nil {
+		return err
+	}
+	if err := run.PrintApplyPlan(os.Stdout, plan); err != nil {
+		return err
+	}
+	if len(plan.Actions) == 0 {
+		slog.Info(run.Config.Action.Name, "text", "Environment is up to date with the config")
+		return nil
+	}
+
+	return run.ExecuteApplyPlan(plan)
+}
+
+// ==================== Plan ====================
+
+func (run *Run) PlanApply() (*models.ApplyPlan, error) {
+	slog.Info(run.Config.Action.Name, "text", "PLANNING CONFIG RECONCILIATION")
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	if err := run.setVaultRootTokenIntoContext(client); err != nil {
+		return nil, err
+	}
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+		return nil, err
+	}
+
+	plan := &models.ApplyPlan{}
+	if err := run.planApplication(client, plan); err != nil {
+		return nil, err
+	}
+	liveTenants, err := run.planTenants(plan)
+	if err != nil {
+		return nil, err
+	}
+	if err := run.planTenantResources(liveTenants, plan); err != nil {
+		return nil, err
+	}
+	if err := run.planConsortiums(liveTenants, plan); err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (run *Run) planApplication(client *client.Client, plan *models.ApplyPlan) error {
+	applications, err := run.Config.ManagementSvc.GetApplications()
+	if err != nil {
+		return err
+	}
+	for _, descriptor := range applications.ApplicationDescriptors {
+		if helpers.GetString(descriptor, "id") == run.Config.Action.ConfigApplicationID {
+			return run.planModules(client, descriptor, plan)
+		}
+	}
+
+	plan.Actions = append(plan.Actions, models.ApplyAction{
+		Resource:  constant.ApplicationCategory,
+		Operation: constant.DeployOperation,
+		Name:      run.Config.Action.ConfigApplicationID,
+		Reason:    "application is not registered, all modules will be deployed",
+	})
+
+	return nil
+}
+
+func (run *Run) planModules(client *client.Client, descriptor map[string]any, plan *models.ApplyPlan) error {
+	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, false)
+	if err != nil {
+		return err
+	}
+
+	modules, lock, err := run.GetRegistryModules(false)
+	if err != nil {
+		return err
+	}
+	if moduleIDs := getUnregisteredModuleIDs(descriptor, modules, backendModules); len(moduleIDs) > 0 {
+		plan.Actions = append(plan.Actions, models.ApplyAction{
+			Resource:  constant.ApplicationCategory,
+			Operation: constant.UpdateOperation,
+			Name:      run.Config.Action.ConfigApplicationID,
+			Reason:    fmt.Sprintf("modules %s are not registered, application and module discovery will be recreated", strings.Join(moduleIDs, ", ")),
+		})
+	}
+
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(client, filters.NewArgs(filters.KeyValuePair{
+		Key:   "name",
+		Value: fmt.Sprintf(constant.ProfileContainerPattern, run.Config.Action.ConfigProfileName),
+	}))
+	if err != nil {
+		return err
+	}
+	liveContainers := make(map[string]container.Summary, len(containers))
+	for _, summary := range containers {
+		liveContainers[getContainerName(summary)] = summary
+	}
+
+	var runningModules []string
+	for _, moduleName := range slices.Sorted(maps.Keys(backendModules)) {
+		backendModule := backendModules[moduleName]
+		if !backendModule.DeployModule {
+			continue
+		}
+
+		moduleContainerName := fmt.Sprintf("eureka-%s-%s", run.Config.Action.ConfigProfileName, moduleName)
+		reason := getMissingContainerReason(liveContainers, moduleContainerName)
+		if reason == "" && backendModule.DeploySidecar {
+			reason = getMissingContainerReason(liveContainers, moduleContainerName+"-sc")
+		}
+		if reason == "" {
+			runningModules = append(runningModules, moduleName)
+			continue
+		}
+
+		plan.Actions = append(plan.Actions, models.ApplyAction{
+			Resource:  constant.ModuleCategory,
+			Operation: constant.DeployOperation,
+			Name:      moduleName,
+			Reason:    reason,
+		})
+	}
+	if len(runningModules) == 0 {
+		return nil
+	}
+
+	drift, err := run.getModuleDrift(client, modules, lock, filterBackendModules(backendModules, runningModules))
+	if err != nil {
+		return err
+	}
+	for _, moduleName := range runningModules {
+		if reason, ok := drift[moduleName]; ok {
+			plan.Actions = append(plan.Actions, models.ApplyAction{
+				Resource:  constant.ModuleCategory,
+				Operation: constant.RedeployOperation,
+				Name:      moduleName,
+				Reason:    reason,
+			})
+		}
+	}
+
+	return nil
+}
+
+// getUnregisteredModuleIDs returns the IDs of the deployable backend modules that are missing from the registered application
+func getUnregisteredModuleIDs(descriptor map[string]any, modules *models.ProxyModulesByRegistry, backendModules map[string]models.BackendModule) []string {
+	registeredModuleIDs := make(map[string]bool)
+	for _, value := range helpers.GetAnySlice(descriptor, "modules") {
+		if entry, ok := value.(map[string]any); ok {
+			registeredModuleIDs[helpers.GetString(entry, "id")] = true
+		}
+	}
+
+	var moduleIDs []string
+	for _, module := range slices.Concat(modules.FolioModules, modules.EurekaModules) {
+		backendModule, ok := backendModules[module.Metadata.Name]
+		if !ok || !backendModule.DeployModule || strings.Contains(module.Metadata.Name, constant.ManagementModulePattern) {
+			continue
+		}
+
+		moduleID := module.ID
+		if backendModule.ModuleVersion != nil {
+			moduleID = fmt.Sprintf("%s-%s", module.Metadata.Name, *backendModule.ModuleVersion)
+		}
+		if !registeredModuleIDs[moduleID] {
+			moduleIDs = append(moduleIDs, moduleID)
+		}
+	}
+	slices.Sort(moduleIDs)
+
+	return moduleIDs
+}
+
+// getModuleDrift compares the running modules with the containers that the config would deploy
+func (run *Run) getModuleDrift(client *client.Client, modules *models.ProxyModulesByRegistry, lock *models.Lock, backendModules map[string]models.BackendModule) (map[string]string, error) {
+	sidecarImage, _, err := run.getSidecarImage(modules, lock)
+	if err != nil {
+		return nil, err
+	}
+	containers := &models.Containers{
+		Modules:        modules,
+		BackendModules: backendModules,
+		IsManagement:   false,
+		Lock:           lock,
+	}
+	sidecarResources := helpers.CreateResources(false, run.Config.Action.ConfigSidecarModuleResources)
+
+	return run.Config.ModuleSvc.GetModuleDrift(client, containers, sidecarImage, sidecarResources)
+}
+
+func (run *Run) planTenants(plan *models.ApplyPlan) (map[string]string, error) {
+	tenants, err := run.Config.ManagementSvc.GetTenants("", constant.All)
+	if err != nil {
+		return nil, err
+	}
+
+	liveTenants := make(map[string]string, len(tenants))
+	for _, value := range tenants {
+		entry := value.(map[string]any)
+		liveTenants[helpers.GetString(entry, "name")] = helpers.GetString(entry, "id")
+	}
+	for _, tenantName := range helpers.SortedMapKeys(run.Config.Action.ConfigTenants) {
+		if _, ok := liveTenants[tenantName]; ok {
+			continue
+		}
+		plan.Actions = append(plan.Actions, models.ApplyAction{
+			Resource:  constant.TenantCategory,
+			Operation: constant.CreateOperation,
+			Name:      tenantName,
+			Tenant:    tenantName,
+			Reason:    "tenant does not exist",
+		})
+	}
+
+	return liveTenants, nil
+}
+
+func (run *Run) planTenantResources(liveTenants map[string]string, plan *models.ApplyPlan) error {
+	for _, tenantName := range helpers.SortedMapKeys(run.Config.Action.ConfigTenants) {
+		entitled := false
+		if _, ok := liveTenants[tenantName]; ok {
+			entitlements, err := run.Config.ManagementSvc.GetTenantEntitlements(tenantName, false)
+			if err != nil {
+				return err
+			}
+			for _, entitlement := range entitlements.Entitlements {
+				if entitlement.ApplicationID == run.Config.Action.ConfigApplicationID {
+					entitled = true
+					break
+				}
+			}
+		}
+		if !entitled {
+			plan.Actions = append(plan.Actions, models.ApplyAction{
+				Resource:  constant.EntitlementCategory,
+				Operation: constant.EntitleOperation,
+				Name:      run.Config.Action.ConfigApplicationID,
+				Tenant:    tenantName,
+				Reason:    "tenant is not entitled to the application",
+			})
+		} else if plan.HasOperation(constant.ApplicationCategory, constant.UpdateOperation) {
+			plan.Actions = append(plan.Actions, models.ApplyAction{
+				Resource:  constant.EntitlementCategory,
+				Operation: constant.ReentitleOperation,
+				Name:      run.Config.Action.ConfigApplicationID,
+				Tenant:    tenantName,
+				Reason:    "entitlement must cover the recreated application modules",
+			})
+		}
+
+		liveRoles, liveUsers := map[string]bool{}, map[string]bool{}
+		if entitled {
+			var err error
+			if liveRoles, liveUsers, err = run.getLiveRolesAndUsers(tenantName); err != nil {
+				return err
+			}
+		}
+		run.planRolesAndUsers(tenantName, liveRoles, liveUsers, plan)
+	}
+
+	return nil
+}
+
+func (run *Run) getLiveRolesAndUsers(tenantName string) (map[string]bool, map[string]bool, error) {
+	if err := run.setKeycloakAccessTokenIntoContext(tenantName); err != nil {
+		return nil, nil, err
+	}
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, run.Config.Action.KeycloakAccessToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles, err := run.Config.KeycloakSvc.GetRoles(headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	liveRoles := make(map[string]bool, len(roles))
+	for _, value := range roles {
+		liveRoles[run.Config.Action.Caser.String(helpers.GetString(value.(map[string]any), "name"))] = true
+	}
+
+	users, err := run.Config.KeycloakSvc.GetUsers(tenantName)
+	if err != nil {
+		return nil, nil, err
+	}
+	liveUsers := make(map[string]bool, len(users))
+	for _, value := range users {
+		liveUsers[helpers.GetString(value.(map[string]any), "username")] = true
+	}
+
+	return liveRoles, liveUsers, nil
+}
+
+func (run *Run) planRolesAndUsers(tenantName string, liveRoles map[string]bool, liveUsers map[string]bool, plan *models.ApplyPlan) {
+	for _, roleName := range helpers.SortedMapKeys(run.Config.Action.ConfigRoles) {
+		entry := run.Config.Action.ConfigRoles[roleName].(map[string]any)
+		if helpers.GetString(entry, field.RolesTenantEntry) != tenantName || liveRoles[run.Config.Action.Caser.String(roleName)] {
+			continue
+		}
+		plan.Actions = append(plan.Actions, models.ApplyAction{
+			Resource:  constant.RoleCategory,
+			Operation: constant.CreateOperation,
+			Name:      roleName,
+			Tenant:    tenantName,
+			Reason:    "role does not exist, capability sets will be attached",
+		})
+	}
+
+	for _, username := range helpers.SortedMapKeys(run.Config.Action.ConfigUsers) {
+		entry := run.Config.Action.ConfigUsers[username].(map[string]any)
+		if helpers.GetString(entry, field.UsersTenantEntry) != tenantName || liveUsers[username] {
+			continue
+		}
+		plan.Actions = append(plan.Actions, models.ApplyAction{
+			Resource:  constant.UserCategory,
+			Operation: constant.CreateOperation,
+			Name:      username,
+			Tenant:    tenantName,
+			Reason:    "user does not exist",
+		})
+	}
+}
+
+func (run *Run) planConsortiums(liveTenants map[string]string, plan *models.ApplyPlan) error {
+	if !action.IsSet(field.Consortiums) {
+		return nil
+	}
+
+	for _, consortiumName := range helpers.SortedMapKeys(run.Config.Action.ConfigConsortiums) {
+		entry := run.Config.Action.ConfigConsortiums[consortiumName].(map[string]any)
+		if !helpers.GetBool(entry, field.ConsortiumCreateConsortiumEntry) {
+			continue
+		}
+
+		centralTenant := run.Config.ConsortiumSvc.GetConsortiumCentralTenant(consortiumName)
+		if centralTenant == "" {
+			return errors.ConsortiumMissingCentralTenant(consortiumName)
+		}
+		isEntitled := !slices.ContainsFunc(plan.GetActions(constant.EntitlementCategory), func(entitlementAction models.ApplyAction) bool {
+			return entitlementAction.Tenant == centralTenant && entitlementAction.Operation == constant.EntitleOperation
+		})
+		if _, ok := liveTenants[centralTenant]; ok && isEntitled {
+			if err := run.setKeycloakAccessTokenIntoContext(centralTenant); err != nil {
+				return err
+			}
+			consortium, err := run.Config.ConsortiumSvc.GetConsortiumByName(centralTenant, consortiumName)
+			if err != nil {
+				return err
+			}
+			if consortium != nil {
+				continue
+			}
+		}
+
+		plan.Actions = append(plan.Actions, models.ApplyAction{
+			Resource:  constant.ConsortiumCategory,
+			Operation: constant.CreateOperation,
+			Name:      consortiumName,
+			Tenant:    centralTenant,
+			Reason:    "consortium does not exist",
+		})
+	}
+
+	return nil
+}
+
+func getMissingContainerReason(liveContainers map[string]container.Summary, containerName string) string {
+	summary, ok := liveContainers[containerName]
+	if !ok {
+		return fmt.Sprintf("container %s is missing", containerName)
+	}
+	if summary.State != container.StateRunning {
+		return fmt.Sprintf("container %s is %s", containerName, summary.State)
+	}
+
+	return ""
+}
+
+func (run *Run) PrintApplyPlan(writer io.Writer, plan *models.ApplyPlan) error {
+	if len(plan.Actions) == 0 {
+		_, err := fmt.Fprintln(writer, "No changes. The environment matches the config.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "#\tOPERATION\tRESOURCE\tNAME\tTENANT\tREASON")
+	for idx, action := range plan.Actions {
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", idx+1, action.Operation, action.Resource, action.Name, action.Tenant, action.Reason)
+	}
+	_, _ = fmt.Fprintf(tw, "\nPlan: %d action(s)\n", len(plan.Actions))
+
+	return tw.Flush()
+}
+
+// ==================== Execute ====================
+
+func (run *Run) ExecuteApplyPlan(plan *models.ApplyPlan) error {
+	slog.Info(run.Config.Action.Name, "text", "APPLYING PLAN", "actions", len(plan.Actions))
+	if plan.HasOperation(constant.ApplicationCategory, constant.DeployOperation) {
+		if err := run.DeployModules(); err != nil {
+			return err
+		}
+	} else {
+		if plan.HasOperation(constant.ApplicationCategory, constant.UpdateOperation) {
+			if err := run.recreateApplication(plan.GetActions(constant.EntitlementCategory)); err != nil {
+				return err
+			}
+		}
+		if moduleActions := plan.GetActions(constant.ModuleCategory); len(moduleActions) > 0 {
+			moduleNames := make([]string, 0, len(moduleActions))
+			for _, moduleAction := range moduleActions {
+				moduleNames = append(moduleNames, moduleAction.Name)
+			}
+			if err := run.undeployApplyModules(moduleNames); err != nil {
+				return err
+			}
+			if err := run.deployModules(moduleNames, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+		return err
+	}
+	for _, tenantAction := range plan.GetActions(constant.TenantCategory) {
+		slog.Info(run.Config.Action.Name, "text", "CREATING TENANT", "tenant", tenantAction.Tenant)
+		if err := run.Config.ManagementSvc.CreateTenant(tenantAction.Tenant); err != nil {
+			return err
+		}
+	}
+	if err := run.applyEntitlements(plan.GetActions(constant.EntitlementCategory)); err != nil {
+		return err
+	}
+	if err := run.applyRolesAndUsers(plan); err != nil {
+		return err
+	}
+	for _, consortiumAction := range plan.GetActions(constant.ConsortiumCategory) {
+		entry := run.Config.Action.ConfigConsortiums[consortiumAction.Name].(map[string]any)
+		if err := run.createConsortium(consortiumAction.Name, entry); err != nil {
+			return err
+		}
+	}
+	slog.Info(run.Config.Action.Name, "text", "Applied plan", "actions", len(plan.Actions))
+
+	return nil
+}
+
+// recreateApplication revokes the entitlements that the plan re-entitles and replaces the registered application,
+// so that the module discovery of the added or changed modules is registered before their containers are deployed
+func (run *Run) recreateApplication(entitlementActions []models.ApplyAction) error {
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+		return err
+	}
+	if err := run.revokeEntitlements(entitlementActions); err != nil {
+		return err
+	}
+
+	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, false)
+	if err != nil {
+		return err
+	}
+	frontendModules, err := run.Config.ModuleProps.ReadFrontendModules(false)
+	if err != nil {
+		return err
+	}
+	modules, _, err := run.GetRegistryModules(false)
+	if err != nil {
+		return err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "REMOVING APPLICATION", "id", run.Config.Action.ConfigApplicationID)
+	if err := run.Config.ManagementSvc.RemoveApplication(run.Config.Action.ConfigApplicationID); err != nil {
+		return err
+	}
+
+	return run.createApplication(modules, backendModules, frontendModules)
+}
+
+// revokeEntitlements removes the entitlements of the re-entitled tenants while keeping their module data
+func (run *Run) revokeEntitlements(entitlementActions []models.ApplyAction) error {
+	var tenantIDs map[string]string
+	for _, entitlementAction := range entitlementActions {
+		if entitlementAction.Operation != constant.ReentitleOperation {
+			continue
+		}
+		if tenantIDs == nil {
+			var err error
+			if tenantIDs, err = run.getTenantIDs(); err != nil {
+				return err
+			}
+		}
+
+		slog.Info(run.Config.Action.Name, "text", "REMOVING TENANT ENTITLEMENT", "tenant", entitlementAction.Tenant)
+		if err := run.Config.ManagementSvc.RemoveTenantEntitlementForTenant(tenantIDs[entitlementAction.Tenant], entitlementAction.Tenant, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// undeployApplyModules removes the stopped or drifted containers of the modules before they are deployed again
+func (run *Run) undeployApplyModules(moduleNames []string) error {
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	for _, moduleName := range moduleNames {
+		pattern := fmt.Sprintf(constant.SingleModuleOrSidecarContainerPattern, run.Config.Action.ConfigProfileName, moduleName)
+		if err := run.Config.ModuleSvc.UndeployModuleByNamePattern(client, pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (run *Run) applyEntitlements(entitlementActions []models.ApplyAction) error {
+	if len(entitlementActions) == 0 {
+		return nil
+	}
+
+	tenantIDs, err := run.getTenantIDs()
+	if err != nil {
+		return err
+	}
+
+	for _, entitlementAction := range entitlementActions {
+		tenantName := entitlementAction.Tenant
+		configTenant := run.Config.Action.ConfigTenants[tenantName].(map[string]any)
+		consortiumName := helpers.GetString(configTenant, field.TenantsConsortiumEntry)
+		if consortiumName == "" {
+			consortiumName = constant.NoneConsortium
+		}
+
+		slog.Info(run.Config.Action.Name, "text", "CREATING TENANT ENTITLEMENT", "tenant", tenantName)
+		if err := run.Config.ManagementSvc.CreateTenantEntitlementForTenant(consortiumName, tenantIDs[tenantName], tenantName); err != nil {
+			return err
+		}
+		if err := run.updateRealmAccessTokenSettingsAndRelogin(tenantName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (run *Run) getTenantIDs() (map[string]string, error) {
+	tenants, err := run.Config.ManagementSvc.GetTenants("", constant.All)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantIDs := make(map[string]string, len(tenants))
+	for _, value := range tenants {
+		entry := value.(map[string]any)
+		tenantIDs[helpers.GetString(entry, "name")] = helpers.GetString(entry, "id")
+	}
+
+	return tenantIDs, nil
+}
+
+func (run *Run) applyRolesAndUsers(plan *models.ApplyPlan) error {
+	var tenantNames []string
+	for _, action := range plan.Actions {
+		if (action.Resource == constant.RoleCategory || action.Resource == constant.UserCategory) && !slices.Contains(tenantNames, action.Tenant) {
+			tenantNames = append(tenantNames, action.Tenant)
+		}
+	}
+
+	for _, tenantName := range tenantNames {
+		if err := run.setKeycloakAccessTokenIntoContext(tenantName); err != nil {
+			return err
+		}
+
+		var createdRoles []string
+		for _, roleAction := range plan.GetActions(constant.RoleCategory) {
+			if roleAction.Tenant != tenantName {
+				continue
+			}
+			if err := run.Config.KeycloakSvc.CreateRole(tenantName, roleAction.Name); err != nil {
+				return err
+			}
+			createdRoles = append(createdRoles, roleAction.Name)
+		}
+		if len(createdRoles) > 0 {
+			topicConfigTenant := run.Config.Action.GetKafkaTopicConfigTenant(tenantName)
+			slog.Info(run.Config.Action.Name, "text", "POLLING FOR CAPABILITY SETS CREATION", "topicConfigTenant", topicConfigTenant)
+			if err := run.Config.KafkaSvc.PollConsumerGroup(topicConfigTenant); err != nil {
+				return err
+			}
+			for _, roleName := range createdRoles {
+				if err := run.Config.KeycloakSvc.AttachCapabilitySetsToRole(tenantName, roleName); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, userAction := range plan.GetActions(constant.UserCategory) {
+			if userAction.Tenant != tenantName {
+				continue
+			}
+			if err := run.Config.KeycloakSvc.CreateUser(tenantName, userAction.Name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+}
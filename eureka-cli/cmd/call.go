@@ -0,0 +1,204 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/filters"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// callCmd represents the call command
+var callCmd = &cobra.Command{
+	Use:   "call <method> <path>",
+	Short: "Call a module API",
+	Long: `Send an authenticated request to a module API through Kong with the token of the tenant system user or of a configured user,
+the status line and the round trip time are printed to stderr and the pretty-printed response body to stdout, e.g. call GET /users?limit=5 --tenant diku.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.Call)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		response, err := run.Call(strings.ToUpper(args[0]), args[1])
+		if err != nil {
+			return err
+		}
+		if response == nil {
+			return nil
+		}
+		PrintHTTPCallResponse(os.Stderr, os.Stdout, response)
+		if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+			return errors.RequestFailed(response.StatusCode, strings.ToUpper(args[0]), args[1])
+		}
+
+		return nil
+	},
+}
+
+// Call sends a request with the tenant and token headers to Kong, or to the sidecar of the module serving the path
+// when the direct flag is set, the response is nil in dry run mode for requests that may mutate the environment
+func (run *Run) Call(method, path string) (*models.HTTPCallResponse, error) {
+	tenantName, accessToken, err := run.getCallAccessToken(params.Tenant, params.User)
+	if err != nil {
+		return nil, err
+	}
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := readCallPayload(params.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	port := constant.KongPort
+	if params.Direct {
+		sidecarPort, err := run.getCallSidecarPort(method, path)
+		if err != nil {
+			return nil, err
+		}
+		port = strconv.Itoa(sidecarPort)
+	}
+	requestURL := run.Config.Action.GetRequestURL(port, path)
+	slog.Info(run.Config.Action.Name, "text", "Calling module API", "method", method, "url", requestURL, "tenant", tenantName)
+
+	return run.Config.HTTPClient.Call(method, requestURL, payload, headers)
+}
+
+// getCallAccessToken returns the tenant and the token of the system user, or of a configured user whose
+// password is read from the users section of the config, the tenant of the configured user is the default
+func (run *Run) getCallAccessToken(tenantName, username string) (string, string, error) {
+	if username == "" {
+		if tenantName == "" {
+			return "", "", errors.RequiredParameterMissing(action.Tenant.Long)
+		}
+		if err := run.setKeycloakAccessTokenIntoContext(tenantName); err != nil {
+			return "", "", err
+		}
+
+		return tenantName, run.Config.Action.KeycloakAccessToken, nil
+	}
+
+	entry, ok := run.Config.Action.ConfigUsers[username].(map[string]any)
+	if !ok {
+		return "", "", errors.ConfigUserNotFound(username)
+	}
+	if tenantName == "" {
+		tenantName = helpers.GetString(entry, field.UsersTenantEntry)
+	}
+	key := run.newTokenCacheKey(tenantName, tenantName+action.GetConfigEnv("KC_LOGIN_CLIENT_SUFFIX", run.Config.Action.ConfigGlobalEnv), constant.Password)
+	key.Username = username
+	accessToken, err := run.getCachedAccessToken(key, func() (string, error) {
+		return run.Config.KeycloakSvc.GetUserAccessToken(tenantName, username, helpers.GetString(entry, field.UsersPasswordEntry))
+	})
+
+	return tenantName, accessToken, err
+}
+
+// getCallSidecarPort returns the published server port of the sidecar of the module given by name,
+// or of the module whose Kong route Kong picks for the request when no module name is given
+func (run *Run) getCallSidecarPort(method, path string) (int, error) {
+	moduleName := params.ModuleName
+	if moduleName == "" {
+		routes, err := run.FindRoute(method, path)
+		if err != nil {
+			return 0, err
+		}
+		moduleName = helpers.GetModuleNameFromID(routes[0].ModuleID)
+	}
+
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return 0, err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	filters := filters.NewArgs(filters.KeyValuePair{
+		Key:   "name",
+		Value: fmt.Sprintf(constant.SingleModuleOrSidecarContainerPattern, run.Config.Action.ConfigProfileName, moduleName),
+	})
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(client, filters)
+	if err != nil {
+		return 0, err
+	}
+	for _, summary := range containers {
+		if !strings.HasSuffix(getContainerName(summary), "-sc") {
+			continue
+		}
+		if port := getServerPublicPort(summary.Ports); port != 0 {
+			return port, nil
+		}
+	}
+
+	return 0, errors.SidecarPortNotFound(moduleName)
+}
+
+// readCallPayload returns the request body given inline or, with an @ prefix, read from a file
+func readCallPayload(data string) ([]byte, error) {
+	if data == "" {
+		return nil, nil
+	}
+	if filePath, ok := strings.CutPrefix(data, "@"); ok {
+		return os.ReadFile(filePath)
+	}
+
+	return []byte(data), nil
+}
+
+// PrintHTTPCallResponse writes the status line with the round trip time and the response body, JSON bodies are indented
+func PrintHTTPCallResponse(statusWriter io.Writer, bodyWriter io.Writer, response *models.HTTPCallResponse) {
+	_, _ = fmt.Fprintf(statusWriter, "%s (%s)\n", response.Status, response.Duration.Round(time.Millisecond))
+	if len(response.Body) == 0 {
+		return
+	}
+
+	var formatted bytes.Buffer
+	if err := json.Indent(&formatted, response.Body, "", "  "); err != nil {
+		_, _ = fmt.Fprintln(bodyWriter, string(response.Body))
+		return
+	}
+	_, _ = fmt.Fprintln(bodyWriter, formatted.String())
+}
+
+func init() {
+	rootCmd.AddCommand(callCmd)
+	callCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	callCmd.PersistentFlags().StringVarP(&params.User, action.User.Long, action.User.Short, "", action.User.Description)
+	callCmd.PersistentFlags().StringVarP(&params.Data, action.Data.Long, action.Data.Short, "", action.Data.Description)
+	callCmd.PersistentFlags().BoolVarP(&params.Direct, action.Direct.Long, action.Direct.Short, false, action.Direct.Description)
+	callCmd.PersistentFlags().StringVarP(&params.ModuleName, action.ModuleName.Long, action.ModuleName.Short, "", action.ModuleName.Description)
+}
@@ -1,15 +1,31 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/client"
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/kongsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/modulesvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
+	"github.com/folio-org/eureka-setup/eureka-cli/tokencachesvc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -106,6 +122,30 @@ func (m *MockKongSvc) ListAllRoutes() ([]models.KongRoute, error) {
 	return args.Get(0).([]models.KongRoute), args.Error(1)
 }
 
+func (m *MockKongSvc) ListAllServices() ([]models.KongService, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KongService), args.Error(1)
+}
+
+func (m *MockKongSvc) ListRoutes(moduleName string) ([]models.KongRouteInfo, error) {
+	args := m.Called(moduleName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KongRouteInfo), args.Error(1)
+}
+
+func (m *MockKongSvc) FindRoutes(method, path string) ([]models.KongRouteInfo, error) {
+	args := m.Called(method, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KongRouteInfo), args.Error(1)
+}
+
 // ==================== UpgradeModule Tests ====================
 
 func TestValidateModulePath_EmptyPath(t *testing.T) {
@@ -437,6 +477,114 @@ func TestConsortiumPartition_FunctionError(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 }
 
+// ==================== JournalStep Tests ====================
+
+func newTestJournalRun(t *testing.T, resume bool) *Run {
+	t.Setenv("HOME", t.TempDir())
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	run.Config.Action.ConfigProfileName = "combined"
+	run.Config.JournalSvc = journalsvc.New(run.Config.Action)
+	assert.NoError(t, run.Config.JournalSvc.Begin(resume))
+
+	return run
+}
+
+func TestJournalStep_NotEnabled(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DeployApplication)
+	called := false
+
+	// Act
+	err := run.JournalStep(action.DeploySystem, "", func() error {
+		called = true
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestJournalStep_RecordsSuccess(t *testing.T) {
+	// Arrange
+	run := newTestJournalRun(t, false)
+
+	var currentStep string
+
+	// Act
+	err := run.JournalStep(action.CreateRoles, "nop/default", func() error {
+		currentStep = run.Config.JournalSvc.GetCurrentStep()
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, run.Config.JournalSvc.IsSucceeded(action.CreateRoles, "nop/default"))
+	assert.Equal(t, action.CreateRoles, currentStep)
+	assert.Empty(t, run.Config.JournalSvc.GetCurrentStep())
+}
+
+func TestJournalStep_RestoresOuterStep(t *testing.T) {
+	// Arrange
+	run := newTestJournalRun(t, false)
+	var innerStep, outerStep string
+
+	// Act
+	err := run.JournalStep(action.DeployApplication, "", func() error {
+		if err := run.JournalStep(action.CreateRoles, "nop/default", func() error {
+			innerStep = run.Config.JournalSvc.GetCurrentStep()
+			return nil
+		}); err != nil {
+			return err
+		}
+		outerStep = run.Config.JournalSvc.GetCurrentStep()
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, action.CreateRoles, innerStep)
+	assert.Equal(t, action.DeployApplication, outerStep)
+	assert.Empty(t, run.Config.JournalSvc.GetCurrentStep())
+}
+
+func TestJournalStep_RecordsFailure(t *testing.T) {
+	// Arrange
+	run := newTestJournalRun(t, false)
+
+	// Act
+	err := run.JournalStep(action.CreateUsers, "nop/default", func() error {
+		return assert.AnError
+	})
+
+	// Assert
+	assert.Equal(t, assert.AnError, err)
+	assert.False(t, run.Config.JournalSvc.IsSucceeded(action.CreateUsers, "nop/default"))
+}
+
+func TestJournalStep_SkipsSucceededOnResume(t *testing.T) {
+	// Arrange
+	run := newTestJournalRun(t, false)
+	assert.NoError(t, run.JournalStep(action.DeploySystem, "", func() error { return nil }))
+	assert.NoError(t, run.Config.JournalSvc.Begin(true))
+	called := false
+
+	// Act
+	err := run.JournalStep(action.DeploySystem, "", func() error {
+		called = true
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, called)
+}
+
+func TestGetPartitionKey(t *testing.T) {
+	assert.Equal(t, "consortium/central/diku", getPartitionKey("consortium", string(constant.Central), "diku"))
+	assert.Equal(t, "nop/default", getPartitionKey(constant.NoneConsortium, string(constant.Default)))
+}
+
 // ==================== CheckDeployedModuleReadiness Tests ====================
 
 func TestCheckDeployedModuleReadiness_NoModules(t *testing.T) {
@@ -470,3 +618,2270 @@ func TestCheckDeployedModuleReadiness_WithModules(t *testing.T) {
 	assert.NoError(t, err)
 	mockModule.AssertExpectations(t)
 }
+
+// ==================== Status Tests ====================
+
+func TestGetContainerHealth(t *testing.T) {
+	tests := []struct {
+		name          string
+		summary       container.Summary
+		expectedState string
+		expectedOk    bool
+	}{
+		{"Healthy", container.Summary{State: container.StateRunning, Status: "Up 5 minutes (healthy)"}, "healthy", true},
+		{"Unhealthy", container.Summary{State: container.StateRunning, Status: "Up 5 minutes (unhealthy)"}, "unhealthy", false},
+		{"Starting", container.Summary{State: container.StateRunning, Status: "Up 2 seconds (health: starting)"}, "starting", false},
+		{"NoHealthcheck", container.Summary{State: container.StateRunning, Status: "Up 5 minutes"}, "running", true},
+		{"Completed", container.Summary{State: container.StateExited, Status: "Exited (0) 2 hours ago"}, "completed", true},
+		{"Crashed", container.Summary{State: container.StateExited, Status: "Exited (137) 2 hours ago"}, "exited", false},
+		{"Created", container.Summary{State: container.StateCreated, Status: "Created"}, "created", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			state, healthy := getContainerHealth(tt.summary)
+
+			// Assert
+			assert.Equal(t, tt.expectedState, state)
+			assert.Equal(t, tt.expectedOk, healthy)
+		})
+	}
+}
+
+func TestGetServerPublicPort(t *testing.T) {
+	// Arrange
+	ports := []container.Port{
+		{PrivatePort: 5005, PublicPort: 36001},
+		{PrivatePort: 8081, PublicPort: 36000},
+	}
+
+	// Act
+	port := getServerPublicPort(ports)
+
+	// Assert
+	assert.Equal(t, 36000, port)
+	assert.Equal(t, 0, getServerPublicPort([]container.Port{{PrivatePort: 8081}}))
+}
+
+func TestCollectRouteStatus_MissingRoute(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Status)
+	mockKong := &MockKongSvc{}
+	run.Config.KongSvc = mockKong
+	expressions := kongsvc.GetRouteReadinessExpressions()
+	mockKong.On("FindRouteByExpressions", expressions).Return([]*models.KongRoute{{Name: "applications-get", Expression: expressions[0]}}, nil)
+	report := &models.StatusReport{}
+
+	// Act
+	run.collectRouteStatus(report)
+
+	// Assert
+	assert.Len(t, report.Components, len(expressions))
+	assert.True(t, report.Components[0].Healthy)
+	assert.Equal(t, "applications-get", report.Components[0].Detail)
+	assert.False(t, report.Components[1].Healthy)
+	assert.Equal(t, len(expressions)-1, countUnhealthy(report))
+	mockKong.AssertExpectations(t)
+}
+
+func TestCollectRouteStatus_KongUnreachable(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Status)
+	mockKong := &MockKongSvc{}
+	run.Config.KongSvc = mockKong
+	mockKong.On("FindRouteByExpressions", mock.Anything).Return(nil, assert.AnError)
+	report := &models.StatusReport{}
+
+	// Act
+	run.collectRouteStatus(report)
+
+	// Assert
+	assert.Len(t, report.Components, 1)
+	assert.Equal(t, "unreachable", report.Components[0].State)
+	assert.False(t, report.Components[0].Healthy)
+}
+
+func TestCollectTenantStatus(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, _, _ := newTestRun(action.Status)
+	run.Config.Action.ConfigApplicationID = "app-combined-1.0.0"
+	run.Config.Action.ConfigTenants = map[string]any{"diku": map[string]any{}, "missing": map[string]any{}}
+	mockManagement.On("GetTenantEntitlements", "diku", false).Return(models.TenantEntitlementResponse{
+		Entitlements: []models.TenantEntitlementDTO{{ApplicationID: "app-combined-1.0.0", TenantID: "1"}},
+	}, nil)
+	report := &models.StatusReport{}
+
+	// Act
+	run.collectTenantStatus([]any{map[string]any{"name": "diku"}}, report)
+
+	// Assert
+	assert.Len(t, report.Components, 3)
+	assert.Equal(t, models.StatusEntry{Category: constant.TenantCategory, Name: "diku", State: "created", Healthy: true}, report.Components[0])
+	assert.Equal(t, constant.EntitlementCategory, report.Components[1].Category)
+	assert.True(t, report.Components[1].Healthy)
+	assert.Equal(t, models.StatusEntry{Category: constant.TenantCategory, Name: "missing", State: "missing"}, report.Components[2])
+	mockManagement.AssertExpectations(t)
+}
+
+func TestCollectApplicationStatus_Missing(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Status)
+	run.Config.Action.ConfigApplicationID = "app-combined-1.0.0"
+	applications := models.ApplicationsResponse{ApplicationDescriptors: []map[string]any{{"id": "app-other-1.0.0"}}}
+	report := &models.StatusReport{}
+
+	// Act
+	run.collectApplicationStatus(applications, report)
+
+	// Assert
+	assert.Len(t, report.Components, 2)
+	assert.True(t, report.Components[0].Healthy)
+	assert.Equal(t, "app-combined-1.0.0", report.Components[1].Name)
+	assert.False(t, report.Components[1].Healthy)
+}
+
+func TestPrintStatus(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Status)
+	report := &models.StatusReport{
+		Profile: "combined",
+		Components: []models.StatusEntry{
+			{Category: constant.SystemCategory, Name: "kong", State: "healthy", Healthy: true},
+		},
+	}
+
+	t.Run("TestPrintStatus_Table", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintStatus(&buf, report, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "CATEGORY")
+		assert.Contains(t, buf.String(), "kong")
+	})
+
+	t.Run("TestPrintStatus_JSON", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintStatus(&buf, report, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		var decoded models.StatusReport
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, *report, decoded)
+	})
+}
+
+// ==================== Apply Tests ====================
+
+func TestFilterBackendModules(t *testing.T) {
+	// Arrange
+	backendModules := map[string]models.BackendModule{
+		"mod-orders":    {ModuleName: "mod-orders"},
+		"mod-inventory": {ModuleName: "mod-inventory"},
+	}
+
+	// Act & Assert
+	assert.Equal(t, backendModules, filterBackendModules(backendModules, nil))
+	filtered := filterBackendModules(backendModules, []string{"mod-orders", "mod-unknown"})
+	assert.Len(t, filtered, 1)
+	assert.Contains(t, filtered, "mod-orders")
+}
+
+func TestGetMissingContainerReason(t *testing.T) {
+	// Arrange
+	liveContainers := map[string]container.Summary{
+		"eureka-combined-mod-orders":    {State: container.StateRunning},
+		"eureka-combined-mod-orders-sc": {State: container.StateExited},
+	}
+
+	// Act & Assert
+	assert.Empty(t, getMissingContainerReason(liveContainers, "eureka-combined-mod-orders"))
+	assert.Equal(t, "container eureka-combined-mod-orders-sc is exited", getMissingContainerReason(liveContainers, "eureka-combined-mod-orders-sc"))
+	assert.Equal(t, "container eureka-combined-mod-users is missing", getMissingContainerReason(liveContainers, "eureka-combined-mod-users"))
+}
+
+func TestPlanModules_Drift(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, mockModule := newTestRun(action.Apply)
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run.Config.ModuleProps, run.Config.RegistrySvc = mockModuleProps, mockRegistrySvc
+	run.Config.Action.ConfigProfileName = "combined"
+	backendModules := map[string]models.BackendModule{
+		"mod-orders": {DeployModule: true},
+		"mod-users":  {DeployModule: true},
+	}
+	modules := &models.ProxyModulesByRegistry{}
+	mockModuleProps.On("ReadBackendModules", false, false).Return(backendModules, nil)
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders"}, State: container.StateRunning},
+	}, nil)
+	mockRegistrySvc.On("GetModules", false).Return(modules, nil)
+	mockRegistrySvc.On("ExtractModuleMetadata", modules).Return()
+	mockModule.On("GetSidecarImage", mock.Anything).Return("folio-module-sidecar:3.0.0", false, nil)
+	mockModule.On("GetModuleDrift", mock.Anything, mock.MatchedBy(func(containers *models.Containers) bool {
+		return len(containers.BackendModules) == 1 && containers.BackendModules["mod-orders"].DeployModule
+	}), "folio-module-sidecar:3.0.0", mock.Anything).Return(map[string]string{"mod-orders": "container eureka-combined-mod-orders has different resources"}, nil)
+	plan := &models.ApplyPlan{}
+
+	// Act
+	err := run.planModules(nil, nil, plan)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ApplyAction{
+		{Resource: constant.ModuleCategory, Operation: constant.DeployOperation, Name: "mod-users", Reason: "container eureka-combined-mod-users is missing"},
+		{Resource: constant.ModuleCategory, Operation: constant.RedeployOperation, Name: "mod-orders", Reason: "container eureka-combined-mod-orders has different resources"},
+	}, plan.Actions)
+	mockModule.AssertExpectations(t)
+}
+
+func TestPlanModules_UnregisteredModule(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, mockModule := newTestRun(action.Apply)
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run.Config.ModuleProps, run.Config.RegistrySvc = mockModuleProps, mockRegistrySvc
+	run.Config.Action.ConfigProfileName = "combined"
+	run.Config.Action.ConfigApplicationID = "app-combined-1.0.0"
+	backendModules := map[string]models.BackendModule{
+		"mod-orders": {DeployModule: true},
+		"mod-users":  {DeployModule: true},
+	}
+	modules := &models.ProxyModulesByRegistry{FolioModules: []*models.ProxyModule{
+		{ID: "mod-orders-1.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-orders"}},
+		{ID: "mod-users-2.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-users"}},
+	}}
+	descriptor := map[string]any{
+		"id":      "app-combined-1.0.0",
+		"modules": []any{map[string]any{"id": "mod-orders-1.0.0", "name": "mod-orders", "version": "1.0.0"}},
+	}
+	mockModuleProps.On("ReadBackendModules", false, false).Return(backendModules, nil)
+	mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+		{Names: []string{"/eureka-combined-mod-orders"}, State: container.StateRunning},
+	}, nil)
+	mockRegistrySvc.On("GetModules", false).Return(modules, nil)
+	mockRegistrySvc.On("ExtractModuleMetadata", modules).Return()
+	mockModule.On("GetSidecarImage", mock.Anything).Return("folio-module-sidecar:3.0.0", false, nil)
+	mockModule.On("GetModuleDrift", mock.Anything, mock.Anything, "folio-module-sidecar:3.0.0", mock.Anything).Return(map[string]string{}, nil)
+	plan := &models.ApplyPlan{}
+
+	// Act
+	err := run.planModules(nil, descriptor, plan)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ApplyAction{
+		{Resource: constant.ApplicationCategory, Operation: constant.UpdateOperation, Name: "app-combined-1.0.0", Reason: "modules mod-users-2.0.0 are not registered, application and module discovery will be recreated"},
+		{Resource: constant.ModuleCategory, Operation: constant.DeployOperation, Name: "mod-users", Reason: "container eureka-combined-mod-users is missing"},
+	}, plan.Actions)
+	mockModule.AssertExpectations(t)
+}
+
+func TestGetUnregisteredModuleIDs(t *testing.T) {
+	// Arrange
+	moduleVersion := "1.1.0"
+	backendModules := map[string]models.BackendModule{
+		"mod-orders":    {DeployModule: true, ModuleVersion: &moduleVersion},
+		"mod-users":     {DeployModule: true},
+		"mod-inventory": {DeployModule: false},
+	}
+	modules := &models.ProxyModulesByRegistry{
+		FolioModules: []*models.ProxyModule{
+			{ID: "mod-orders-1.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-orders"}},
+			{ID: "mod-users-2.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-users"}},
+			{ID: "mod-inventory-3.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-inventory"}},
+		},
+	}
+	descriptor := map[string]any{"modules": []any{
+		map[string]any{"id": "mod-orders-1.0.0"},
+		map[string]any{"id": "mod-users-2.0.0"},
+	}}
+
+	// Act
+	moduleIDs := getUnregisteredModuleIDs(descriptor, modules, backendModules)
+
+	// Assert
+	assert.Equal(t, []string{"mod-orders-1.1.0"}, moduleIDs)
+}
+
+func TestPlanTenantResources_ApplicationUpdate(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.Apply)
+	run.Config.Action.ConfigApplicationID = "app-combined-1.0.0"
+	run.Config.Action.ConfigTenants = map[string]any{"diku": map[string]any{}}
+	mockManagement.On("GetTenantEntitlements", "diku", false).Return(models.TenantEntitlementResponse{
+		Entitlements: []models.TenantEntitlementDTO{{ApplicationID: "app-combined-1.0.0"}},
+	}, nil)
+	mockKeycloak.On("GetAccessToken", "diku").Return("tenant-token", nil)
+	mockKeycloak.On("GetRoles", mock.Anything).Return([]any{}, nil)
+	mockKeycloak.On("GetUsers", "diku").Return([]any{}, nil)
+	plan := &models.ApplyPlan{Actions: []models.ApplyAction{
+		{Resource: constant.ApplicationCategory, Operation: constant.UpdateOperation, Name: "app-combined-1.0.0"},
+	}}
+
+	// Act
+	err := run.planTenantResources(map[string]string{"diku": "1"}, plan)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, plan.Actions, 2)
+	assert.Equal(t, models.ApplyAction{Resource: constant.EntitlementCategory, Operation: constant.ReentitleOperation, Name: "app-combined-1.0.0", Tenant: "diku", Reason: "entitlement must cover the recreated application modules"}, plan.Actions[1])
+	mockManagement.AssertExpectations(t)
+}
+
+func TestRecreateApplication(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.Apply)
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	run.Config.ModuleProps, run.Config.RegistrySvc = mockModuleProps, mockRegistrySvc
+	run.Config.Action.ConfigApplicationID = "app-combined-1.0.0"
+	backendModules := map[string]models.BackendModule{
+		"mod-orders": {DeployModule: true},
+		"mod-users":  {DeployModule: true},
+	}
+	frontendModules := map[string]models.FrontendModule{}
+	modules := &models.ProxyModulesByRegistry{}
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+	mockManagement.On("GetTenants", "", constant.TenantType(constant.All)).Return([]any{map[string]any{"id": "1", "name": "diku"}}, nil)
+	mockModuleProps.On("ReadBackendModules", false, false).Return(backendModules, nil)
+	mockModuleProps.On("ReadFrontendModules", false).Return(frontendModules, nil)
+	mockRegistrySvc.On("GetModules", false).Return(modules, nil)
+	mockRegistrySvc.On("ExtractModuleMetadata", modules).Return()
+	mock.InOrder(
+		mockManagement.On("RemoveTenantEntitlementForTenant", "1", "diku", false).Return(nil),
+		mockManagement.On("RemoveApplication", "app-combined-1.0.0").Return(nil),
+		mockManagement.On("CreateApplication", mock.MatchedBy(func(extract *models.RegistryExtract) bool {
+			_, ok := extract.BackendModules["mod-users"]
+			return ok && extract.Modules == modules
+		})).Return(nil),
+	)
+
+	// Act
+	err := run.recreateApplication([]models.ApplyAction{
+		{Resource: constant.EntitlementCategory, Operation: constant.EntitleOperation, Name: "app-combined-1.0.0", Tenant: "test"},
+		{Resource: constant.EntitlementCategory, Operation: constant.ReentitleOperation, Name: "app-combined-1.0.0", Tenant: "diku"},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	mockManagement.AssertExpectations(t)
+	mockModuleProps.AssertExpectations(t)
+}
+
+func TestPlanTenants(t *testing.T) {
+	// Arrange
+	run, mockManagement, _, _, _, _ := newTestRun(action.Apply)
+	run.Config.Action.ConfigTenants = map[string]any{"diku": map[string]any{}, "test": map[string]any{}}
+	mockManagement.On("GetTenants", "", constant.TenantType(constant.All)).Return([]any{map[string]any{"id": "1", "name": "diku"}}, nil)
+	plan := &models.ApplyPlan{}
+
+	// Act
+	liveTenants, err := run.planTenants(plan)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"diku": "1"}, liveTenants)
+	assert.Len(t, plan.Actions, 1)
+	assert.Equal(t, models.ApplyAction{Resource: constant.TenantCategory, Operation: constant.CreateOperation, Name: "test", Tenant: "test", Reason: "tenant does not exist"}, plan.Actions[0])
+	mockManagement.AssertExpectations(t)
+}
+
+func TestPlanRolesAndUsers(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Apply)
+	run.Config.Action.ConfigRoles = map[string]any{
+		"admin":  map[string]any{"tenant": "diku"},
+		"reader": map[string]any{"tenant": "diku"},
+		"other":  map[string]any{"tenant": "test"},
+	}
+	run.Config.Action.ConfigUsers = map[string]any{
+		"diku_admin": map[string]any{"tenant": "diku"},
+		"diku_user":  map[string]any{"tenant": "diku"},
+	}
+	plan := &models.ApplyPlan{}
+
+	// Act
+	run.planRolesAndUsers("diku", map[string]bool{"admin": true}, map[string]bool{"diku_admin": true}, plan)
+
+	// Assert
+	assert.Len(t, plan.Actions, 2)
+	assert.Equal(t, constant.RoleCategory, plan.Actions[0].Resource)
+	assert.Equal(t, "reader", plan.Actions[0].Name)
+	assert.Equal(t, constant.UserCategory, plan.Actions[1].Resource)
+	assert.Equal(t, "diku_user", plan.Actions[1].Name)
+}
+
+func TestPrintApplyPlan(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.Apply)
+
+	t.Run("TestPrintApplyPlan_NoChanges", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintApplyPlan(&buf, &models.ApplyPlan{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "No changes")
+	})
+
+	t.Run("TestPrintApplyPlan_WithActions", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		plan := &models.ApplyPlan{Actions: []models.ApplyAction{{Resource: constant.TenantCategory, Operation: constant.CreateOperation, Name: "diku", Tenant: "diku"}}}
+
+		// Act
+		err := run.PrintApplyPlan(&buf, plan)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "OPERATION")
+		assert.Contains(t, buf.String(), "Plan: 1 action(s)")
+	})
+}
+
+func TestApplyRolesAndUsers(t *testing.T) {
+	// Arrange
+	run, _, mockKeycloak, _, _, _ := newTestRun(action.Apply)
+	mockKafka := &MockKafkaSvc{}
+	run.Config.KafkaSvc = mockKafka
+	plan := &models.ApplyPlan{Actions: []models.ApplyAction{
+		{Resource: constant.RoleCategory, Operation: constant.CreateOperation, Name: "reader", Tenant: "diku"},
+		{Resource: constant.UserCategory, Operation: constant.CreateOperation, Name: "diku_user", Tenant: "diku"},
+	}}
+	mockKeycloak.On("GetAccessToken", "diku").Return("tenant-token", nil)
+	mockKeycloak.On("CreateRole", "diku", "reader").Return(nil)
+	mockKafka.On("PollConsumerGroup", "diku").Return(nil)
+	mockKeycloak.On("AttachCapabilitySetsToRole", "diku", "reader").Return(nil)
+	mockKeycloak.On("CreateUser", "diku", "diku_user").Return(nil)
+
+	// Act
+	err := run.applyRolesAndUsers(plan)
+
+	// Assert
+	assert.NoError(t, err)
+	mockKeycloak.AssertExpectations(t)
+	mockKafka.AssertExpectations(t)
+}
+
+func TestPrintValidationReport(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.ValidateConfig)
+	report := &models.ValidationReport{FilePath: "config.combined.yaml", Issues: []models.ValidationIssue{
+		{Severity: constant.ErrorSeverity, Path: "application.port-strat", Line: 3, Column: 3, Message: `unknown key "port-strat"`},
+		{Severity: constant.WarningSeverity, Path: "backend-modules.mod-a.port", Line: 9, Column: 11, Message: "port 9901 is outside the application port range 30000-30999"},
+	}}
+
+	t.Run("TestPrintValidationReport_Table", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintValidationReport(&buf, report, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), `config.combined.yaml:3:3: error: unknown key "port-strat" (application.port-strat)`)
+		assert.Contains(t, buf.String(), "1 error(s), 1 warning(s)")
+	})
+
+	t.Run("TestPrintValidationReport_JSON", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintValidationReport(&buf, report, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		var decoded models.ValidationReport
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, *report, decoded)
+	})
+}
+
+func TestPreflightConfig(t *testing.T) {
+	t.Run("TestPreflightConfig_NoConfigFile", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.Status)
+
+		// Act
+		err := run.PreflightConfig("")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("TestPreflightConfig_WarningsOnly", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.Status)
+		run.Config.ConfigSvc = configsvc.New(run.Config.Action)
+		filePath := filepath.Join(t.TempDir(), "config.test.yaml")
+		content := "application:\n  port-start: 30000\n  port-end: 30999\nbackend-modules:\n  mgr-tenants:\n    port: 9902\n"
+		assert.NoError(t, os.WriteFile(filePath, []byte(content), 0600))
+
+		// Act
+		err := run.PreflightConfig(filePath)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("TestPreflightConfig_Errors", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.Status)
+		run.Config.ConfigSvc = configsvc.New(run.Config.Action)
+		filePath := filepath.Join(t.TempDir(), "config.test.yaml")
+		assert.NoError(t, os.WriteFile(filePath, []byte("tenants:\n  diku:\n    deploy-uii: true\n"), 0600))
+
+		// Act
+		err := run.PreflightConfig(filePath)
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrInvalidInput)
+		assert.Contains(t, err.Error(), "has 1 error(s)")
+	})
+}
+
+func TestShowConfig(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "config.combined.yaml"), []byte("profile:\n  name: combined\napplication:\n  name: app-combined\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "config.ecs.yaml"), []byte("profile:\n  extends: combined\n  name: ecs\n"), 0600))
+	filePath := filepath.Join(dir, "config.ecs.yaml")
+
+	t.Run("TestShowConfig_Raw", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ShowConfig)
+		run.Config.Action.Param = &action.Param{}
+		var buf bytes.Buffer
+
+		// Act
+		err := run.ShowConfig(&buf, filePath)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "profile:\n  extends: combined\n  name: ecs\n", buf.String())
+	})
+
+	t.Run("TestShowConfig_Resolved", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ShowConfig)
+		run.Config.Action.Param = &action.Param{Resolved: true}
+		run.Config.ConfigSvc = configsvc.New(run.Config.Action)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.ShowConfig(&buf, filePath)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "profile:\n  name: ecs\napplication:\n  name: app-combined\n", buf.String())
+	})
+}
+
+func TestPrintProfiles(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.ListProfiles)
+	profiles := []models.ProfileSummary{
+		{Name: "combined", FilePath: "/home/.eureka/config.combined.yaml", Application: "app-combined", PortStart: 30000, PortEnd: 30999},
+		{Name: "export", FilePath: "/home/.eureka/config.export.yaml", Application: "app-export", PortStart: 31000, PortEnd: 31999, ChildApp: true},
+		{Name: "broken", FilePath: "/home/.eureka/config.broken.yaml", Error: "failed to parse config file"},
+	}
+	var buf bytes.Buffer
+
+	// Act
+	err := run.PrintProfiles(&buf, profiles, constant.TableOutput)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "NAME")
+	assert.Regexp(t, `combined\s+app-combined\s+30000-30999\s+false`, buf.String())
+	assert.Regexp(t, `export\s+app-export\s+31000-31999\s+true`, buf.String())
+	assert.Contains(t, buf.String(), "failed to parse config file")
+}
+
+func TestPrintImageReport(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.PullImages)
+	reports := []models.ImageReport{
+		{
+			ModuleImage: models.ModuleImage{Module: "mod-users", Image: "folioorg/mod-users:19.4.0", Version: "19.4.0", RegistryVersion: "19.4.0"},
+			Present:     true,
+			Pulled:      true,
+			Size:        300 * 1024 * 1024,
+			CachedTags:  []string{"19.3.0", "19.3.1"},
+		},
+		{
+			ModuleImage: models.ModuleImage{Module: "mod-orders", Image: "folioorg/mod-orders:13.0.0", Version: "13.0.0", RegistryVersion: "13.1.0"},
+			Stale:       true,
+		},
+	}
+	var buf bytes.Buffer
+
+	// Act
+	err := run.PrintImageReport(&buf, reports, constant.TableOutput)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "MODULE")
+	assert.Regexp(t, `mod-users\s+folioorg/mod-users:19.4.0\s+true\s+true\s+300 MiB\s+no\s+19.3.0, 19.3.1`, buf.String())
+	assert.Regexp(t, `mod-orders\s+folioorg/mod-orders:13.0.0\s+false\s+false\s+-\s+yes \(LSP/FAR 13.1.0\)\s+-`, buf.String())
+}
+
+func TestPrintRegistrySnapshot(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.SnapshotRegistry)
+	run.Config.Action.RegistrySnapshotDir = "/home/user/.eureka/registry-snapshots/R1-2025"
+	snapshot := &models.RegistrySnapshot{
+		Name:            "R1-2025",
+		Platform:        "folio-lsp",
+		PlatformVersion: "R1-2025",
+		Applications:    []string{"app-platform-minimal-1.0.0", "app-platform-complete-1.0.0"},
+		Modules:         120,
+		MissingModules:  []string{"mod-missing-1.0.0"},
+	}
+	var buf bytes.Buffer
+
+	// Act
+	err := run.PrintRegistrySnapshot(&buf, snapshot)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Regexp(t, `Directory\s+/home/user/.eureka/registry-snapshots/R1-2025`, buf.String())
+	assert.Regexp(t, `Platform\s+folio-lsp R1-2025`, buf.String())
+	assert.Regexp(t, `Applications\s+2`, buf.String())
+	assert.Regexp(t, `Modules\s+120`, buf.String())
+	assert.Regexp(t, `Missing modules\s+mod-missing-1.0.0`, buf.String())
+}
+
+func TestPrintLockDiff(t *testing.T) {
+	t.Run("TestPrintLockDiff_Table", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.UpdateLock)
+		diffs := []models.LockDiff{
+			{Name: "mod-orders", Change: constant.LockChangeUpdated, OldVersion: "13.0.0", NewVersion: "13.1.0", OldDigest: "sha256:1111111111111111", NewDigest: "sha256:2222222222222222"},
+			{Name: "mod-snapshot", Change: constant.LockChangeRebuilt, OldVersion: "2.0.0-SNAPSHOT", NewVersion: "2.0.0-SNAPSHOT", OldDigest: "sha256:aaaaaaaaaaaaaaaa", NewDigest: "sha256:bbbbbbbbbbbbbbbb"},
+			{Name: "mod-removed", Change: constant.LockChangeRemoved, OldVersion: "1.0.0"},
+		}
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintLockDiff(&buf, diffs, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Regexp(t, `mod-orders\s+updated\s+13.0.0\s+13.1.0\n`, buf.String())
+		assert.Regexp(t, `mod-snapshot\s+rebuilt\s+2.0.0-SNAPSHOT \(sha256:aaaaaaaaaaaa\)\s+2.0.0-SNAPSHOT \(sha256:bbbbbbbbbbbb\)`, buf.String())
+		assert.Regexp(t, `mod-removed\s+removed\s+1.0.0\s+-`, buf.String())
+	})
+
+	t.Run("TestPrintLockDiff_UpToDate", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.UpdateLock)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintLockDiff(&buf, nil, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Lock file is up to date\n", buf.String())
+	})
+}
+
+func TestLogs(t *testing.T) {
+	t.Run("TestLogs_PrefixesLines", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, mockDocker, mockModule := newTestRun(action.Logs)
+		run.Config.Action.ConfigProfileName = "combined"
+		params.ModuleNames, params.All, params.Sidecar = []string{"mod-orders"}, false, true
+		defer func() { params.ModuleNames, params.Sidecar = nil, false }()
+		containerNames := []string{"eureka-combined-mod-orders", "eureka-combined-mod-orders-sc"}
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return(nil)
+		mockModule.On("GetLogContainers", mock.Anything, []string{"mod-orders"}, true).Return(containerNames, nil)
+		mockModule.On("ReadModuleLogs", mock.Anything, mock.Anything, containerNames, models.LogOptions{}, mock.Anything).Return([]models.LogLine{
+			{Container: "eureka-combined-mod-orders-sc", Text: "Forwarding request"},
+			{Container: "eureka-combined-mod-orders", Text: "Order opened"},
+		}, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.Logs(&buf, models.LogOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "mod-orders-sc | Forwarding request\nmod-orders    | Order opened\n", buf.String())
+		mockModule.AssertExpectations(t)
+	})
+
+	t.Run("TestLogs_ManagementModule", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, mockDocker, mockModule := newTestRun(action.Logs)
+		run.Config.Action.ConfigProfileName = "combined"
+		params.ModuleNames, params.All = []string{"mod-orders", "mgr-tenants"}, false
+		defer func() { params.ModuleNames = nil }()
+		containerNames := []string{"eureka-combined-mod-orders", "eureka-mgr-tenants"}
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return(nil)
+		mockModule.On("GetLogContainers", mock.Anything, []string{"mod-orders", "mgr-tenants"}, false).Return(containerNames, nil)
+		mockModule.On("ReadModuleLogs", mock.Anything, mock.Anything, containerNames, models.LogOptions{}, mock.Anything).Return([]models.LogLine{
+			{Container: "eureka-mgr-tenants", Text: "Tenant created"},
+			{Container: "eureka-combined-mod-orders", Text: "Order opened"},
+		}, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.Logs(&buf, models.LogOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "mgr-tenants | Tenant created\nmod-orders  | Order opened\n", buf.String())
+		mockModule.AssertExpectations(t)
+	})
+
+	t.Run("TestLogs_ContainersNotFound", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, mockDocker, mockModule := newTestRun(action.Logs)
+		params.ModuleNames, params.All = nil, true
+		defer func() { params.All = false }()
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return(nil)
+		mockModule.On("GetLogContainers", mock.Anything, []string(nil), false).Return(nil, errors.LogContainersNotFound(nil))
+
+		// Act
+		err := run.Logs(&bytes.Buffer{}, models.LogOptions{})
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		mockModule.AssertNotCalled(t, "ReadModuleLogs", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetLogOptions(t *testing.T) {
+	defer func() { params.ModuleNames, params.All, params.Level, params.Grep = nil, false, "", "" }()
+
+	t.Run("TestGetLogOptions_ModulesNotSpecified", func(t *testing.T) {
+		params.ModuleNames, params.All = nil, false
+		_, err := getLogOptions()
+		assert.ErrorIs(t, err, errors.ErrInvalidInput)
+	})
+
+	t.Run("TestGetLogOptions_UnsupportedLevel", func(t *testing.T) {
+		params.All, params.Level = true, "verbose"
+		_, err := getLogOptions()
+		assert.ErrorContains(t, err, "verbose")
+	})
+
+	t.Run("TestGetLogOptions_InvalidGrep", func(t *testing.T) {
+		params.All, params.Level, params.Grep = true, "", "order("
+		_, err := getLogOptions()
+		assert.ErrorIs(t, err, errors.ErrInvalidInput)
+	})
+
+	t.Run("TestGetLogOptions_Success", func(t *testing.T) {
+		params.All, params.Level, params.Grep = true, "warn", "order-[0-9]+"
+		options, err := getLogOptions()
+		assert.NoError(t, err)
+		assert.Equal(t, constant.WarnLevel, options.Level)
+		assert.True(t, options.Grep.MatchString("order-42"))
+	})
+}
+
+func TestCreatePortProxy(t *testing.T) {
+	defer func() {
+		params.ModuleName, params.SidecarURL, params.PrivatePort, params.GatewayHostname = "", "", 0, ""
+		params.Restore, params.Foreground = false, false
+	}()
+
+	t.Run("TestCreatePortProxy_Detached", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.CreatePortProxy)
+		mockPortProxySvc := &MockPortProxySvc{}
+		run.Config.PortProxySvc = mockPortProxySvc
+		params.ModuleName, params.SidecarURL, params.PrivatePort, params.GatewayHostname = "mod-orders", "37002", 8081, "host.docker.internal"
+		params.Restore, params.Foreground = false, false
+		expected := &models.PortProxy{Name: "mod-orders-sc", ListenAddress: "mod-orders-sc.eureka:8081", TargetAddress: "host.docker.internal:37002"}
+		mockPortProxySvc.On("StartDetached", expected, mock.MatchedBy(func(args []string) bool {
+			return slices.Contains(args, "--foreground")
+		})).Return(expected, nil)
+
+		// Act
+		err := run.CreatePortProxy()
+
+		// Assert
+		assert.NoError(t, err)
+		mockPortProxySvc.AssertExpectations(t)
+	})
+
+	t.Run("TestCreatePortProxy_Restore", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.CreatePortProxy)
+		mockPortProxySvc := &MockPortProxySvc{}
+		run.Config.PortProxySvc = mockPortProxySvc
+		params.ModuleName, params.SidecarURL, params.Restore = "mod-orders", "", true
+		mockPortProxySvc.On("Stop", "mod-orders-sc").Return(&models.PortProxy{Name: "mod-orders-sc"}, nil)
+
+		// Act
+		err := run.CreatePortProxy()
+
+		// Assert
+		assert.NoError(t, err)
+		mockPortProxySvc.AssertExpectations(t)
+	})
+
+	t.Run("TestCreatePortProxy_SidecarURLBlank", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.CreatePortProxy)
+		params.ModuleName, params.SidecarURL, params.Restore = "mod-orders", "", false
+
+		// Act
+		err := run.CreatePortProxy()
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrInvalidInput)
+	})
+}
+
+func TestPrintPortProxies(t *testing.T) {
+	t.Run("TestPrintPortProxies_Table", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ListPortProxies)
+		proxies := []models.PortProxy{
+			{Name: "mod-orders-sc", ListenAddress: "mod-orders-sc.eureka:8081", TargetAddress: "host.docker.internal:37002", PID: 4242, Running: true},
+			{Name: "mod-users-sc", ListenAddress: "mod-users-sc.eureka:8081", TargetAddress: "host.docker.internal:37010", PID: 4343},
+		}
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintPortProxies(&buf, proxies, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Regexp(t, `mod-orders-sc\s+mod-orders-sc.eureka:8081\s+host.docker.internal:37002\s+4242\s+running`, buf.String())
+		assert.Regexp(t, `mod-users-sc\s+mod-users-sc.eureka:8081\s+host.docker.internal:37010\s+4343\s+stopped`, buf.String())
+	})
+
+	t.Run("TestPrintPortProxies_EmptyJSON", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ListPortProxies)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintPortProxies(&buf, nil, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "[]\n", buf.String())
+	})
+}
+
+func TestPrintTopics(t *testing.T) {
+	t.Run("TestPrintTopics_Table", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ListTopics)
+		topics := []models.KafkaTopicSummary{{Name: "folio.diku.inventory.instance", Tenant: "diku", Partitions: 50, Messages: 12}}
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintTopics(&buf, topics, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Regexp(t, `TOPIC\s+TENANT\s+PARTITIONS\s+MESSAGES`, buf.String())
+		assert.Regexp(t, `folio.diku.inventory.instance\s+diku\s+50\s+12`, buf.String())
+	})
+
+	t.Run("TestPrintTopics_EmptyJSON", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ListTopics)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintTopics(&buf, nil, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "[]\n", buf.String())
+	})
+}
+
+func TestPrintConsumerGroup(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.DescribeConsumerGroup)
+	group := &models.KafkaConsumerGroupDescription{
+		KafkaConsumerGroup: models.KafkaConsumerGroup{GroupID: "folio-mod-roles-keycloak-capability-group", State: constant.KafkaGroupStateStable},
+		Lag:                6,
+		Offsets: []models.KafkaPartitionOffset{
+			{Topic: "folio.diku.mgr-tenant-entitlements.capability", Partition: 0, CommittedOffset: 4, EndOffset: 10, Lag: 6, ClientID: "mod-roles-keycloak"},
+			{Topic: "folio.diku.mgr-tenant-entitlements.capability", Partition: 1, CommittedOffset: -1, EndOffset: 2, Lag: -1},
+		},
+	}
+	var buf bytes.Buffer
+
+	// Act
+	err := run.PrintConsumerGroup(&buf, group, constant.TableOutput)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "State: Stable")
+	assert.Contains(t, buf.String(), "Lag: 6")
+	assert.Regexp(t, `capability\s+0\s+4\s+10\s+6\s+mod-roles-keycloak`, buf.String())
+	assert.Regexp(t, `capability\s+1\s+-\s+2\s+-\s+-`, buf.String())
+}
+
+func TestResetOffsets(t *testing.T) {
+	t.Run("TestResetOffsets_ToEarliest", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ResetOffsets)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		params.Group, params.Tenant, params.ToEarliest, params.ToLatest = "folio-group", "diku", true, false
+		defer func() {
+			params.Group, params.Tenant, params.ToEarliest = "", "", false
+		}()
+		mockKafka.On("ResetOffsets", "folio-group", "diku", int64(constant.KafkaOffsetEarliest)).
+			Return([]models.KafkaOffsetReset{{Topic: "folio.diku.inventory.instance", Partition: 0, PreviousOffset: 12, NewOffset: 2}}, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.ResetOffsets(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Regexp(t, `folio.diku.inventory.instance\s+0\s+12\s+2`, buf.String())
+		mockKafka.AssertExpectations(t)
+	})
+
+	t.Run("TestResetOffsets_Error", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ResetOffsets)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		params.Group, params.ToLatest = "folio-group", true
+		defer func() {
+			params.Group, params.ToLatest = "", false
+		}()
+		expectedErr := errors.ConsumerGroupActive("folio-group", constant.KafkaGroupStateStable, 1)
+		mockKafka.On("ResetOffsets", "folio-group", "", int64(constant.KafkaOffsetLatest)).Return(nil, expectedErr)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.ResetOffsets(&buf)
+
+		// Assert
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, buf.String())
+		mockKafka.AssertExpectations(t)
+	})
+}
+
+func TestTailTopic(t *testing.T) {
+	t.Run("TestTailTopic_PrettyPrintsMessages", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.TailTopic)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		params.Topic, params.Tenant = "inventory.instance", "diku"
+		defer func() {
+			params.Topic, params.Tenant = "", ""
+		}()
+		records := []models.KafkaRecord{
+			{
+				Topic:     "folio.diku.inventory.instance",
+				Partition: 1,
+				Offset:    7,
+				Timestamp: time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC),
+				Key:       []byte("a1"),
+				Value:     []byte(`{"type":"UPDATE"}`),
+				Headers:   []models.KafkaRecordHeader{{Key: "x-okapi-tenant", Value: []byte("diku")}},
+			},
+			{Topic: "folio.diku.inventory.instance", Partition: 0, Offset: 3, Timestamp: time.Date(2025, 1, 2, 15, 4, 6, 0, time.UTC), Value: []byte("plain text")},
+			{Topic: "folio.diku.inventory.instance", Partition: 0, Offset: 4, Timestamp: time.Date(2025, 1, 2, 15, 4, 7, 0, time.UTC)},
+		}
+		mockKafka.On("GetTopicName", "inventory.instance", "diku").Return("folio.diku.inventory.instance")
+		mockKafka.On("TailTopic", "folio.diku.inventory.instance", false).Return(records, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.TailTopic(&buf, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "--- folio.diku.inventory.instance [1] offset 7 at 2025-01-02T15:04:05Z\n")
+		assert.Contains(t, buf.String(), "Key: a1\nHeaders: x-okapi-tenant=diku\n{\n  \"type\": \"UPDATE\"\n}\n")
+		assert.Contains(t, buf.String(), "offset 3 at 2025-01-02T15:04:06Z\nplain text\n")
+		assert.Contains(t, buf.String(), "offset 4 at 2025-01-02T15:04:07Z\n<null>\n")
+		mockKafka.AssertExpectations(t)
+	})
+
+	t.Run("TestTailTopic_Filter", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.TailTopic)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		params.Topic, params.FromBeginning = "folio.diku.inventory.instance", true
+		defer func() {
+			params.Topic, params.FromBeginning = "", false
+		}()
+		records := []models.KafkaRecord{
+			{Topic: "folio.diku.inventory.instance", Offset: 1, Value: []byte(`{"type":"CREATE"}`)},
+			{Topic: "folio.diku.inventory.instance", Offset: 2, Value: []byte(`{"type":"UPDATE"}`)},
+			{Topic: "folio.diku.inventory.instance", Offset: 3, Value: []byte("not json")},
+		}
+		mockKafka.On("GetTopicName", "folio.diku.inventory.instance", "").Return("folio.diku.inventory.instance")
+		mockKafka.On("TailTopic", "folio.diku.inventory.instance", true).Return(records, nil)
+		filter, err := helpers.ParseJSONPathFilter("$.type == 'UPDATE'")
+		assert.NoError(t, err)
+		var buf bytes.Buffer
+
+		// Act
+		err = run.TailTopic(&buf, filter)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "offset 2 at")
+		assert.NotContains(t, buf.String(), "offset 1 at")
+		assert.NotContains(t, buf.String(), "offset 3 at")
+		mockKafka.AssertExpectations(t)
+	})
+}
+
+func TestPublishMessage(t *testing.T) {
+	t.Run("TestPublishMessage_Success", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.PublishMessage)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		fileName := filepath.Join(t.TempDir(), "event.json")
+		assert.NoError(t, os.WriteFile(fileName, []byte(`{"type":"CREATE"}`), 0600))
+		params.Topic, params.Tenant, params.File = "inventory.instance", "diku", fileName
+		defer func() {
+			params.Topic, params.Tenant, params.File = "", "", ""
+		}()
+		record := models.KafkaRecord{Topic: "folio.diku.inventory.instance", Partition: -1, Value: []byte(`{"type":"CREATE"}`)}
+		mockKafka.On("GetTopicName", "inventory.instance", "diku").Return("folio.diku.inventory.instance")
+		mockKafka.On("PublishMessage", record).Return(&models.KafkaRecord{Topic: record.Topic, Partition: 2, Offset: 15}, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PublishMessage(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Published message to folio.diku.inventory.instance [2] at offset 15\n", buf.String())
+		mockKafka.AssertExpectations(t)
+	})
+
+	t.Run("TestPublishMessage_KeyAndHeaders", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.PublishMessage)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		fileName := filepath.Join(t.TempDir(), "event.json")
+		assert.NoError(t, os.WriteFile(fileName, []byte(`{"type":"CREATE"}`), 0600))
+		params.Topic, params.File, params.Key, params.Headers = "inventory.instance", fileName, "a1", []string{"X-Okapi-Tenant=diku", "X-Okapi-Url=http://kong:8000?a=b"}
+		defer func() {
+			params.Topic, params.File, params.Key, params.Headers = "", "", "", nil
+		}()
+		record := models.KafkaRecord{
+			Topic:     "folio.inventory.instance",
+			Partition: -1,
+			Key:       []byte("a1"),
+			Value:     []byte(`{"type":"CREATE"}`),
+			Headers: []models.KafkaRecordHeader{
+				{Key: "X-Okapi-Tenant", Value: []byte("diku")},
+				{Key: "X-Okapi-Url", Value: []byte("http://kong:8000?a=b")},
+			},
+		}
+		mockKafka.On("GetTopicName", "inventory.instance", "").Return("folio.inventory.instance")
+		mockKafka.On("PublishMessage", record).Return(&models.KafkaRecord{Topic: record.Topic, Partition: 1, Offset: 3}, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PublishMessage(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Published message to folio.inventory.instance [1] at offset 3\n", buf.String())
+		mockKafka.AssertExpectations(t)
+	})
+
+	t.Run("TestPublishMessage_InvalidHeader", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.PublishMessage)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		fileName := filepath.Join(t.TempDir(), "event.json")
+		assert.NoError(t, os.WriteFile(fileName, []byte(`{"type":"CREATE"}`), 0600))
+		params.Topic, params.File, params.Headers = "inventory.instance", fileName, []string{"X-Okapi-Tenant"}
+		defer func() {
+			params.Topic, params.File, params.Headers = "", "", nil
+		}()
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PublishMessage(&buf)
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrInvalidInput)
+		mockKafka.AssertNotCalled(t, "PublishMessage", mock.Anything)
+	})
+
+	t.Run("TestPublishMessage_NotJSON", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.PublishMessage)
+		mockKafka := &MockKafkaSvc{}
+		run.Config.KafkaSvc = mockKafka
+		fileName := filepath.Join(t.TempDir(), "event.json")
+		assert.NoError(t, os.WriteFile(fileName, []byte("{broken"), 0600))
+		params.Topic, params.File = "inventory.instance", fileName
+		defer func() {
+			params.Topic, params.File = "", ""
+		}()
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PublishMessage(&buf)
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrInvalidInput)
+		assert.Empty(t, buf.String())
+		mockKafka.AssertNotCalled(t, "PublishMessage", mock.Anything)
+	})
+}
+
+func TestVaultList(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.VaultList)
+	mockVaultSvc := &MockVaultSvc{}
+	run.Config.VaultSvc = mockVaultSvc
+	params.Path = "folio"
+	defer func() { params.Path = "" }()
+	mockVaultSvc.On("ListSecrets", "folio").Return([]string{"consortium/", "diku"}, nil)
+	var buf bytes.Buffer
+
+	// Act
+	err := run.VaultList(&buf)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "consortium/\ndiku\n", buf.String())
+	mockVaultSvc.AssertExpectations(t)
+}
+
+func TestVaultGet(t *testing.T) {
+	secret := map[string]any{"diku_admin": "admin", "mod-users": "s3cr3t"}
+
+	t.Run("TestVaultGet_Masked", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.VaultGet)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Path, params.Output = "folio/diku", constant.TableOutput
+		defer func() { params.Path, params.Output = "", "" }()
+		mockVaultSvc.On("GetSecret", "folio/diku").Return(secret, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.VaultGet(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "KEY")
+		assert.Contains(t, buf.String(), "mod-users")
+		assert.Contains(t, buf.String(), "********")
+		assert.NotContains(t, buf.String(), "s3cr3t")
+	})
+
+	t.Run("TestVaultGet_Reveal", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.VaultGet)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Path, params.Output, params.Reveal = "folio/diku", constant.JSONOutput, true
+		defer func() { params.Path, params.Output, params.Reveal = "", "", false }()
+		mockVaultSvc.On("GetSecret", "folio/diku").Return(secret, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.VaultGet(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		var values map[string]string
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &values))
+		assert.Equal(t, map[string]string{"diku_admin": "admin", "mod-users": "s3cr3t"}, values)
+	})
+
+	t.Run("TestVaultGet_Key", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.VaultGet)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Path, params.Key, params.Reveal = "folio/diku", "mod-users", true
+		defer func() { params.Path, params.Key, params.Reveal = "", "", false }()
+		mockVaultSvc.On("GetSecret", "folio/diku").Return(secret, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.VaultGet(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "s3cr3t\n", buf.String())
+	})
+
+	t.Run("TestVaultGet_KeyNotFound", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.VaultGet)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Path, params.Key = "folio/diku", "missing"
+		defer func() { params.Path, params.Key = "", "" }()
+		mockVaultSvc.On("GetSecret", "folio/diku").Return(secret, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.VaultGet(&buf)
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestVaultSet(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.VaultSet)
+	mockVaultSvc := &MockVaultSvc{}
+	run.Config.VaultSvc = mockVaultSvc
+	params.Path, params.Key, params.Value = "folio/diku", "mod-users", "s3cr3t"
+	defer func() { params.Path, params.Key, params.Value = "", "", "" }()
+	mockVaultSvc.On("SetSecretKeys", "folio/diku", map[string]any{"mod-users": "s3cr3t"}).Return(nil)
+
+	// Act
+	err := run.VaultSet()
+
+	// Assert
+	assert.NoError(t, err)
+	mockVaultSvc.AssertExpectations(t)
+}
+
+func TestVaultDelete(t *testing.T) {
+	t.Run("TestVaultDelete_Secret", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.VaultDelete)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Path = "folio/diku"
+		defer func() { params.Path = "" }()
+		mockVaultSvc.On("DeleteSecret", "folio/diku").Return(nil)
+
+		// Act
+		err := run.VaultDelete()
+
+		// Assert
+		assert.NoError(t, err)
+		mockVaultSvc.AssertExpectations(t)
+		mockVaultSvc.AssertNotCalled(t, "DeleteSecretKey", mock.Anything, mock.Anything)
+	})
+
+	t.Run("TestVaultDelete_Key", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.VaultDelete)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Path, params.Key = "folio/diku", "mod-users"
+		defer func() { params.Path, params.Key = "", "" }()
+		mockVaultSvc.On("DeleteSecretKey", "folio/diku", "mod-users").Return(nil)
+
+		// Act
+		err := run.VaultDelete()
+
+		// Assert
+		assert.NoError(t, err)
+		mockVaultSvc.AssertExpectations(t)
+		mockVaultSvc.AssertNotCalled(t, "DeleteSecret", mock.Anything)
+	})
+}
+
+func TestRepairSystemUser(t *testing.T) {
+	t.Run("TestRepairSystemUser_Success", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.RepairSystemUser)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Tenant, params.Usernames = "diku", []string{"mod-users", "mod-orders"}
+		defer func() { params.Tenant, params.Usernames = "", nil }()
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+		mockKeycloak.On("ResetUserPassword", "diku", "mod-users", mock.AnythingOfType("string")).Return(nil)
+		mockKeycloak.On("ResetUserPassword", "diku", "mod-orders", mock.AnythingOfType("string")).Return(errors.UserNotFound("mod-orders", "diku"))
+		var secrets map[string]any
+		mockVaultSvc.On("SetSecretKeys", "folio/diku", mock.Anything).
+			Run(func(args mock.Arguments) { secrets = args.Get(1).(map[string]any) }).
+			Return(nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.RepairSystemUser(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, secrets, 2)
+		assert.NotEmpty(t, secrets["mod-users"])
+		assert.NotEqual(t, secrets["mod-users"], secrets["mod-orders"])
+		assert.Contains(t, buf.String(), "Password reset")
+		assert.Contains(t, buf.String(), "user mod-orders in tenant diku")
+		assert.NotContains(t, buf.String(), secrets["mod-users"])
+		mockKeycloak.AssertExpectations(t)
+		mockVaultSvc.AssertExpectations(t)
+	})
+
+	t.Run("TestRepairSystemUser_VaultError", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.RepairSystemUser)
+		mockVaultSvc := &MockVaultSvc{}
+		run.Config.VaultSvc = mockVaultSvc
+		params.Tenant, params.Usernames = "diku", []string{"mod-users"}
+		defer func() { params.Tenant, params.Usernames = "", nil }()
+		expectedErr := errors.New("permission denied")
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+		mockKeycloak.On("ResetUserPassword", "diku", "mod-users", mock.AnythingOfType("string")).Return(nil)
+		mockVaultSvc.On("SetSecretKeys", "folio/diku", mock.Anything).Return(expectedErr)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.RepairSystemUser(&buf)
+
+		// Assert
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestPrintPasswordResets(t *testing.T) {
+	resets := []models.KeycloakPasswordReset{{Username: "mod-users", Password: "s3cr3t"}}
+
+	t.Run("TestPrintPasswordResets_Masked", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.RepairSystemUser)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintPasswordResets(&buf, resets, false)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "USERNAME")
+		assert.Contains(t, buf.String(), "********")
+		assert.NotContains(t, buf.String(), "s3cr3t")
+	})
+
+	t.Run("TestPrintPasswordResets_Reveal", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.RepairSystemUser)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintPasswordResets(&buf, resets, true)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "s3cr3t")
+	})
+}
+
+func TestMigrateConsortiumUsers(t *testing.T) {
+	consortiumTenants := models.SortedConsortiumTenants{
+		&models.SortedConsortiumTenant{Name: "ecs", IsCentral: 1},
+		&models.SortedConsortiumTenant{Name: "university1", IsCentral: 0},
+	}
+
+	t.Run("TestMigrateConsortiumUsers_Success", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.MigrateConsortiumUsers)
+		mockConsortium := &MockConsortiumSvc{}
+		run.Config.ConsortiumSvc = mockConsortium
+		run.Config.Action.ConfigConsortiums = map[string]any{"ecs": map[string]any{}, "ecs2": map[string]any{}}
+		params.Consortium, params.Delete, params.Output = "ecs", true, constant.TableOutput
+		defer func() { params.Consortium, params.Delete, params.Output = "", false, "" }()
+		mockConsortium.On("GetConsortiumCentralTenant", "ecs").Return("ecs")
+		mockKeycloak.On("GetAccessToken", "ecs").Return("access-token", nil)
+		mockConsortium.On("GetConsortiumByName", "ecs", "ecs").Return(map[string]any{"id": "consortium-123", "name": "ecs"}, nil)
+		mockConsortium.On("GetSortedConsortiumTenants", "ecs").Return(consortiumTenants)
+		mockConsortium.On("MigrateConsortiumUsers", "ecs", "consortium-123", consortiumTenants, true).
+			Return([]models.ConsortiumUserMigration{{Tenant: "university1", Users: helpers.IntPtr(3), Status: constant.UserDeleted}})
+		var buf bytes.Buffer
+
+		// Act
+		err := run.MigrateConsortiumUsers(&buf)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "access-token", run.Config.Action.KeycloakAccessToken)
+		assert.Contains(t, buf.String(), "USERS")
+		assert.Contains(t, buf.String(), "university1  3")
+		assert.Contains(t, buf.String(), constant.UserDeleted)
+		mockConsortium.AssertExpectations(t)
+		mockConsortium.AssertNotCalled(t, "GetConsortiumCentralTenant", "ecs2")
+	})
+
+	t.Run("TestMigrateConsortiumUsers_Failed", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.MigrateConsortiumUsers)
+		mockConsortium := &MockConsortiumSvc{}
+		run.Config.ConsortiumSvc = mockConsortium
+		run.Config.Action.ConfigConsortiums = map[string]any{"ecs": map[string]any{}}
+		params.Output = constant.JSONOutput
+		defer func() { params.Output = "" }()
+		mockConsortium.On("GetConsortiumCentralTenant", "ecs").Return("ecs")
+		mockKeycloak.On("GetAccessToken", "ecs").Return("access-token", nil)
+		mockConsortium.On("GetConsortiumByName", "ecs", "ecs").Return(map[string]any{"id": "consortium-123", "name": "ecs"}, nil)
+		mockConsortium.On("GetSortedConsortiumTenants", "ecs").Return(consortiumTenants)
+		mockConsortium.On("MigrateConsortiumUsers", "ecs", "consortium-123", consortiumTenants, false).
+			Return([]models.ConsortiumUserMigration{
+				{Tenant: "university1", Users: helpers.IntPtr(1), Status: constant.UserMigrated},
+				{Tenant: "university2", Status: constant.UserMigrationFailed, Error: "internal server error"},
+			})
+		var buf bytes.Buffer
+
+		// Act
+		err := run.MigrateConsortiumUsers(&buf)
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrDeploymentFailed)
+		var results []models.ConsortiumUserMigration
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &results))
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("TestMigrateConsortiumUsers_ConsortiumNotConfigured", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.MigrateConsortiumUsers)
+		mockConsortium := &MockConsortiumSvc{}
+		run.Config.ConsortiumSvc = mockConsortium
+		run.Config.Action.ConfigConsortiums = map[string]any{"ecs": map[string]any{}}
+		params.Consortium = "missing"
+		defer func() { params.Consortium = "" }()
+		var buf bytes.Buffer
+
+		// Act
+		err := run.MigrateConsortiumUsers(&buf)
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		mockConsortium.AssertNotCalled(t, "MigrateConsortiumUsers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("TestMigrateConsortiumUsers_ConsortiumNotCreated", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.MigrateConsortiumUsers)
+		mockConsortium := &MockConsortiumSvc{}
+		run.Config.ConsortiumSvc = mockConsortium
+		run.Config.Action.ConfigConsortiums = map[string]any{"ecs": map[string]any{}}
+		mockConsortium.On("GetConsortiumCentralTenant", "ecs").Return("ecs")
+		mockKeycloak.On("GetAccessToken", "ecs").Return("access-token", nil)
+		mockConsortium.On("GetConsortiumByName", "ecs", "ecs").Return(nil, nil)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.MigrateConsortiumUsers(&buf)
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		assert.Empty(t, buf.String())
+	})
+}
+
+func TestSnapshotSave(t *testing.T) {
+	t.Run("TestSnapshotSave_Success", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, mockDocker, _ := newTestRun(action.SnapshotSave)
+		mockSnapshot := &MockSnapshotSvc{}
+		mockLock := &MockLockSvc{}
+		run.Config.SnapshotSvc, run.Config.LockSvc = mockSnapshot, mockLock
+		run.Config.Action.ConfigProfileName = "combined"
+		lock := &models.Lock{Profile: "combined", Modules: []models.LockedModule{{ID: "mod-users-19.3.0", Name: "mod-users"}}}
+		capturedLock := &models.Lock{Profile: "combined", Modules: []models.LockedModule{{ID: "mod-users-19.4.0", Name: "mod-users", Image: "folioorg/mod-users:19.4.0"}}}
+		consumerGroups := []models.SnapshotConsumerGroup{{GroupID: "folio-mod-users", Offsets: []models.SnapshotPartitionOffset{{Topic: "folio.diku.users", Offset: 7}}}}
+		mockLock.On("ReadLock").Return(lock, nil)
+		mockSnapshot.On("CreateSnapshotDir", "before-upgrade").Return("/snapshots/before-upgrade", nil)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockLock.On("CaptureLock", mock.Anything, lock).Return(capturedLock, nil)
+		mockSnapshot.On("DumpDatabases", mock.Anything, "/snapshots/before-upgrade").Return([]string{"folio", "keycloak", "kong"}, nil)
+		mockSnapshot.On("SaveSecrets", "/snapshots/before-upgrade").Return(12, nil)
+		mockSnapshot.On("SaveConsumerGroupOffsets").Return(consumerGroups, nil)
+		mockSnapshot.On("WriteManifest", "/snapshots/before-upgrade", mock.AnythingOfType("*models.EnvironmentSnapshot")).Return(nil)
+
+		// Act
+		snapshot, err := run.SnapshotSave("before-upgrade")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "before-upgrade", snapshot.Name)
+		assert.Equal(t, "combined", snapshot.Profile)
+		assert.Equal(t, []string{"folio", "keycloak", "kong"}, snapshot.Databases)
+		assert.Equal(t, 12, snapshot.Secrets)
+		assert.Equal(t, consumerGroups, snapshot.ConsumerGroups)
+		assert.Same(t, capturedLock, snapshot.Lock)
+		mockSnapshot.AssertExpectations(t)
+	})
+
+	t.Run("TestSnapshotSave_LockNotFound", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, mockDocker, _ := newTestRun(action.SnapshotSave)
+		mockSnapshot := &MockSnapshotSvc{}
+		mockLock := &MockLockSvc{}
+		run.Config.SnapshotSvc, run.Config.LockSvc = mockSnapshot, mockLock
+		capturedLock := &models.Lock{Profile: "combined", Modules: []models.LockedModule{{ID: "mod-users-19.4.0", Name: "mod-users", Image: "folioorg/mod-users:19.4.0"}}}
+		mockLock.On("ReadLock").Return(nil, errors.LockNotFound("eureka.combined.lock"))
+		mockSnapshot.On("CreateSnapshotDir", "before-upgrade").Return("/snapshots/before-upgrade", nil)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockLock.On("CaptureLock", mock.Anything, (*models.Lock)(nil)).Return(capturedLock, nil)
+		mockSnapshot.On("DumpDatabases", mock.Anything, "/snapshots/before-upgrade").Return([]string{"folio"}, nil)
+		mockSnapshot.On("SaveSecrets", "/snapshots/before-upgrade").Return(0, nil)
+		mockSnapshot.On("SaveConsumerGroupOffsets").Return([]models.SnapshotConsumerGroup{}, nil)
+		mockSnapshot.On("WriteManifest", "/snapshots/before-upgrade", mock.AnythingOfType("*models.EnvironmentSnapshot")).Return(nil)
+
+		// Act
+		snapshot, err := run.SnapshotSave("before-upgrade")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Same(t, capturedLock, snapshot.Lock)
+		mockSnapshot.AssertExpectations(t)
+	})
+
+	t.Run("TestSnapshotSave_LockReadFailed", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.SnapshotSave)
+		mockSnapshot := &MockSnapshotSvc{}
+		mockLock := &MockLockSvc{}
+		run.Config.SnapshotSvc, run.Config.LockSvc = mockSnapshot, mockLock
+		mockLock.On("ReadLock").Return(nil, errors.LockReadFailed("eureka.combined.lock", io.ErrUnexpectedEOF))
+
+		// Act
+		snapshot, err := run.SnapshotSave("before-upgrade")
+
+		// Assert
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Nil(t, snapshot)
+		mockSnapshot.AssertNotCalled(t, "CreateSnapshotDir", mock.Anything)
+	})
+}
+
+func TestSnapshotRestore_ProfileMismatch(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, _ := newTestRun(action.SnapshotRestore)
+	mockSnapshot := &MockSnapshotSvc{}
+	run.Config.SnapshotSvc = mockSnapshot
+	run.Config.Action.ConfigProfileName = "ecs"
+	mockSnapshot.On("ReadManifest", "before-upgrade").Return(&models.EnvironmentSnapshot{Name: "before-upgrade", Profile: "combined"}, nil)
+
+	// Act
+	snapshot, err := run.SnapshotRestore("before-upgrade")
+
+	// Assert
+	assert.Equal(t, errors.EnvironmentSnapshotProfileMismatch("before-upgrade", "combined", "ecs"), err)
+	assert.Nil(t, snapshot)
+	mockDocker.AssertNotCalled(t, "Create")
+	mockSnapshot.AssertNotCalled(t, "RestoreDatabases", mock.Anything, mock.Anything)
+}
+
+func TestGetSnapshotModuleNames(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.SnapshotRestore)
+	run.Config.Action.ConfigBackendModules = map[string]any{"mod-users": map[string]any{"port": 9904}, "mod-orders": nil}
+	lock := &models.Lock{Modules: []models.LockedModule{
+		{ID: "folio_users-12.0.0", Name: "folio_users", Version: "12.0.0"},
+		{ID: "mgr-tenants-3.0.0", Name: "mgr-tenants", Version: "3.0.0", Image: "folioorg/mgr-tenants:3.0.0"},
+		{ID: "mod-finance-5.0.0", Name: "mod-finance", Version: "5.0.0", Image: "folioorg/mod-finance:5.0.0"},
+		{ID: "mod-users-19.4.0", Name: "mod-users", Version: "19.4.0", Image: "folioorg/mod-users:19.4.0"},
+	}}
+
+	// Act
+	moduleNames := run.getSnapshotModuleNames(lock)
+
+	// Assert
+	assert.Equal(t, []string{"mod-finance", "mod-users"}, moduleNames)
+	assert.Contains(t, run.Config.Action.ConfigBackendModules, "mod-finance")
+	assert.Nil(t, run.Config.Action.ConfigBackendModules["mod-finance"])
+	assert.Equal(t, map[string]any{"port": 9904}, run.Config.Action.ConfigBackendModules["mod-users"])
+}
+
+func TestPrintEnvironmentSnapshots(t *testing.T) {
+	snapshots := []models.EnvironmentSnapshotSummary{{
+		Name:      "before-upgrade",
+		Profile:   "combined",
+		CreatedAt: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		Size:      3 * 1024 * 1024,
+	}}
+
+	t.Run("TestPrintEnvironmentSnapshots_Table", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.SnapshotList)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintEnvironmentSnapshots(&buf, snapshots, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buf.String(), "NAME")
+		assert.Contains(t, buf.String(), "before-upgrade")
+		assert.Contains(t, buf.String(), "3 MiB")
+	})
+
+	t.Run("TestPrintEnvironmentSnapshots_JSON", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.SnapshotList)
+		var buf bytes.Buffer
+
+		// Act
+		err := run.PrintEnvironmentSnapshots(&buf, snapshots, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		var decoded []models.EnvironmentSnapshotSummary
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, snapshots, decoded)
+	})
+}
+
+func TestExportTenantData(t *testing.T) {
+	t.Run("TestExportTenantData_Success", func(t *testing.T) {
+		// Arrange
+		run, mockManagement, mockKeycloak, _, mockDocker, _ := newTestRun(action.ExportTenantData)
+		mockSnapshot := &MockSnapshotSvc{}
+		run.Config.SnapshotSvc = mockSnapshot
+		archive := &models.TenantDataArchive{Tenant: "diku", Schemas: []models.TenantDataSchema{{Name: "diku_mod_users", ModuleID: "mod-users-19.4.0"}}}
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+		mockManagement.On("GetTenantEntitlements", "diku", true).Return(models.TenantEntitlementResponse{
+			Entitlements: []models.TenantEntitlementDTO{
+				{ApplicationID: "app-combined-1.0.0", TenantID: "1", Modules: []string{"mod-users-19.4.0"}},
+				{ApplicationID: "app-edge-1.0.0", TenantID: "1", Modules: []string{"edge-oai-pmh-2.9.0"}},
+			},
+		}, nil)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockSnapshot.On("ExportTenantData", mock.Anything, "diku", []string{"mod-users-19.4.0", "edge-oai-pmh-2.9.0"}, "diku.tar.gz").Return(archive, nil)
+
+		// Act
+		result, filePath, err := run.ExportTenantData("diku", "diku.tar.gz")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Same(t, archive, result)
+		assert.Equal(t, "diku.tar.gz", filePath)
+		mockSnapshot.AssertExpectations(t)
+	})
+
+	t.Run("TestExportTenantData_DefaultFile", func(t *testing.T) {
+		// Arrange
+		run, mockManagement, mockKeycloak, _, mockDocker, _ := newTestRun(action.ExportTenantData)
+		mockSnapshot := &MockSnapshotSvc{}
+		run.Config.SnapshotSvc = mockSnapshot
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+		mockManagement.On("GetTenantEntitlements", "diku", true).Return(models.TenantEntitlementResponse{
+			Entitlements: []models.TenantEntitlementDTO{{ApplicationID: "app-combined-1.0.0", TenantID: "1"}},
+		}, nil)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockSnapshot.On("ExportTenantData", mock.Anything, "diku", []string{}, mock.AnythingOfType("string")).Return(&models.TenantDataArchive{Tenant: "diku"}, nil)
+
+		// Act
+		_, filePath, err := run.ExportTenantData("diku", "")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Regexp(t, `^diku-\d{14}\.tar\.gz$`, filePath)
+	})
+
+	t.Run("TestExportTenantData_NotEntitled", func(t *testing.T) {
+		// Arrange
+		run, mockManagement, mockKeycloak, _, mockDocker, _ := newTestRun(action.ExportTenantData)
+		mockSnapshot := &MockSnapshotSvc{}
+		run.Config.SnapshotSvc = mockSnapshot
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+		mockManagement.On("GetTenantEntitlements", "diku", true).Return(models.TenantEntitlementResponse{}, nil)
+
+		// Act
+		result, _, err := run.ExportTenantData("diku", "diku.tar.gz")
+
+		// Assert
+		assert.Equal(t, errors.TenantNotEntitled("diku"), err)
+		assert.Nil(t, result)
+		mockDocker.AssertNotCalled(t, "Create")
+	})
+}
+
+func TestImportTenantData(t *testing.T) {
+	t.Run("TestImportTenantData_Success", func(t *testing.T) {
+		// Arrange
+		run, mockManagement, mockKeycloak, _, mockDocker, _ := newTestRun(action.ImportTenantData)
+		mockSnapshot := &MockSnapshotSvc{}
+		run.Config.SnapshotSvc = mockSnapshot
+		archive := &models.TenantDataArchive{Tenant: "diku", Schemas: []models.TenantDataSchema{{Name: "diku_mod_users", ModuleID: "mod-users-19.3.0"}}}
+		moduleIDs := []string{"mod-users-19.4.0"}
+		mockSnapshot.On("ReadTenantDataArchive", "diku.tar.gz").Return(archive, nil)
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+		mockManagement.On("GetTenantEntitlements", "fs09000000", true).Return(models.TenantEntitlementResponse{
+			Entitlements: []models.TenantEntitlementDTO{{ApplicationID: "app-combined-1.0.0", TenantID: "1", Modules: moduleIDs}},
+		}, nil)
+		mockSnapshot.On("GetTenantDataModuleMismatches", archive, moduleIDs).Return([]models.TenantDataModuleMismatch{
+			{Schema: "diku_mod_users", ArchiveModuleID: "mod-users-19.3.0", TenantModuleID: "mod-users-19.4.0"},
+		})
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockSnapshot.On("ImportTenantData", mock.Anything, "fs09000000", "diku.tar.gz", archive).Return(nil)
+
+		// Act
+		result, err := run.ImportTenantData("fs09000000", "diku.tar.gz")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Same(t, archive, result)
+		mockSnapshot.AssertExpectations(t)
+	})
+
+	t.Run("TestImportTenantData_NotEntitled", func(t *testing.T) {
+		// Arrange
+		run, mockManagement, mockKeycloak, _, _, _ := newTestRun(action.ImportTenantData)
+		mockSnapshot := &MockSnapshotSvc{}
+		run.Config.SnapshotSvc = mockSnapshot
+		mockSnapshot.On("ReadTenantDataArchive", "diku.tar.gz").Return(&models.TenantDataArchive{Tenant: "diku"}, nil)
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+		mockManagement.On("GetTenantEntitlements", "diku", true).Return(models.TenantEntitlementResponse{}, nil)
+
+		// Act
+		result, err := run.ImportTenantData("diku", "diku.tar.gz")
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		assert.Nil(t, result)
+		mockSnapshot.AssertNotCalled(t, "ImportTenantData", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPrintTenantDataArchive(t *testing.T) {
+	// Arrange
+	run, _, _, _, _, _ := newTestRun(action.ExportTenantData)
+	archive := &models.TenantDataArchive{
+		Tenant:    "diku",
+		Profile:   "combined",
+		Database:  "folio",
+		CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		Schemas:   []models.TenantDataSchema{{Name: "diku_mod_users", ModuleID: "mod-users-19.4.0"}},
+	}
+	var buffer bytes.Buffer
+
+	// Act
+	err := run.PrintTenantDataArchive(&buffer, archive, "diku.tar.gz")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Regexp(t, `File +diku\.tar\.gz\n`, buffer.String())
+	assert.Regexp(t, `Schemas +1\n`, buffer.String())
+	assert.Contains(t, buffer.String(), "  diku_mod_users  mod-users-19.4.0")
+}
+
+func TestExportRealm(t *testing.T) {
+	// Arrange
+	run, _, mockKeycloak, _, _, _ := newTestRun(action.ExportRealm)
+	filePath := filepath.Join(t.TempDir(), "diku-realm.json")
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+	mockKeycloak.On("ExportRealm", "diku", true).Return(map[string]any{"realm": "diku", "users": []any{}}, nil)
+
+	// Act
+	err := run.ExportRealm("diku", filePath, true)
+
+	// Assert
+	assert.NoError(t, err)
+	var realm map[string]any
+	assert.NoError(t, helpers.ReadJSONFromFile(filePath, &realm))
+	assert.Equal(t, "diku", realm["realm"])
+	mockKeycloak.AssertExpectations(t)
+}
+
+func TestImportRealm(t *testing.T) {
+	// Arrange
+	run, _, mockKeycloak, _, _, _ := newTestRun(action.ImportRealm)
+	filePath := filepath.Join(t.TempDir(), "diku-realm.json")
+	realm := map[string]any{"realm": "diku", "accessTokenLifespan": float64(600)}
+	assert.NoError(t, helpers.WriteJSONToFile(filePath, realm))
+	response := &models.KeycloakPartialImportResponse{Added: 4, Skipped: 1}
+	mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return("master-token", nil)
+	mockKeycloak.On("ImportRealm", "diku", realm, false).Return(response, nil)
+	var buffer bytes.Buffer
+
+	// Act
+	result, err := run.ImportRealm("diku", filePath, false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Same(t, response, result)
+	assert.NoError(t, run.PrintRealmImport(&buffer, result))
+	assert.Equal(t, "Imported realm resources: 4 added, 0 overwritten, 1 skipped\n", buffer.String())
+}
+
+func TestFindRoute(t *testing.T) {
+	t.Run("TestFindRoute_Success", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.FindRoute)
+		mockKong := &MockKongSvc{}
+		run.Config.KongSvc = mockKong
+		routes := []models.KongRouteInfo{{RouteID: "route-1", ModuleID: "mod-orders-12.9.0", Location: "http://mod-orders.eureka:8082"}}
+		mockKong.On("FindRoutes", "GET", "/orders/composite-orders/123").Return(routes, nil)
+
+		// Act
+		result, err := run.FindRoute("GET", "/orders/composite-orders/123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, routes, result)
+	})
+
+	t.Run("TestFindRoute_NotFound", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.FindRoute)
+		mockKong := &MockKongSvc{}
+		run.Config.KongSvc = mockKong
+		mockKong.On("FindRoutes", "GET", "/unknown").Return([]models.KongRouteInfo{}, nil)
+
+		// Act
+		result, err := run.FindRoute("GET", "/unknown")
+
+		// Assert
+		assert.Equal(t, errors.KongRouteNotFound("GET", "/unknown"), err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPrintKongRoutes(t *testing.T) {
+	routes := []models.KongRouteInfo{
+		{RouteID: "route-1", ModuleID: "mod-orders-12.9.0", Methods: []string{"GET"}, Expression: `(http.path == "/orders" && http.method == "GET")`, Location: "http://mod-orders.eureka:8082"},
+		{RouteID: "route-2", ModuleID: "mod-finance-5.1.0", Methods: []string{}, Paths: []string{"/finance", "/budgets"}},
+	}
+
+	t.Run("TestPrintKongRoutes_Table", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ListRoutes)
+		var buffer bytes.Buffer
+
+		// Act
+		err := run.PrintKongRoutes(&buffer, routes, constant.TableOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, buffer.String(), "MODULE")
+		assert.Regexp(t, `mod-orders-12\.9\.0 +GET +\(http\.path == "/orders" && http\.method == "GET"\) +http://mod-orders\.eureka:8082`, buffer.String())
+		assert.Contains(t, buffer.String(), "/finance,/budgets")
+	})
+
+	t.Run("TestPrintKongRoutes_JSON", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.ListRoutes)
+		var buffer bytes.Buffer
+
+		// Act
+		err := run.PrintKongRoutes(&buffer, routes, constant.JSONOutput)
+
+		// Assert
+		assert.NoError(t, err)
+		var result []models.KongRouteInfo
+		assert.NoError(t, json.Unmarshal(buffer.Bytes(), &result))
+		assert.Equal(t, routes, result)
+	})
+}
+
+// ==================== Call Tests ====================
+
+func TestCall(t *testing.T) {
+	response := &models.HTTPCallResponse{StatusCode: 200, Status: "200 OK", Body: []byte(`{"users":[]}`)}
+
+	t.Run("TestCall_SystemUserThroughKong", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.Call)
+		mockHTTP := run.Config.HTTPClient.(*testhelpers.MockHTTPClient)
+		params.Tenant, params.User, params.Data, params.Direct, params.ModuleName = "diku", "", `{"username":"test"}`, false, ""
+		mockKeycloak.On("GetAccessToken", "diku").Return("system-token", nil)
+		headers := map[string]string{constant.ContentTypeHeader: constant.ApplicationJSON, constant.OkapiTenantHeader: "diku", constant.OkapiTokenHeader: "system-token"}
+		mockHTTP.On("Call", "POST", "http://localhost:8000/users", []byte(`{"username":"test"}`), headers).Return(response, nil)
+
+		// Act
+		result, err := run.Call("POST", "/users")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, response, result)
+		mockKeycloak.AssertExpectations(t)
+		mockHTTP.AssertExpectations(t)
+	})
+
+	t.Run("TestCall_ConfiguredUser", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.Call)
+		mockHTTP := run.Config.HTTPClient.(*testhelpers.MockHTTPClient)
+		run.Config.Action.ConfigUsers = map[string]any{"diku_admin": map[string]any{"tenant": "diku", "password": "admin"}}
+		params.Tenant, params.User, params.Data, params.Direct, params.ModuleName = "", "diku_admin", "", false, ""
+		mockKeycloak.On("GetUserAccessToken", "diku", "diku_admin", "admin").Return("user-token", nil)
+		mockHTTP.On("Call", "GET", "http://localhost:8000/users?limit=5", []byte(nil), mock.MatchedBy(func(headers map[string]string) bool {
+			return headers[constant.OkapiTenantHeader] == "diku" && headers[constant.OkapiTokenHeader] == "user-token"
+		})).Return(response, nil)
+
+		// Act
+		result, err := run.Call("GET", "/users?limit=5")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, response, result)
+		mockKeycloak.AssertExpectations(t)
+		mockHTTP.AssertExpectations(t)
+	})
+
+	t.Run("TestCall_UserNotConfigured", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.Call)
+		params.Tenant, params.User, params.Data, params.Direct, params.ModuleName = "diku", "unknown", "", false, ""
+
+		// Act
+		result, err := run.Call("GET", "/users")
+
+		// Assert
+		assert.Equal(t, errors.ConfigUserNotFound("unknown"), err)
+		assert.Nil(t, result)
+		mockKeycloak.AssertNotCalled(t, "GetUserAccessToken", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("TestCall_TenantMissing", func(t *testing.T) {
+		// Arrange
+		run, _, _, _, _, _ := newTestRun(action.Call)
+		params.Tenant, params.User, params.Data, params.Direct, params.ModuleName = "", "", "", false, ""
+
+		// Act
+		result, err := run.Call("GET", "/users")
+
+		// Assert
+		assert.Equal(t, errors.RequiredParameterMissing("tenant"), err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("TestCall_DirectToSidecarOfMatchedRoute", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.Call)
+		mockHTTP := run.Config.HTTPClient.(*testhelpers.MockHTTPClient)
+		mockKong := &MockKongSvc{}
+		run.Config.KongSvc = mockKong
+		params.Tenant, params.User, params.Data, params.Direct, params.ModuleName = "diku", "", "", true, ""
+		mockKeycloak.On("GetAccessToken", "diku").Return("system-token", nil)
+		mockKong.On("FindRoutes", "GET", "/users").Return([]models.KongRouteInfo{{ModuleID: "mod-users-19.4.0"}}, nil)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{
+			{Names: []string{"/eureka-combined-mod-users"}, Ports: []container.Port{{PrivatePort: 8081, PublicPort: 30001}}},
+			{Names: []string{"/eureka-combined-mod-users-sc"}, Ports: []container.Port{{PrivatePort: 5005, PublicPort: 30004}, {PrivatePort: 8081, PublicPort: 30003}}},
+		}, nil)
+		mockHTTP.On("Call", "GET", "http://localhost:30003/users", []byte(nil), mock.Anything).Return(response, nil)
+
+		// Act
+		result, err := run.Call("GET", "/users")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, response, result)
+		mockKong.AssertExpectations(t)
+		mockHTTP.AssertExpectations(t)
+	})
+
+	t.Run("TestCall_DirectSidecarNotDeployed", func(t *testing.T) {
+		// Arrange
+		run, _, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.Call)
+		params.Tenant, params.User, params.Data, params.Direct, params.ModuleName = "diku", "", "", true, "mod-orders"
+		mockKeycloak.On("GetAccessToken", "diku").Return("system-token", nil)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockModule.On("GetDeployedModules", mock.Anything, mock.Anything).Return([]container.Summary{}, nil)
+
+		// Act
+		result, err := run.Call("GET", "/orders/composite-orders")
+
+		// Assert
+		assert.Equal(t, errors.SidecarPortNotFound("mod-orders"), err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestReadCallPayload(t *testing.T) {
+	t.Run("TestReadCallPayload_Inline", func(t *testing.T) {
+		// Act
+		payload, err := readCallPayload(`{"active":true}`)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"active":true}`), payload)
+	})
+
+	t.Run("TestReadCallPayload_File", func(t *testing.T) {
+		// Arrange
+		filePath := filepath.Join(t.TempDir(), "user.json")
+		assert.NoError(t, os.WriteFile(filePath, []byte(`{"username":"test"}`), 0600))
+
+		// Act
+		payload, err := readCallPayload("@" + filePath)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(`{"username":"test"}`), payload)
+	})
+
+	t.Run("TestReadCallPayload_Empty", func(t *testing.T) {
+		// Act
+		payload, err := readCallPayload("")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, payload)
+	})
+}
+
+func TestPrintHTTPCallResponse(t *testing.T) {
+	t.Run("TestPrintHTTPCallResponse_JSON", func(t *testing.T) {
+		// Arrange
+		var status, body bytes.Buffer
+		response := &models.HTTPCallResponse{Status: "200 OK", Body: []byte(`{"totalRecords":0}`), Duration: 1234 * time.Microsecond}
+
+		// Act
+		PrintHTTPCallResponse(&status, &body, response)
+
+		// Assert
+		assert.Equal(t, "200 OK (1ms)\n", status.String())
+		assert.Equal(t, "{\n  \"totalRecords\": 0\n}\n", body.String())
+	})
+
+	t.Run("TestPrintHTTPCallResponse_Text", func(t *testing.T) {
+		// Arrange
+		var status, body bytes.Buffer
+		response := &models.HTTPCallResponse{Status: "403 Forbidden", Body: []byte("Access Denied")}
+
+		// Act
+		PrintHTTPCallResponse(&status, &body, response)
+
+		// Assert
+		assert.Equal(t, "403 Forbidden (0s)\n", status.String())
+		assert.Equal(t, "Access Denied\n", body.String())
+	})
+}
+
+// ==================== Token Cache Tests ====================
+
+func newTestJWT(expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, `{"exp":%d}`, expiresAt.Unix()))
+	return "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"
+}
+
+func TestTokenCache(t *testing.T) {
+	t.Run("TestTokenCache_MasterAccessTokenIsReused", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.GetKeycloakAccessToken)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		token := newTestJWT(time.Now().Add(time.Hour))
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return(token, nil).Once()
+
+		// Act
+		errFirst := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials)
+		run.Config.Action.KeycloakMasterAccessToken = ""
+		errSecond := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials)
+
+		// Assert
+		assert.NoError(t, errFirst)
+		assert.NoError(t, errSecond)
+		assert.Equal(t, token, run.Config.Action.KeycloakMasterAccessToken)
+		mockKeycloak.AssertNumberOfCalls(t, "GetMasterAccessToken", 1)
+	})
+
+	t.Run("TestTokenCache_GrantTypesAreCachedSeparately", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.GetKeycloakAccessToken)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		mockKeycloak.On("GetMasterAccessToken", constant.KeycloakGrantType(constant.ClientCredentials)).Return(newTestJWT(time.Now().Add(time.Hour)), nil).Once()
+		mockKeycloak.On("GetMasterAccessToken", constant.KeycloakGrantType(constant.Password)).Return(newTestJWT(time.Now().Add(2*time.Hour)), nil).Once()
+
+		// Act
+		errClient := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials)
+		errPassword := run.setKeycloakMasterAccessTokenIntoContext(constant.Password)
+
+		// Assert
+		assert.NoError(t, errClient)
+		assert.NoError(t, errPassword)
+		mockKeycloak.AssertExpectations(t)
+	})
+
+	t.Run("TestTokenCache_TenantAccessTokenIsReused", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.GetKeycloakAccessToken)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		token := newTestJWT(time.Now().Add(time.Hour))
+		mockKeycloak.On("GetAccessToken", "diku").Return(token, nil).Once()
+
+		// Act
+		_, errFirst := run.GetKeycloakAccessToken(constant.DefaultToken, "diku")
+		result, errSecond := run.GetKeycloakAccessToken(constant.DefaultToken, "diku")
+
+		// Assert
+		assert.NoError(t, errFirst)
+		assert.NoError(t, errSecond)
+		assert.Equal(t, token, result)
+		mockKeycloak.AssertNumberOfCalls(t, "GetAccessToken", 1)
+	})
+
+	t.Run("TestTokenCache_VaultRootTokenIsCachedPerContainer", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, _, _, mockDocker, mockModule := newTestRun(action.GetVaultRootToken)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		mockDocker.On("Create").Return(nil, nil)
+		mockDocker.On("Close", mock.Anything).Return()
+		mockModule.On("GetVaultContainerID", mock.Anything).Return("container-1", nil).Twice()
+		mockModule.On("GetVaultContainerID", mock.Anything).Return("container-2", nil).Once()
+		mockModule.On("GetVaultRootToken", mock.Anything).Return("hvs.first", nil).Once()
+		mockModule.On("GetVaultRootToken", mock.Anything).Return("hvs.second", nil).Once()
+
+		// Act
+		errFirst := run.GetVaultRootToken()
+		errCached := run.GetVaultRootToken()
+		cachedToken := run.Config.Action.VaultRootToken
+		errRedeployed := run.GetVaultRootToken()
+
+		// Assert
+		assert.NoError(t, errFirst)
+		assert.NoError(t, errCached)
+		assert.NoError(t, errRedeployed)
+		assert.Equal(t, "hvs.first", cachedToken)
+		assert.Equal(t, "hvs.second", run.Config.Action.VaultRootToken)
+		mockModule.AssertExpectations(t)
+	})
+
+	t.Run("TestTokenCache_CallUserTokenIsCachedPerUser", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.Call)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		run.Config.Action.ConfigUsers = map[string]any{
+			"diku_admin": map[string]any{"tenant": "diku", "password": "admin"},
+			"diku_user":  map[string]any{"tenant": "diku", "password": "user"},
+		}
+		adminToken, userToken := newTestJWT(time.Now().Add(time.Hour)), newTestJWT(time.Now().Add(2*time.Hour))
+		mockKeycloak.On("GetUserAccessToken", "diku", "diku_admin", "admin").Return(adminToken, nil).Once()
+		mockKeycloak.On("GetUserAccessToken", "diku", "diku_user", "user").Return(userToken, nil).Once()
+
+		// Act
+		_, adminFirst, errAdminFirst := run.getCallAccessToken("", "diku_admin")
+		_, userFirst, errUserFirst := run.getCallAccessToken("", "diku_user")
+		_, adminSecond, errAdminSecond := run.getCallAccessToken("", "diku_admin")
+
+		// Assert
+		assert.NoError(t, errAdminFirst)
+		assert.NoError(t, errUserFirst)
+		assert.NoError(t, errAdminSecond)
+		assert.Equal(t, adminToken, adminFirst)
+		assert.Equal(t, userToken, userFirst)
+		assert.Equal(t, adminToken, adminSecond)
+		mockKeycloak.AssertExpectations(t)
+	})
+
+	t.Run("TestTokenCache_CallSystemUserTokenIsShared", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.Call)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		token := newTestJWT(time.Now().Add(time.Hour))
+		mockKeycloak.On("GetAccessToken", "diku").Return(token, nil).Once()
+		assert.NoError(t, run.setKeycloakAccessTokenIntoContext("diku"))
+
+		// Act
+		_, result, err := run.getCallAccessToken("diku", "")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, token, result)
+		mockKeycloak.AssertNumberOfCalls(t, "GetAccessToken", 1)
+	})
+
+	t.Run("TestTokenCache_UndeploySystemClearsCache", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.UndeploySystem)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		mockExecSvc := &MockExecSvc{}
+		run.Config.ExecSvc = mockExecSvc
+		mockExecSvc.On("Exec", mock.Anything).Return(nil)
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return(newTestJWT(time.Now().Add(time.Hour)), nil)
+		assert.NoError(t, run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials))
+
+		// Act
+		err := run.UndeploySystem()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials))
+		mockKeycloak.AssertNumberOfCalls(t, "GetMasterAccessToken", 2)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	t.Run("TestLogout_ClearsTokenCache", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.Logout)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return(newTestJWT(time.Now().Add(time.Hour)), nil)
+		assert.NoError(t, run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials))
+
+		// Act
+		err := run.Logout()
+
+		// Assert
+		assert.NoError(t, err)
+		filePath, _ := run.Config.TokenCacheSvc.GetTokenCacheFilePath()
+		assert.NoFileExists(t, filePath)
+		assert.NoError(t, run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials))
+		mockKeycloak.AssertNumberOfCalls(t, "GetMasterAccessToken", 2)
+	})
+
+	t.Run("TestLogout_DryRunKeepsTokenCache", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		run, _, mockKeycloak, _, _, _ := newTestRun(action.Logout)
+		run.Config.TokenCacheSvc = tokencachesvc.New(run.Config.Action)
+		mockKeycloak.On("GetMasterAccessToken", mock.AnythingOfType("constant.KeycloakGrantType")).Return(newTestJWT(time.Now().Add(time.Hour)), nil)
+		assert.NoError(t, run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials))
+		run.Config.Action.Param.DryRun = true
+
+		// Act
+		err := run.Logout()
+
+		// Assert
+		assert.NoError(t, err)
+		filePath, _ := run.Config.TokenCacheSvc.GetTokenCacheFilePath()
+		assert.FileExists(t, filePath)
+	})
+}
@@ -43,6 +43,11 @@ func (m *MockManagementSvc) CreateTenants() error {
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) CreateTenant(tenantName string) error {
+	args := m.Called(tenantName)
+	return args.Error(0)
+}
+
 func (m *MockManagementSvc) RemoveTenants(consortiumName string, tenantType constant.TenantType) error {
 	args := m.Called(consortiumName, tenantType)
 	return args.Error(0)
@@ -115,6 +120,11 @@ func (m *MockManagementSvc) CreateTenantEntitlement(consortiumName string, tenan
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) CreateTenantEntitlementForTenant(consortiumName string, tenantID string, tenantName string) error {
+	args := m.Called(consortiumName, tenantID, tenantName)
+	return args.Error(0)
+}
+
 func (m *MockManagementSvc) UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
 	args := m.Called(consortiumName, tenantType, newApplicationID)
 	return args.Error(0)
@@ -125,6 +135,11 @@ func (m *MockManagementSvc) RemoveTenantEntitlements(consortiumName string, tena
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) RemoveTenantEntitlementForTenant(tenantID string, tenantName string, purgeSchemas bool) error {
+	args := m.Called(tenantID, tenantName, purgeSchemas)
+	return args.Error(0)
+}
+
 // MockKeycloakSvc is a mock for keycloaksvc.KeycloakProcessor
 type MockKeycloakSvc struct {
 	mock.Mock
@@ -135,6 +150,11 @@ func (m *MockKeycloakSvc) GetAccessToken(tenantName string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockKeycloakSvc) GetUserAccessToken(tenantName string, username string, password string) (string, error) {
+	args := m.Called(tenantName, username, password)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockKeycloakSvc) GetMasterAccessToken(grantType constant.KeycloakGrantType) (string, error) {
 	args := m.Called(grantType)
 	return args.String(0), args.Error(1)
@@ -150,6 +170,22 @@ func (m *MockKeycloakSvc) UpdatePublicClientSettings(tenantName string, url stri
 	return args.Error(0)
 }
 
+func (m *MockKeycloakSvc) ExportRealm(tenantName string, includeUsers bool) (map[string]any, error) {
+	args := m.Called(tenantName, includeUsers)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m *MockKeycloakSvc) ImportRealm(tenantName string, realm map[string]any, overwrite bool) (*models.KeycloakPartialImportResponse, error) {
+	args := m.Called(tenantName, realm, overwrite)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.KeycloakPartialImportResponse), args.Error(1)
+}
+
 func (m *MockKeycloakSvc) GetUsers(tenantName string) ([]any, error) {
 	args := m.Called(tenantName)
 	if args.Get(0) == nil {
@@ -163,11 +199,21 @@ func (m *MockKeycloakSvc) CreateUsers(configTenant string) error {
 	return args.Error(0)
 }
 
+func (m *MockKeycloakSvc) CreateUser(tenantName string, username string) error {
+	args := m.Called(tenantName, username)
+	return args.Error(0)
+}
+
 func (m *MockKeycloakSvc) RemoveUsers(tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
 }
 
+func (m *MockKeycloakSvc) ResetUserPassword(tenantName string, username string, password string) error {
+	args := m.Called(tenantName, username, password)
+	return args.Error(0)
+}
+
 func (m *MockKeycloakSvc) GetRoles(headers map[string]string) ([]any, error) {
 	args := m.Called(headers)
 	if args.Get(0) == nil {
@@ -189,6 +235,11 @@ func (m *MockKeycloakSvc) CreateRoles(configTenant string) error {
 	return args.Error(0)
 }
 
+func (m *MockKeycloakSvc) CreateRole(tenantName string, roleName string) error {
+	args := m.Called(tenantName, roleName)
+	return args.Error(0)
+}
+
 func (m *MockKeycloakSvc) RemoveRoles(tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
@@ -225,6 +276,11 @@ func (m *MockKeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
 	return args.Error(0)
 }
 
+func (m *MockKeycloakSvc) AttachCapabilitySetsToRole(tenantName string, roleName string) error {
+	args := m.Called(tenantName, roleName)
+	return args.Error(0)
+}
+
 func (m *MockKeycloakSvc) DetachCapabilitySetsFromRoles(tenantName string) error {
 	args := m.Called(tenantName)
 	return args.Error(0)
@@ -256,6 +312,24 @@ func (m *MockVaultClient) GetSecretKey(ctx context.Context, client *vault.Client
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
+func (m *MockVaultClient) ListSecrets(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) ([]string, error) {
+	args := m.Called(ctx, client, vaultRootToken, secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockVaultClient) PutSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string, data map[string]any) error {
+	args := m.Called(ctx, client, vaultRootToken, secretPath, data)
+	return args.Error(0)
+}
+
+func (m *MockVaultClient) DeleteSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) error {
+	args := m.Called(ctx, client, vaultRootToken, secretPath)
+	return args.Error(0)
+}
+
 // MockDockerClient is a mock for dockerclient.DockerClientRunner
 type MockDockerClient struct {
 	mock.Mock
@@ -288,6 +362,11 @@ type MockModuleSvc struct {
 	mock.Mock
 }
 
+func (m *MockModuleSvc) GetVaultContainerID(client *client.Client) (string, error) {
+	args := m.Called(client)
+	return args.String(0), args.Error(1)
+}
+
 func (m *MockModuleSvc) GetVaultRootToken(client *client.Client) (string, error) {
 	args := m.Called(client)
 	return args.String(0), args.Error(1)
@@ -355,6 +434,48 @@ func (m *MockModuleSvc) PullModule(cli *client.Client, imageName string) error {
 	return args.Error(0)
 }
 
+func (m *MockModuleSvc) GetModuleImages(containers *models.Containers, sidecarImage string) []models.ModuleImage {
+	args := m.Called(containers, sidecarImage)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]models.ModuleImage)
+}
+
+func (m *MockModuleSvc) PullModules(cli *client.Client, imageNames []string) ([]string, error) {
+	args := m.Called(cli, imageNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockModuleSvc) GetImageReport(cli *client.Client, images []models.ModuleImage, pulledImages []string) ([]models.ImageReport, error) {
+	args := m.Called(cli, images, pulledImages)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.ImageReport), args.Error(1)
+}
+
+func (m *MockModuleSvc) GetLogContainers(cli *client.Client, moduleNames []string, sidecar bool) ([]string, error) {
+	args := m.Called(cli, moduleNames, sidecar)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockModuleSvc) ReadModuleLogs(ctx context.Context, cli *client.Client, containerNames []string, options models.LogOptions, handleFn func(line models.LogLine)) error {
+	args := m.Called(ctx, cli, containerNames, options, handleFn)
+	if lines, ok := args.Get(0).([]models.LogLine); ok {
+		for _, line := range lines {
+			handleFn(line)
+		}
+	}
+	return args.Error(1)
+}
+
 func (m *MockModuleSvc) DeployModules(cli *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, error) {
 	args := m.Called(cli, containers, sidecarImage, sidecarResources)
 	if args.Get(0) == nil {
@@ -363,6 +484,14 @@ func (m *MockModuleSvc) DeployModules(cli *client.Client, containers *models.Con
 	return args.Get(0).(map[string]int), args.Error(1)
 }
 
+func (m *MockModuleSvc) GetModuleDrift(cli *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]string, error) {
+	args := m.Called(cli, containers, sidecarImage, sidecarResources)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]string), args.Error(1)
+}
+
 func (m *MockModuleSvc) DeployModule(cli *client.Client, container *models.Container) error {
 	args := m.Called(cli, container)
 	return args.Error(0)
@@ -444,6 +573,50 @@ func newTestRun(actionName string) (*Run, *MockManagementSvc, *MockKeycloakSvc,
 	return run, mockManagement, mockKeycloak, mockVault, mockDocker, mockModule
 }
 
+// MockPortProxySvc is a mock for portproxysvc.PortProxyProcessor
+type MockPortProxySvc struct {
+	mock.Mock
+}
+
+func (m *MockPortProxySvc) Forward(ctx context.Context, proxy *models.PortProxy) error {
+	args := m.Called(ctx, proxy)
+	return args.Error(0)
+}
+
+func (m *MockPortProxySvc) StartDetached(proxy *models.PortProxy, cmdArgs []string) (*models.PortProxy, error) {
+	args := m.Called(proxy, cmdArgs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PortProxy), args.Error(1)
+}
+
+func (m *MockPortProxySvc) Stop(name string) (*models.PortProxy, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PortProxy), args.Error(1)
+}
+
+func (m *MockPortProxySvc) ListPortProxies() ([]models.PortProxy, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.PortProxy), args.Error(1)
+}
+
+// MockSystemSvc is a mock for systemsvc.SystemProcessor
+type MockSystemSvc struct {
+	mock.Mock
+}
+
+func (m *MockSystemSvc) WaitForSystemReadiness(client *client.Client, serviceNames []string) error {
+	args := m.Called(client, serviceNames)
+	return args.Error(0)
+}
+
 // MockExecSvc is a mock for execsvc.CommandRunner
 type MockExecSvc struct {
 	mock.Mock
@@ -534,6 +707,258 @@ func (m *MockKafkaSvc) PollConsumerGroup(tenantName string) error {
 	return args.Error(0)
 }
 
+func (m *MockKafkaSvc) ListTopics(tenantName string) ([]models.KafkaTopicSummary, error) {
+	args := m.Called(tenantName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KafkaTopicSummary), args.Error(1)
+}
+
+func (m *MockKafkaSvc) PurgeTopics(tenantName string) error {
+	args := m.Called(tenantName)
+	return args.Error(0)
+}
+
+func (m *MockKafkaSvc) ListConsumerGroups() ([]string, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockKafkaSvc) DescribeConsumerGroup(groupID, tenantName string) (*models.KafkaConsumerGroupDescription, error) {
+	args := m.Called(groupID, tenantName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.KafkaConsumerGroupDescription), args.Error(1)
+}
+
+func (m *MockKafkaSvc) ResetOffsets(groupID, tenantName string, timestamp int64) ([]models.KafkaOffsetReset, error) {
+	args := m.Called(groupID, tenantName, timestamp)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KafkaOffsetReset), args.Error(1)
+}
+
+func (m *MockKafkaSvc) RestoreOffsets(groupID string, offsets map[models.KafkaTopicPartition]int64) ([]models.KafkaOffsetReset, error) {
+	args := m.Called(groupID, offsets)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KafkaOffsetReset), args.Error(1)
+}
+
+func (m *MockKafkaSvc) GetTopicName(topic, tenantName string) string {
+	args := m.Called(topic, tenantName)
+	return args.String(0)
+}
+
+func (m *MockKafkaSvc) TailTopic(ctx context.Context, topicName string, fromBeginning bool, handler func(record models.KafkaRecord)) error {
+	args := m.Called(topicName, fromBeginning)
+	if records, ok := args.Get(0).([]models.KafkaRecord); ok {
+		for _, record := range records {
+			handler(record)
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockKafkaSvc) PublishMessage(record models.KafkaRecord) (*models.KafkaRecord, error) {
+	args := m.Called(record)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.KafkaRecord), args.Error(1)
+}
+
+// MockConsortiumSvc is a mock for consortiumsvc.ConsortiumProcessor
+type MockConsortiumSvc struct {
+	mock.Mock
+}
+
+func (m *MockConsortiumSvc) GetConsortiumByName(centralTenant string, consortiumName string) (any, error) {
+	args := m.Called(centralTenant, consortiumName)
+	return args.Get(0), args.Error(1)
+}
+
+func (m *MockConsortiumSvc) GetConsortiumCentralTenant(consortiumName string) string {
+	args := m.Called(consortiumName)
+	return args.String(0)
+}
+
+func (m *MockConsortiumSvc) GetConsortiumUsers(consortiumName string) map[string]any {
+	args := m.Called(consortiumName)
+	return args.Get(0).(map[string]any)
+}
+
+func (m *MockConsortiumSvc) GetAdminUsername(centralTenant string, consortiumUsers map[string]any) string {
+	args := m.Called(centralTenant, consortiumUsers)
+	return args.String(0)
+}
+
+func (m *MockConsortiumSvc) CreateConsortium(centralTenant string, consortiumName string) (string, error) {
+	args := m.Called(centralTenant, consortiumName)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockConsortiumSvc) GetSortedConsortiumTenants(consortiumName string) models.SortedConsortiumTenants {
+	args := m.Called(consortiumName)
+	return args.Get(0).(models.SortedConsortiumTenants)
+}
+
+func (m *MockConsortiumSvc) CreateConsortiumTenants(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, adminUsername string) error {
+	args := m.Called(centralTenant, consortiumID, consortiumTenants, adminUsername)
+	return args.Error(0)
+}
+
+func (m *MockConsortiumSvc) EnableCentralOrdering(centralTenant string) error {
+	args := m.Called(centralTenant)
+	return args.Error(0)
+}
+
+func (m *MockConsortiumSvc) MigrateConsortiumUsers(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, deleteUsers bool) []models.ConsortiumUserMigration {
+	args := m.Called(centralTenant, consortiumID, consortiumTenants, deleteUsers)
+	return args.Get(0).([]models.ConsortiumUserMigration)
+}
+
+// MockVaultSvc is a mock for vaultsvc.VaultProcessor
+type MockVaultSvc struct {
+	mock.Mock
+}
+
+func (m *MockVaultSvc) ListSecrets(secretPath string) ([]string, error) {
+	args := m.Called(secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockVaultSvc) GetSecret(secretPath string) (map[string]any, error) {
+	args := m.Called(secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m *MockVaultSvc) SetSecretKeys(secretPath string, values map[string]any) error {
+	args := m.Called(secretPath, values)
+	return args.Error(0)
+}
+
+func (m *MockVaultSvc) DeleteSecretKey(secretPath string, key string) error {
+	args := m.Called(secretPath, key)
+	return args.Error(0)
+}
+
+func (m *MockVaultSvc) DeleteSecret(secretPath string) error {
+	args := m.Called(secretPath)
+	return args.Error(0)
+}
+
+// MockSnapshotSvc is a mock for snapshotsvc.SnapshotProcessor
+type MockSnapshotSvc struct {
+	mock.Mock
+}
+
+func (m *MockSnapshotSvc) GetSnapshotDir(name string) (string, error) {
+	args := m.Called(name)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) CreateSnapshotDir(name string) (string, error) {
+	args := m.Called(name)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) ListSnapshots() ([]models.EnvironmentSnapshotSummary, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.EnvironmentSnapshotSummary), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) ReadManifest(name string) (*models.EnvironmentSnapshot, error) {
+	args := m.Called(name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.EnvironmentSnapshot), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) WriteManifest(snapshotDir string, snapshot *models.EnvironmentSnapshot) error {
+	args := m.Called(snapshotDir, snapshot)
+	return args.Error(0)
+}
+
+func (m *MockSnapshotSvc) DumpDatabases(client *client.Client, snapshotDir string) ([]string, error) {
+	args := m.Called(client, snapshotDir)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) RestoreDatabases(client *client.Client, snapshotDir string) error {
+	args := m.Called(client, snapshotDir)
+	return args.Error(0)
+}
+
+func (m *MockSnapshotSvc) SaveSecrets(snapshotDir string) (int, error) {
+	args := m.Called(snapshotDir)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) RestoreSecrets(snapshotDir string) (int, error) {
+	args := m.Called(snapshotDir)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) SaveConsumerGroupOffsets() ([]models.SnapshotConsumerGroup, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.SnapshotConsumerGroup), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) RestoreConsumerGroupOffsets(consumerGroups []models.SnapshotConsumerGroup) int {
+	args := m.Called(consumerGroups)
+	return args.Int(0)
+}
+
+func (m *MockSnapshotSvc) ExportTenantData(client *client.Client, tenantName string, moduleIDs []string, filePath string) (*models.TenantDataArchive, error) {
+	args := m.Called(client, tenantName, moduleIDs, filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TenantDataArchive), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) ReadTenantDataArchive(filePath string) (*models.TenantDataArchive, error) {
+	args := m.Called(filePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.TenantDataArchive), args.Error(1)
+}
+
+func (m *MockSnapshotSvc) ImportTenantData(client *client.Client, tenantName string, filePath string, archive *models.TenantDataArchive) error {
+	args := m.Called(client, tenantName, filePath, archive)
+	return args.Error(0)
+}
+
+func (m *MockSnapshotSvc) GetTenantDataModuleMismatches(archive *models.TenantDataArchive, moduleIDs []string) []models.TenantDataModuleMismatch {
+	args := m.Called(archive, moduleIDs)
+	return args.Get(0).([]models.TenantDataModuleMismatch)
+}
+
 type MockModuleProps struct {
 	mock.Mock
 }
@@ -551,6 +976,58 @@ func (m *MockModuleProps) ReadFrontendModules(checkIntegrity bool) (map[string]m
 	return args.Get(0).(map[string]models.FrontendModule), args.Error(1)
 }
 
+// MockLockSvc is a mock for locksvc.LockProcessor
+type MockLockSvc struct {
+	mock.Mock
+}
+
+func (m *MockLockSvc) GetLockFilePath() string {
+	args := m.Called()
+	return args.String(0)
+}
+
+func (m *MockLockSvc) ReadLock() (*models.Lock, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Lock), args.Error(1)
+}
+
+func (m *MockLockSvc) ValidateLock(lock *models.Lock) error {
+	args := m.Called(lock)
+	return args.Error(0)
+}
+
+func (m *MockLockSvc) CreateLock(client *client.Client, modules *models.ProxyModulesByRegistry, images []models.ModuleImage, sidecarImage string) (*models.Lock, error) {
+	args := m.Called(client, modules, images, sidecarImage)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Lock), args.Error(1)
+}
+
+func (m *MockLockSvc) CaptureLock(client *client.Client, baseLock *models.Lock) (*models.Lock, error) {
+	args := m.Called(client, baseLock)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Lock), args.Error(1)
+}
+
+func (m *MockLockSvc) WriteLock(lock *models.Lock) error {
+	args := m.Called(lock)
+	return args.Error(0)
+}
+
+func (m *MockLockSvc) DiffLock(oldLock *models.Lock, newLock *models.Lock) []models.LockDiff {
+	args := m.Called(oldLock, newLock)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).([]models.LockDiff)
+}
+
 type MockRegistrySvc struct {
 	mock.Mock
 }
@@ -577,6 +1054,22 @@ func (m *MockRegistrySvc) GetAuthorizationToken() (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockRegistrySvc) GetLockedModules(lock *models.Lock) *models.ProxyModulesByRegistry {
+	args := m.Called(lock)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*models.ProxyModulesByRegistry)
+}
+
+func (m *MockRegistrySvc) SnapshotRegistry(snapshotName string) (*models.RegistrySnapshot, error) {
+	args := m.Called(snapshotName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RegistrySnapshot), args.Error(1)
+}
+
 // ==================== CreateTenants Tests ====================
 
 func TestCreateTenants_Success(t *testing.T) {
@@ -1825,7 +2318,7 @@ func TestGetVaultRootToken_Success(t *testing.T) {
 
 	expectedToken := "root-token-123"
 	mockDocker.On("Create").Return(nil, nil)
-	mockDocker.On("Close", mock.Anything).Return()
+	mockDocker.On("Close", mock.Anything).Return(nil)
 	mockModule.On("GetVaultRootToken", mock.Anything).Return(expectedToken, nil)
 
 	// Act
@@ -1860,7 +2353,7 @@ func TestGetVaultRootToken_GetTokenError(t *testing.T) {
 
 	expectedError := assert.AnError
 	mockDocker.On("Create").Return(nil, nil)
-	mockDocker.On("Close", mock.Anything).Return()
+	mockDocker.On("Close", mock.Anything).Return(nil)
 	mockModule.On("GetVaultRootToken", mock.Anything).Return("", expectedError)
 
 	// Act
@@ -1873,6 +2366,25 @@ func TestGetVaultRootToken_GetTokenError(t *testing.T) {
 	mockModule.AssertExpectations(t)
 }
 
+func TestGetVaultRootToken_DryRunUsesPlaceholder(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, mockModule := newTestRun(action.GetVaultRootToken)
+	run.Config.Action.Param = &action.Param{DryRun: true}
+
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return(nil)
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("", assert.AnError)
+
+	// Act
+	err := run.GetVaultRootToken()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, constant.DryRunToken, run.Config.Action.VaultRootToken)
+	mockDocker.AssertExpectations(t)
+	mockModule.AssertExpectations(t)
+}
+
 // ==================== GetKeycloakAccessToken Tests ====================
 
 func TestGetKeycloakAccessToken_MasterCustomToken(t *testing.T) {
@@ -2268,8 +2780,10 @@ func TestDeployModules_Success(t *testing.T) {
 	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.DeployModules)
 	mockModuleProps := &MockModuleProps{}
 	mockRegistrySvc := &MockRegistrySvc{}
+	mockLockSvc := &MockLockSvc{}
 	run.Config.ModuleProps = mockModuleProps
 	run.Config.RegistrySvc = mockRegistrySvc
+	run.Config.LockSvc = mockLockSvc
 
 	mockModuleProps.On("ReadBackendModules", false, true).Return(map[string]models.BackendModule{}, nil)
 	mockModuleProps.On("ReadFrontendModules", true).Return(map[string]models.FrontendModule{}, nil)
@@ -2283,29 +2797,87 @@ func TestDeployModules_Success(t *testing.T) {
 	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("access-token", nil)
 	mockManagement.On("CreateApplication", mock.Anything).Return(nil)
 	mockDocker.On("Close", mock.Anything).Return(nil)
+	mockModuleProps.On("ReadBackendModules", false, false).Return(map[string]models.BackendModule{}, nil)
+	mockModuleProps.On("ReadBackendModules", true, false).Return(map[string]models.BackendModule{}, nil)
+	mockModule.On("GetModuleImages", mock.Anything, "test-sidecar:latest").Return([]models.ModuleImage{})
+	lock := &models.Lock{SidecarImage: "test-sidecar:latest"}
+	mockLockSvc.On("CreateLock", mock.Anything, mock.Anything, []models.ModuleImage{}, "test-sidecar:latest").Return(lock, nil)
+	mockLockSvc.On("WriteLock", lock).Return(nil)
 
 	// Act
 	err := run.DeployModules()
 
 	// Assert
 	assert.NoError(t, err)
+	mockLockSvc.AssertExpectations(t)
+}
+
+func TestDeployModules_Locked(t *testing.T) {
+	// Arrange
+	run, mockManagement, mockKeycloak, _, mockDocker, mockModule := newTestRun(action.DeployModules)
+	run.Config.Action.Param.Locked = true
+	mockModuleProps := &MockModuleProps{}
+	mockRegistrySvc := &MockRegistrySvc{}
+	mockLockSvc := &MockLockSvc{}
+	run.Config.ModuleProps = mockModuleProps
+	run.Config.RegistrySvc = mockRegistrySvc
+	run.Config.LockSvc = mockLockSvc
+
+	lock := &models.Lock{
+		SidecarImage:  "folioorg/folio-module-sidecar:3.0.0",
+		SidecarDigest: "sha256:abc",
+		Modules:       []models.LockedModule{{ID: "mod-users-19.4.0", Name: "mod-users", Version: "19.4.0"}},
+	}
+	modules := &models.ProxyModulesByRegistry{FolioModules: []*models.ProxyModule{{ID: "mod-users-19.4.0"}}}
+	mockModuleProps.On("ReadBackendModules", false, true).Return(map[string]models.BackendModule{}, nil)
+	mockModuleProps.On("ReadFrontendModules", true).Return(map[string]models.FrontendModule{}, nil)
+	mockLockSvc.On("ReadLock").Return(lock, nil)
+	mockLockSvc.On("ValidateLock", lock).Return(nil)
+	mockLockSvc.On("GetLockFilePath").Return("eureka.combined.lock")
+	mockRegistrySvc.On("GetLockedModules", lock).Return(modules)
+	mockRegistrySvc.On("ExtractModuleMetadata", modules).Return()
+	mockDocker.On("Create").Return(nil, nil)
+	mockModule.On("GetVaultRootToken", mock.Anything).Return("", nil)
+	mockModule.On("PullModule", mock.Anything, "folioorg/folio-module-sidecar:3.0.0@sha256:abc").Return(nil)
+	mockModule.On("DeployModules", mock.Anything, mock.MatchedBy(func(containers *models.Containers) bool {
+		return containers.Lock == lock
+	}), "folioorg/folio-module-sidecar:3.0.0@sha256:abc", mock.Anything).Return(map[string]int{"mod-users": 8080}, nil)
+	mockModule.On("CheckModuleReadiness", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	mockKeycloak.On("GetMasterAccessToken", mock.Anything).Return("access-token", nil)
+	mockManagement.On("CreateApplication", mock.Anything).Return(nil)
+	mockDocker.On("Close", mock.Anything).Return(nil)
+
+	// Act
+	err := run.DeployModules()
+
+	// Assert
+	assert.NoError(t, err)
+	mockRegistrySvc.AssertNotCalled(t, "GetModules", mock.Anything)
+	mockModule.AssertNotCalled(t, "GetSidecarImage", mock.Anything)
+	mockLockSvc.AssertNotCalled(t, "WriteLock", mock.Anything)
+	mockModule.AssertExpectations(t)
 }
 
 // ==================== DeploySystem Tests ====================
 
 func TestDeploySystem_Success(t *testing.T) {
 	// Arrange
-	run, _, _, _, _, _ := newTestRun(action.DeploySystem)
+	run, _, _, _, mockDocker, _ := newTestRun(action.DeploySystem)
 	mockGitClient := &testhelpers.MockGitClient{}
 	mockExecSvc := &MockExecSvc{}
+	mockSystemSvc := &MockSystemSvc{}
 	run.Config.GitClient = mockGitClient
 	run.Config.ExecSvc = mockExecSvc
+	run.Config.SystemSvc = mockSystemSvc
 	params.BuildImages = false
 
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return(nil)
 	mockGitClient.On("KongRepository").Return(&gitrepository.GitRepository{}, nil)
 	mockGitClient.On("KeycloakRepository").Return(&gitrepository.GitRepository{}, nil)
 	mockGitClient.On("Clone", mock.Anything).Return(nil)
 	mockExecSvc.On("ExecFromDir", mock.Anything, mock.Anything).Return(nil)
+	mockSystemSvc.On("WaitForSystemReadiness", mock.Anything, []string(nil)).Return(nil)
 
 	// Act
 	err := run.DeploySystem()
@@ -2313,6 +2885,35 @@ func TestDeploySystem_Success(t *testing.T) {
 	// Assert
 	assert.NoError(t, err)
 	mockExecSvc.AssertExpectations(t)
+	mockSystemSvc.AssertExpectations(t)
+}
+
+func TestDeploySystem_NotReady(t *testing.T) {
+	// Arrange
+	run, _, _, _, mockDocker, _ := newTestRun(action.DeploySystem)
+	mockGitClient := &testhelpers.MockGitClient{}
+	mockExecSvc := &MockExecSvc{}
+	mockSystemSvc := &MockSystemSvc{}
+	run.Config.GitClient = mockGitClient
+	run.Config.ExecSvc = mockExecSvc
+	run.Config.SystemSvc = mockSystemSvc
+	params.BuildImages = false
+
+	expectedError := assert.AnError
+	mockDocker.On("Create").Return(nil, nil)
+	mockDocker.On("Close", mock.Anything).Return(nil)
+	mockGitClient.On("KongRepository").Return(&gitrepository.GitRepository{}, nil)
+	mockGitClient.On("KeycloakRepository").Return(&gitrepository.GitRepository{}, nil)
+	mockGitClient.On("Clone", mock.Anything).Return(nil)
+	mockExecSvc.On("ExecFromDir", mock.Anything, mock.Anything).Return(nil)
+	mockSystemSvc.On("WaitForSystemReadiness", mock.Anything, []string(nil)).Return(expectedError)
+
+	// Act
+	err := run.DeploySystem()
+
+	// Assert
+	assert.ErrorIs(t, err, expectedError)
+	mockSystemSvc.AssertExpectations(t)
 }
 
 func TestDeploySystem_ExecError(t *testing.T) {
@@ -55,41 +55,44 @@ func (run *Run) CreateConsortium() error {
 			slog.Info(run.Config.Action.Name, "text", "IGNORING CREATION OF CONSORTIUM", "consortium", consortium)
 			continue
 		}
-
-		centralTenant := run.Config.ConsortiumSvc.GetConsortiumCentralTenant(consortium)
-		if centralTenant == "" {
-			return errors.ConsortiumMissingCentralTenant(consortium)
-		}
-		_, err := run.GetKeycloakAccessToken(constant.DefaultToken, centralTenant)
-		if err != nil {
+		if err := run.createConsortium(consortium, entry); err != nil {
 			return err
 		}
+	}
 
-		slog.Info(run.Config.Action.Name, "text", "CREATING CONSORTIUM", "consortium", consortium)
-		consortiumID, err := run.Config.ConsortiumSvc.CreateConsortium(centralTenant, consortium)
-		if err != nil {
-			return err
-		}
-		consortiumTenants := run.Config.ConsortiumSvc.GetSortedConsortiumTenants(consortium)
-		consortiumUsers := run.Config.ConsortiumSvc.GetConsortiumUsers(consortium)
+	return nil
+}
 
-		slog.Info(run.Config.Action.Name, "text", "ADDING TENANTS TO CONSORTIUM", "tenants", consortiumTenants, "count", len(consortiumTenants), "consortium", consortium)
-		adminUsername := run.Config.ConsortiumSvc.GetAdminUsername(centralTenant, consortiumUsers)
-		if err := run.Config.ConsortiumSvc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername); err != nil {
-			return err
-		}
-		if !helpers.GetBool(entry, field.ConsortiumEnableCentralOrderingEntry) {
-			slog.Warn(run.Config.Action.Name, "text", "Ignoring enablement of central ordering", "tenant", centralTenant, "consortium", consortium)
-			continue
-		}
+func (run *Run) createConsortium(consortium string, entry map[string]any) error {
+	centralTenant := run.Config.ConsortiumSvc.GetConsortiumCentralTenant(consortium)
+	if centralTenant == "" {
+		return errors.ConsortiumMissingCentralTenant(consortium)
+	}
+	_, err := run.GetKeycloakAccessToken(constant.DefaultToken, centralTenant)
+	if err != nil {
+		return err
+	}
 
-		slog.Info(run.Config.Action.Name, "text", "ENABLING CENTRAL ORDERING", "tenant", centralTenant, "consortium", consortium)
-		if err := run.Config.ConsortiumSvc.EnableCentralOrdering(centralTenant); err != nil {
-			return err
-		}
+	slog.Info(run.Config.Action.Name, "text", "CREATING CONSORTIUM", "consortium", consortium)
+	consortiumID, err := run.Config.ConsortiumSvc.CreateConsortium(centralTenant, consortium)
+	if err != nil {
+		return err
 	}
+	consortiumTenants := run.Config.ConsortiumSvc.GetSortedConsortiumTenants(consortium)
+	consortiumUsers := run.Config.ConsortiumSvc.GetConsortiumUsers(consortium)
 
-	return nil
+	slog.Info(run.Config.Action.Name, "text", "ADDING TENANTS TO CONSORTIUM", "tenants", consortiumTenants, "count", len(consortiumTenants), "consortium", consortium)
+	adminUsername := run.Config.ConsortiumSvc.GetAdminUsername(centralTenant, consortiumUsers)
+	if err := run.Config.ConsortiumSvc.CreateConsortiumTenants(centralTenant, consortiumID, consortiumTenants, adminUsername); err != nil {
+		return err
+	}
+	if !helpers.GetBool(entry, field.ConsortiumEnableCentralOrderingEntry) {
+		slog.Warn(run.Config.Action.Name, "text", "Ignoring enablement of central ordering", "tenant", centralTenant, "consortium", consortium)
+		return nil
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "ENABLING CENTRAL ORDERING", "tenant", centralTenant, "consortium", consortium)
+	return run.Config.ConsortiumSvc.EnableCentralOrdering(centralTenant)
 }
 
 func init() {
@@ -16,17 +16,21 @@ limitations under the License.
 package cmd
 
 import (
-	"bytes"
+	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"os"
-	"os/exec"
-	"runtime"
+	"os/signal"
+	"slices"
+	"strconv"
+	"syscall"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -35,7 +39,7 @@ import (
 var createPortProxyCmd = &cobra.Command{
 	Use:   "createPortProxy",
 	Short: "Create port proxy",
-	Long:  `Create a platform-specific port proxy to reroute module traffic.`,
+	Long:  `Create a TCP port proxy from a sidecar hostname on the host network to a deployed sidecar to reroute module traffic.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		run, err := New(action.CreatePortProxy)
 		if err != nil {
@@ -47,109 +51,63 @@ var createPortProxyCmd = &cobra.Command{
 }
 
 func (run *Run) CreatePortProxy() error {
-	if runtime.GOOS == "windows" {
-		if err := run.createPortProxyForWindows(); err != nil {
-			return err
-		}
-	} else {
-		slog.Warn(run.Config.Action.Name, "text", "Command not implemented for platform", "platform", runtime.GOOS)
-	}
-
-	return nil
-}
-
-func (run *Run) createPortProxyForWindows() error {
-	var (
-		sidecarHostname = fmt.Sprintf("%s.eureka", helpers.GetSidecarName(params.ModuleName))
-		listenAddress   = fmt.Sprintf("listenaddress=%s", sidecarHostname)
-		listenPort      = fmt.Sprintf("listenport=%d", params.PrivatePort)
-		from            = fmt.Sprintf("%s:%d", sidecarHostname, params.PrivatePort)
-	)
+	sidecarName := helpers.GetSidecarName(params.ModuleName)
 	if params.Restore {
-		if err := run.showCurrentPortProxiesForWindows(); err != nil {
-			return err
-		}
-
-		if err := run.execWithArgs("netsh", "interface", "portproxy", "delete", "v4tov4", listenAddress, listenPort); err != nil {
+		proxy, err := run.Config.PortProxySvc.Stop(sidecarName)
+		if err != nil {
 			slog.Error(run.Config.Action.Name, "text", "Failed to remove port proxy", "error", err)
 			return err
 		}
-		slog.Info(run.Config.Action.Name, "text", "Deleted port proxy", "from", from)
+		slog.Info(run.Config.Action.Name, "text", "Deleted port proxy", "from", proxy.ListenAddress)
 
 		return nil
 	}
 
+	if params.SidecarURL == "" {
+		return errors.PortProxySidecarURLBlank()
+	}
 	sidecarPort, err := helpers.GetPortFromURL(params.SidecarURL)
 	if err != nil {
 		return err
 	}
 
-	var (
-		connectAddress = fmt.Sprintf("connectaddress=%s", params.GatewayHostname)
-		connectPort    = fmt.Sprintf("connectport=%d", sidecarPort)
-		to             = fmt.Sprintf("%s:%d", params.GatewayHostname, sidecarPort)
-	)
-	if err := run.execWithArgs("netsh", "interface", "portproxy", "add", "v4tov4", listenAddress, listenPort, connectAddress, connectPort); err != nil {
-		slog.Error(run.Config.Action.Name, "text", "Failed to add port proxy", "error", err)
-		return err
+	proxy := &models.PortProxy{
+		Name:          sidecarName,
+		ListenAddress: net.JoinHostPort(fmt.Sprintf("%s.eureka", sidecarName), strconv.Itoa(params.PrivatePort)),
+		TargetAddress: net.JoinHostPort(params.GatewayHostname, strconv.Itoa(sidecarPort)),
 	}
-	slog.Info(run.Config.Action.Name, "text", "Added a port proxy", "from", from, "to", to)
-
-	if err := run.showCurrentPortProxiesForWindows(); err != nil {
-		return err
+	if run.Config.Action.IsDryRun() {
+		slog.Info(run.Config.Action.Name, "text", "Skipping port proxy in dry run mode", "from", proxy.ListenAddress, "to", proxy.TargetAddress)
+		return nil
 	}
+	if params.Foreground {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
 
-	if err := run.execWithArgs("tracert", sidecarHostname); err != nil {
-		slog.Error(run.Config.Action.Name, "text", "Failed to trace hostname", "from", from, "error", err)
-		return errors.HostnameNotReachable(sidecarHostname, err)
+		return run.Config.PortProxySvc.Forward(ctx, proxy)
 	}
-	slog.Info(run.Config.Action.Name, "text", "Traced hostname", "from", sidecarHostname)
-
-	return nil
-}
 
-func (run *Run) showCurrentPortProxiesForWindows() error {
-	if err := run.execWithArgs("netsh", "interface", "portproxy", "show", "all"); err != nil {
-		slog.Error(run.Config.Action.Name, "text", "Failed to show port proxies", "error", err)
+	// The background process runs the same command in the foreground
+	args := append(slices.Clone(os.Args[1:]), "--"+action.Foreground.Long)
+	started, err := run.Config.PortProxySvc.StartDetached(proxy, args)
+	if err != nil {
+		slog.Error(run.Config.Action.Name, "text", "Failed to add port proxy", "error", err)
 		return err
 	}
-	slog.Info(run.Config.Action.Name, "text", "Showed current port proxies")
+	slog.Info(run.Config.Action.Name, "text", "Added a port proxy", "from", started.ListenAddress, "to", started.TargetAddress, "pid", started.PID, "log", started.LogFile)
 
 	return nil
 }
 
-func (run *Run) execWithArgs(args ...string) error {
-	if params.EnableDebug {
-		fmt.Println(args)
-	}
-
-	stdout, stderr, err := run.Config.ExecSvc.ExecReturnOutput(exec.Command(args[0], args[1:]...))
-	for _, b := range []bytes.Buffer{stdout, stderr} {
-		if b.Len() > 0 {
-			output := helpers.FilterEmptyLines(b.String())
-			if output != "" {
-				fmt.Println()
-				fmt.Println(output)
-				fmt.Println()
-			}
-		}
-	}
-
-	return err
-}
-
 func init() {
 	rootCmd.AddCommand(createPortProxyCmd)
 	createPortProxyCmd.PersistentFlags().StringVarP(&params.ModuleName, action.ModuleName.Long, action.ModuleName.Short, "", action.ModuleName.Description)
 	createPortProxyCmd.PersistentFlags().StringVarP(&params.SidecarURL, action.SidecarURL.Long, action.SidecarURL.Short, "", action.SidecarURL.Description)
 	createPortProxyCmd.PersistentFlags().IntVarP(&params.PrivatePort, action.PrivatePort.Long, action.PrivatePort.Short, 8081, action.PrivatePort.Description)
 	createPortProxyCmd.PersistentFlags().StringVarP(&params.GatewayHostname, action.GatewayHostname.Long, action.GatewayHostname.Short, "host.docker.internal", action.GatewayHostname.Description)
+	createPortProxyCmd.PersistentFlags().BoolVarP(&params.Foreground, action.Foreground.Long, action.Foreground.Short, false, action.Foreground.Description)
 	createPortProxyCmd.PersistentFlags().BoolVarP(&params.Restore, action.Restore.Long, action.Restore.Short, false, action.Restore.Description)
 
-	if err := createPortProxyCmd.MarkPersistentFlagRequired(action.SidecarURL.Long); err != nil {
-		slog.Error(errors.MarkFlagRequiredFailed(action.SidecarURL, err).Error())
-		os.Exit(1)
-	}
 	if err := createPortProxyCmd.MarkPersistentFlagRequired(action.ModuleName.Long); err != nil {
 		slog.Error(errors.MarkFlagRequiredFailed(action.ModuleName, err).Error())
 		os.Exit(1)
@@ -18,10 +18,8 @@ package cmd
 import (
 	"log/slog"
 	"os/exec"
-	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
-	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/spf13/cobra"
 )
@@ -59,7 +57,15 @@ func (run *Run) DeployAdditionalSystem() error {
 		return err
 	}
 	slog.Info(run.Config.Action.Name, "text", "WAITING FOR ADDITIONAL SYSTEM CONTAINERS TO BECOME READY")
-	time.Sleep(constant.DeployAdditionalSystemWait)
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	if err := run.Config.SystemSvc.WaitForSystemReadiness(client, finalRequiredContainers); err != nil {
+		return err
+	}
 	slog.Info(run.Config.Action.Name, "text", "All additional system containers are ready")
 
 	return nil
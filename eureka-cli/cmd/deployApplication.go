@@ -40,6 +40,11 @@ var deployApplicationCmd = &cobra.Command{
 			return err
 		}
 
+		if !params.DryRun {
+			if err := run.Config.JournalSvc.Begin(params.Resume); err != nil {
+				return err
+			}
+		}
 		if params.Cleanup {
 			err = run.DeployApplicationWithCleanup()
 		} else {
@@ -77,32 +82,41 @@ func (run *Run) DeployApplicationWithoutCleanup() error {
 }
 
 func (run *Run) DeployApplication() error {
-	if err := run.DeploySystem(); err != nil {
+	if err := run.JournalStep(action.DeploySystem, "", run.DeploySystem); err != nil {
 		return err
 	}
 	if err := run.PingKongStatus(); err != nil {
 		return err
 	}
-	if err := run.DeployManagement(); err != nil {
+	if err := run.JournalStep(action.DeployManagement, "", run.DeployManagement); err != nil {
 		return err
 	}
-	if err := run.DeployModules(); err != nil {
+	if err := run.JournalStep(action.DeployModules, "", run.DeployModules); err != nil {
 		return err
 	}
-	if err := run.CreateTenants(); err != nil {
+	if err := run.JournalStep(action.CreateTenants, "", run.CreateTenants); err != nil {
 		return err
 	}
 	if err := run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.CreateTenantEntitlements(consortiumName, tenantType); err != nil {
+		partition := getPartitionKey(consortiumName, string(tenantType))
+		if err := run.JournalStep(action.CreateTenantEntitlements, partition, func() error {
+			return run.CreateTenantEntitlements(consortiumName, tenantType)
+		}); err != nil {
 			return err
 		}
-		if err := run.CreateRoles(consortiumName, tenantType); err != nil {
+		if err := run.JournalStep(action.CreateRoles, partition, func() error {
+			return run.CreateRoles(consortiumName, tenantType)
+		}); err != nil {
 			return err
 		}
-		if err := run.CreateUsers(consortiumName, tenantType); err != nil {
+		if err := run.JournalStep(action.CreateUsers, partition, func() error {
+			return run.CreateUsers(consortiumName, tenantType)
+		}); err != nil {
 			return err
 		}
-		if err := run.AttachCapabilitySets(consortiumName, tenantType, constant.DeployApplicationPartitionWait); err != nil {
+		if err := run.JournalStep(action.AttachCapabilitySets, partition, func() error {
+			return run.AttachCapabilitySets(consortiumName, tenantType, constant.DeployApplicationPartitionWait)
+		}); err != nil {
 			return err
 		}
 		if consortiumName != constant.NoneConsortium {
@@ -113,18 +127,25 @@ func (run *Run) DeployApplication() error {
 	}); err != nil {
 		return err
 	}
-	if err := run.CreateConsortium(); err != nil {
+	if err := run.JournalStep(action.CreateConsortiums, "", run.CreateConsortium); err != nil {
 		return err
 	}
 	return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.DeployUi(consortiumName, tenantType); err != nil {
+		partition := getPartitionKey(consortiumName, string(tenantType))
+		if err := run.JournalStep(action.DeployUi, partition, func() error {
+			return run.DeployUi(consortiumName, tenantType)
+		}); err != nil {
 			return err
 		}
-		if err := run.UpdateKeycloakPublicClients(consortiumName, tenantType); err != nil {
+		if err := run.JournalStep(action.UpdateKeycloakPublicClients, partition, func() error {
+			return run.UpdateKeycloakPublicClients(consortiumName, tenantType)
+		}); err != nil {
 			return err
 		}
 		if helpers.IsModuleEnabled(constant.ModSearchModule, run.Config.Action.ConfigBackendModules) {
-			return run.ReindexIndices(consortiumName, tenantType)
+			return run.JournalStep(action.ReindexIndices, partition, func() error {
+				return run.ReindexIndices(consortiumName, tenantType)
+			})
 		}
 
 		return nil
@@ -132,21 +153,28 @@ func (run *Run) DeployApplication() error {
 }
 
 func (run *Run) DeployChildApplication() error {
-	if err := run.DeployAdditionalSystem(); err != nil {
+	if err := run.JournalStep(action.DeployAdditionalSystem, "", run.DeployAdditionalSystem); err != nil {
 		return err
 	}
-	if err := run.DeployModules(); err != nil {
+	if err := run.JournalStep(action.DeployModules, "", run.DeployModules); err != nil {
 		return err
 	}
 	return run.ConsortiumPartition(func(consortiumName string, tenantType constant.TenantType) error {
-		if err := run.CreateTenantEntitlements(consortiumName, tenantType); err != nil {
+		partition := getPartitionKey(consortiumName, string(tenantType))
+		if err := run.JournalStep(action.CreateTenantEntitlements, partition, func() error {
+			return run.CreateTenantEntitlements(consortiumName, tenantType)
+		}); err != nil {
 			return err
 		}
-		if err := run.DetachCapabilitySets(consortiumName, tenantType); err != nil {
+		if err := run.JournalStep(action.DetachCapabilitySets, partition, func() error {
+			return run.DetachCapabilitySets(consortiumName, tenantType)
+		}); err != nil {
 			return err
 		}
 
-		return run.AttachCapabilitySets(consortiumName, tenantType, 0*time.Second)
+		return run.JournalStep(action.AttachCapabilitySets, partition, func() error {
+			return run.AttachCapabilitySets(consortiumName, tenantType, 0*time.Second)
+		})
 	})
 }
 
@@ -157,4 +185,8 @@ func init() {
 	deployApplicationCmd.PersistentFlags().BoolVarP(&params.OnlyRequired, action.OnlyRequired.Long, action.OnlyRequired.Short, false, action.OnlyRequired.Description)
 	deployApplicationCmd.PersistentFlags().BoolVarP(&params.Cleanup, action.Cleanup.Long, action.Cleanup.Short, false, action.Cleanup.Description)
 	deployApplicationCmd.PersistentFlags().BoolVarP(&params.SkipRegistry, action.SkipRegistry.Long, action.SkipRegistry.Short, false, action.SkipRegistry.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.Locked, action.Locked.Long, action.Locked.Short, false, action.Locked.Description)
+	deployApplicationCmd.PersistentFlags().IntVarP(&params.Parallelism, action.Parallelism.Long, action.Parallelism.Short, constant.DefaultParallelism, action.Parallelism.Description)
+	deployApplicationCmd.PersistentFlags().BoolVarP(&params.Resume, action.Resume.Long, action.Resume.Short, false, action.Resume.Description)
+	deployApplicationCmd.MarkFlagsMutuallyExclusive(action.Cleanup.Long, action.Resume.Long)
 }
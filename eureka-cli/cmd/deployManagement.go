@@ -49,11 +49,10 @@ func (run *Run) DeployManagement() error {
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULE REGISTRIES")
-	modules, err := run.Config.RegistrySvc.GetModules(true)
+	modules, lock, err := run.GetRegistryModules(true)
 	if err != nil {
 		return err
 	}
-	run.Config.RegistrySvc.ExtractModuleMetadata(modules)
 
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
@@ -69,6 +68,7 @@ func (run *Run) DeployManagement() error {
 		Modules:        modules,
 		BackendModules: backendModules,
 		IsManagement:   true,
+		Lock:           lock,
 	}, "", nil)
 	if err != nil {
 		return err
@@ -99,4 +99,6 @@ func (run *Run) DeployManagement() error {
 func init() {
 	rootCmd.AddCommand(deployManagementCmd)
 	deployManagementCmd.PersistentFlags().BoolVarP(&params.SkipRegistry, action.SkipRegistry.Long, action.SkipRegistry.Short, false, action.SkipRegistry.Description)
+	deployManagementCmd.PersistentFlags().BoolVarP(&params.Locked, action.Locked.Long, action.Locked.Short, false, action.Locked.Description)
+	deployManagementCmd.PersistentFlags().IntVarP(&params.Parallelism, action.Parallelism.Long, action.Parallelism.Short, constant.DefaultParallelism, action.Parallelism.Description)
 }
@@ -43,6 +43,12 @@ var deployModulesCmd = &cobra.Command{
 }
 
 func (run *Run) DeployModules() error {
+	return run.deployModules(nil, true)
+}
+
+// deployModules deploys the given backend modules (or all if none are given)
+// and optionally creates the application from the full set of modules
+func (run *Run) deployModules(moduleNames []string, createApplication bool) error {
 	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULES")
 	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, true)
 	if err != nil {
@@ -56,11 +62,10 @@ func (run *Run) DeployModules() error {
 	}
 
 	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULE REGISTRIES")
-	modules, err := run.Config.RegistrySvc.GetModules(true)
+	modules, lock, err := run.GetRegistryModules(true)
 	if err != nil {
 		return err
 	}
-	run.Config.RegistrySvc.ExtractModuleMetadata(modules)
 
 	client, err := run.Config.DockerClient.Create()
 	if err != nil {
@@ -74,10 +79,11 @@ func (run *Run) DeployModules() error {
 	slog.Info(run.Config.Action.Name, "text", "PULLING SIDECAR IMAGE")
 	containers := &models.Containers{
 		Modules:        modules,
-		BackendModules: backendModules,
+		BackendModules: filterBackendModules(backendModules, moduleNames),
 		IsManagement:   false,
+		Lock:           lock,
 	}
-	sidecarImage, pullSidecarImage, err := run.Config.ModuleSvc.GetSidecarImage(containers.Modules.EurekaModules)
+	sidecarImage, pullSidecarImage, err := run.getSidecarImage(containers.Modules, lock)
 	if err != nil {
 		return err
 	}
@@ -105,7 +111,22 @@ func (run *Run) DeployModules() error {
 	if err := run.CheckDeployedModuleReadiness(constant.Module, deployedModules); err != nil {
 		return err
 	}
+	if !createApplication {
+		return nil
+	}
+	if err := run.createApplication(modules, backendModules, frontendModules); err != nil {
+		return err
+	}
+	if lock != nil || moduleNames != nil || run.Config.Action.IsDryRun() {
+		return nil
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "WRITING LOCK FILE")
+	return run.WriteLock(client, modules, sidecarImage)
+}
 
+// createApplication registers the application together with the module discovery of the backend modules
+func (run *Run) createApplication(modules *models.ProxyModulesByRegistry, backendModules map[string]models.BackendModule, frontendModules map[string]models.FrontendModule) error {
 	slog.Info(run.Config.Action.Name, "text", "CREATING APPLICATION")
 	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
 		return err
@@ -119,7 +140,33 @@ func (run *Run) DeployModules() error {
 	})
 }
 
+// getSidecarImage returns the sidecar image pinned in the lock file or the sidecar image resolved from the config and registry
+func (run *Run) getSidecarImage(modules *models.ProxyModulesByRegistry, lock *models.Lock) (string, bool, error) {
+	if lock != nil {
+		return lock.GetPinnedSidecarImage(), true, nil
+	}
+
+	return run.Config.ModuleSvc.GetSidecarImage(modules.EurekaModules)
+}
+
+func filterBackendModules(backendModules map[string]models.BackendModule, moduleNames []string) map[string]models.BackendModule {
+	if moduleNames == nil {
+		return backendModules
+	}
+
+	filtered := make(map[string]models.BackendModule, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		if backendModule, ok := backendModules[moduleName]; ok {
+			filtered[moduleName] = backendModule
+		}
+	}
+
+	return filtered
+}
+
 func init() {
 	rootCmd.AddCommand(deployModulesCmd)
 	deployModulesCmd.PersistentFlags().BoolVarP(&params.SkipRegistry, action.SkipRegistry.Long, action.SkipRegistry.Short, false, action.SkipRegistry.Description)
+	deployModulesCmd.PersistentFlags().BoolVarP(&params.Locked, action.Locked.Long, action.Locked.Short, false, action.Locked.Description)
+	deployModulesCmd.PersistentFlags().IntVarP(&params.Parallelism, action.Parallelism.Long, action.Parallelism.Short, constant.DefaultParallelism, action.Parallelism.Description)
 }
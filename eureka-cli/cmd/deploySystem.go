@@ -18,7 +18,6 @@ package cmd
 import (
 	"log/slog"
 	"os/exec"
-	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
@@ -51,12 +50,12 @@ func (run *Run) DeploySystem() error {
 		}
 	}
 
-	subCommand := []string{"compose", "--progress", "plain", "--ansi", "never", "--project-name", "eureka", "up", "--detach"}
+	var services []string
 	if params.OnlyRequired {
 		initialRequiredContainers := constant.GetInitialRequiredContainers()
-		finalRequiredContainers := helpers.AppendRequiredContainers(run.Config.Action.Name, initialRequiredContainers, run.Config.Action.ConfigBackendModules)
-		subCommand = append(subCommand, finalRequiredContainers...)
+		services = helpers.AppendRequiredContainers(run.Config.Action.Name, initialRequiredContainers, run.Config.Action.ConfigBackendModules)
 	}
+	subCommand := append([]string{"compose", "--progress", "plain", "--ansi", "never", "--project-name", "eureka", "up", "--detach"}, services...)
 
 	slog.Info(run.Config.Action.Name, "text", "DEPLOYING SYSTEM CONTAINERS")
 	homeDir, err := helpers.GetHomeMiscDir()
@@ -66,8 +65,17 @@ func (run *Run) DeploySystem() error {
 	if err := run.Config.ExecSvc.ExecFromDir(exec.Command("docker", subCommand...), homeDir); err != nil {
 		return err
 	}
+	run.clearTokenCache()
 	slog.Info(run.Config.Action.Name, "text", "WAITING FOR SYSTEM CONTAINERS TO BECOME READY")
-	time.Sleep(constant.DeploySystemWait)
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	if err := run.Config.SystemSvc.WaitForSystemReadiness(client, services); err != nil {
+		return err
+	}
 	slog.Info(run.Config.Action.Name, "text", "All system containers are ready")
 
 	return nil
@@ -0,0 +1,96 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// describeConsumerGroupCmd represents the describeConsumerGroup command
+var describeConsumerGroupCmd = &cobra.Command{
+	Use:   "describeConsumerGroup",
+	Short: "Describe a Kafka consumer group",
+	Long:  `Describe the state, the members and the lag per partition of a Kafka consumer group.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.DescribeConsumerGroup)
+		if err != nil {
+			return err
+		}
+
+		group, err := run.Config.KafkaSvc.DescribeConsumerGroup(params.Group, params.Tenant)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintConsumerGroup(os.Stdout, group, params.Output)
+	},
+}
+
+func (run *Run) PrintConsumerGroup(writer io.Writer, group *models.KafkaConsumerGroupDescription, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(group)
+	}
+
+	_, _ = fmt.Fprintf(writer, "Group: %s\nState: %s\nMembers: %d\nLag: %d\n\n", group.GroupID, group.State, len(group.Members), group.Lag)
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TOPIC\tPARTITION\tCOMMITTED\tEND\tLAG\tCLIENT")
+	for _, offset := range group.Offsets {
+		committedOffset, lag := "-", "-"
+		if offset.CommittedOffset >= 0 {
+			committedOffset, lag = strconv.FormatInt(offset.CommittedOffset, 10), strconv.FormatInt(offset.Lag, 10)
+		}
+		clientID := offset.ClientID
+		if clientID == "" {
+			clientID = "-"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%s\t%s\n", offset.Topic, offset.Partition, committedOffset, offset.EndOffset, lag, clientID)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(describeConsumerGroupCmd)
+	describeConsumerGroupCmd.PersistentFlags().StringVarP(&params.Group, action.Group.Long, action.Group.Short, "", action.Group.Description)
+	describeConsumerGroupCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	describeConsumerGroupCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := describeConsumerGroupCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
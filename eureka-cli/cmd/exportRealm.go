@@ -0,0 +1,81 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/spf13/cobra"
+)
+
+var exportRealmCmd = &cobra.Command{
+	Use:   "exportRealm",
+	Short: "Export Keycloak realm",
+	Long: `Export the settings, clients, roles and groups of a tenant realm into a JSON file, optionally with the users,
+e.g. to carry a realm setup across environments or to attach it to a bug report, the client secrets are masked by Keycloak.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ExportRealm)
+		if err != nil {
+			return err
+		}
+
+		return run.ExportRealm(params.Tenant, params.File, params.IncludeUsers)
+	},
+}
+
+// ExportRealm writes the partial export of a tenant realm to <tenant>-realm.json in the current directory unless a file is given
+func (run *Run) ExportRealm(tenantName, filePath string, includeUsers bool) error {
+	if filePath == "" {
+		filePath = fmt.Sprintf("%s-realm.json", tenantName)
+	}
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.Password); err != nil {
+		return err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "EXPORTING REALM", "realm", tenantName, "includeUsers", includeUsers)
+	realm, err := run.Config.KeycloakSvc.ExportRealm(tenantName, includeUsers)
+	if err != nil {
+		return err
+	}
+	if run.Config.Action.IsDryRun() {
+		slog.Info(run.Config.Action.Name, "text", "Skipping writing of realm export in dry run mode", "path", filePath)
+		return nil
+	}
+	if err := helpers.WriteJSONToFile(filePath, realm); err != nil {
+		return err
+	}
+	slog.Info(run.Config.Action.Name, "text", "Wrote realm export", "realm", tenantName, "path", filePath)
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(exportRealmCmd)
+	exportRealmCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	exportRealmCmd.PersistentFlags().StringVarP(&params.File, action.RealmFile.Long, action.RealmFile.Short, "", action.RealmFile.Description)
+	exportRealmCmd.PersistentFlags().BoolVarP(&params.IncludeUsers, action.IncludeUsers.Long, action.IncludeUsers.Short, false, action.IncludeUsers.Description)
+
+	if err := exportRealmCmd.MarkPersistentFlagRequired(action.Tenant.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Tenant, err).Error())
+		os.Exit(1)
+	}
+}
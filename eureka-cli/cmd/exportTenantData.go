@@ -0,0 +1,124 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var exportTenantDataCmd = &cobra.Command{
+	Use:   "exportTenantData",
+	Short: "Export tenant data",
+	Long: `Export all <tenant>_mod_* schemas of a tenant from the module database configured in the environment block
+into an archive that also records the entitled module versions, e.g. to share data that reproduces a bug.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ExportTenantData)
+		if err != nil {
+			return err
+		}
+
+		archive, filePath, err := run.ExportTenantData(params.Tenant, params.File)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintTenantDataArchive(os.Stdout, archive, filePath)
+	},
+}
+
+// ExportTenantData exports the module schemas of an entitled tenant and returns the archive manifest and path,
+// the archive is written to <tenant>-<timestamp>.tar.gz in the current directory unless a file is given
+func (run *Run) ExportTenantData(tenantName, filePath string) (*models.TenantDataArchive, string, error) {
+	if filePath == "" {
+		filePath = fmt.Sprintf("%s-%s.tar.gz", tenantName, time.Now().Format("20060102150405"))
+	}
+	moduleIDs, err := run.getEntitledModuleIDs(tenantName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "EXPORTING TENANT DATA", "tenant", tenantName, "file", filePath)
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, "", err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	archive, err := run.Config.SnapshotSvc.ExportTenantData(client, tenantName, moduleIDs, filePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return archive, filePath, nil
+}
+
+// getEntitledModuleIDs returns the ids of the modules of all applications entitled for the tenant
+func (run *Run) getEntitledModuleIDs(tenantName string) ([]string, error) {
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+		return nil, err
+	}
+	entitlements, err := run.Config.ManagementSvc.GetTenantEntitlements(tenantName, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(entitlements.Entitlements) == 0 {
+		return nil, errors.TenantNotEntitled(tenantName)
+	}
+
+	moduleIDs := []string{}
+	for _, entitlement := range entitlements.Entitlements {
+		moduleIDs = append(moduleIDs, entitlement.Modules...)
+	}
+
+	return moduleIDs, nil
+}
+
+func (run *Run) PrintTenantDataArchive(writer io.Writer, archive *models.TenantDataArchive, filePath string) error {
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "Tenant\t%s\n", archive.Tenant)
+	_, _ = fmt.Fprintf(tw, "File\t%s\n", filePath)
+	_, _ = fmt.Fprintf(tw, "Profile\t%s\n", archive.Profile)
+	_, _ = fmt.Fprintf(tw, "Database\t%s\n", archive.Database)
+	_, _ = fmt.Fprintf(tw, "Created\t%s\n", archive.CreatedAt.Local().Format(time.DateTime))
+	_, _ = fmt.Fprintf(tw, "Schemas\t%d\n", len(archive.Schemas))
+	for _, schema := range archive.Schemas {
+		_, _ = fmt.Fprintf(tw, "  %s\t%s\n", schema.Name, schema.ModuleID)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(exportTenantDataCmd)
+	exportTenantDataCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	exportTenantDataCmd.PersistentFlags().StringVarP(&params.File, action.TenantDataFile.Long, action.TenantDataFile.Short, "", action.TenantDataFile.Description)
+
+	if err := exportTenantDataCmd.MarkPersistentFlagRequired(action.Tenant.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Tenant, err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var findRouteCmd = &cobra.Command{
+	Use:   "findRoute",
+	Short: "Find the Kong route of a request",
+	Long: `Evaluate a request method and path against the Kong route expressions and print the matching routes with the module
+and discovery location that serve the request, the route listed first is the one Kong picks, e.g. to explain a 404 from the gateway.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.FindRoute)
+		if err != nil {
+			return err
+		}
+
+		routes, err := run.FindRoute(params.Method, params.Path)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintKongRoutes(os.Stdout, routes, params.Output)
+	},
+}
+
+// FindRoute returns the routes matching a request, a request that no route matches is not found
+func (run *Run) FindRoute(method, path string) ([]models.KongRouteInfo, error) {
+	routes, err := run.Config.KongSvc.FindRoutes(method, path)
+	if err != nil {
+		return nil, err
+	}
+	if len(routes) == 0 {
+		return nil, errors.KongRouteNotFound(method, path)
+	}
+
+	return routes, nil
+}
+
+func init() {
+	rootCmd.AddCommand(findRouteCmd)
+	findRouteCmd.PersistentFlags().StringVarP(&params.Method, action.Method.Long, action.Method.Short, "GET", action.Method.Description)
+	findRouteCmd.PersistentFlags().StringVarP(&params.Path, action.RoutePath.Long, action.RoutePath.Short, "", action.RoutePath.Description)
+	findRouteCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := findRouteCmd.MarkPersistentFlagRequired(action.RoutePath.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.RoutePath, err).Error())
+		os.Exit(1)
+	}
+	if err := findRouteCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/spf13/cobra"
 )
 
@@ -77,7 +78,10 @@ func (run *Run) GetKeycloakAccessToken(tokenType, tenant string) (string, error)
 }
 
 func (run *Run) setKeycloakAccessTokenIntoContext(tenant string) error {
-	accessToken, err := run.Config.KeycloakSvc.GetAccessToken(tenant)
+	key := run.newTokenCacheKey(tenant, action.GetConfigEnv("KC_SERVICE_CLIENT_ID", run.Config.Action.ConfigGlobalEnv), constant.Password)
+	accessToken, err := run.getCachedAccessToken(key, func() (string, error) {
+		return run.Config.KeycloakSvc.GetAccessToken(tenant)
+	})
 	if err != nil {
 		return err
 	}
@@ -87,7 +91,14 @@ func (run *Run) setKeycloakAccessTokenIntoContext(tenant string) error {
 }
 
 func (run *Run) setKeycloakMasterAccessTokenIntoContext(grantType constant.KeycloakGrantType) error {
-	accessToken, err := run.Config.KeycloakSvc.GetMasterAccessToken(grantType)
+	clientID := constant.KeycloakAdminClient
+	if grantType == constant.ClientCredentials {
+		clientID = action.GetConfigEnv("KC_ADMIN_CLIENT_ID", run.Config.Action.ConfigGlobalEnv)
+	}
+	key := run.newTokenCacheKey(constant.KeycloakMasterRealm, clientID, string(grantType))
+	accessToken, err := run.getCachedAccessToken(key, func() (string, error) {
+		return run.Config.KeycloakSvc.GetMasterAccessToken(grantType)
+	})
 	if err != nil {
 		return err
 	}
@@ -96,6 +107,19 @@ func (run *Run) setKeycloakMasterAccessTokenIntoContext(grantType constant.Keycl
 	return nil
 }
 
+func (run *Run) newTokenCacheKey(realm, client, grantType string) models.TokenCacheKey {
+	return models.TokenCacheKey{Profile: run.Config.Action.ConfigProfileName, Realm: realm, Client: client, GrantType: grantType}
+}
+
+// getCachedAccessToken returns the access token of the key from the token cache shared across invocations, or fetches it when no cache is configured
+func (run *Run) getCachedAccessToken(key models.TokenCacheKey, fetchFn func() (string, error)) (string, error) {
+	if run.Config.TokenCacheSvc == nil {
+		return fetchFn()
+	}
+
+	return run.Config.TokenCacheSvc.GetAccessToken(key, fetchFn)
+}
+
 func init() {
 	rootCmd.AddCommand(getKeycloakAccessTokenCmd)
 	getKeycloakAccessTokenCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
@@ -17,9 +17,11 @@ package cmd
 
 import (
 	"fmt"
+	"log/slog"
 
 	"github.com/docker/docker/client"
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/spf13/cobra"
 )
 
@@ -53,7 +55,11 @@ func (run *Run) GetVaultRootToken() error {
 }
 
 func (run *Run) setVaultRootTokenIntoContext(client *client.Client) error {
-	rootToken, err := run.Config.ModuleSvc.GetVaultRootToken(client)
+	rootToken, err := run.getCachedVaultRootToken(client)
+	if err != nil && run.Config.Action.IsDryRun() {
+		slog.Warn(run.Config.Action.Name, "text", "Using placeholder Vault root token in dry run", "error", err)
+		rootToken, err = constant.DryRunToken, nil
+	}
 	if err != nil {
 		return err
 	}
@@ -62,6 +68,23 @@ func (run *Run) setVaultRootTokenIntoContext(client *client.Client) error {
 	return nil
 }
 
+// getCachedVaultRootToken returns the root token from the token cache shared across invocations, the token is
+// cached by the id of the Vault container so that a redeployed Vault with a new root token is not served a stale one
+func (run *Run) getCachedVaultRootToken(client *client.Client) (string, error) {
+	if run.Config.TokenCacheSvc == nil {
+		return run.Config.ModuleSvc.GetVaultRootToken(client)
+	}
+	containerID, err := run.Config.ModuleSvc.GetVaultContainerID(client)
+	if err != nil {
+		return "", err
+	}
+
+	key := run.newTokenCacheKey(constant.VaultContainer, containerID, constant.VaultRootTokenGrant)
+	return run.Config.TokenCacheSvc.GetRootToken(key, func() (string, error) {
+		return run.Config.ModuleSvc.GetVaultRootToken(client)
+	})
+}
+
 func init() {
 	rootCmd.AddCommand(getVaultRootTokenCmd)
 }
@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var importRealmCmd = &cobra.Command{
+	Use:   "importRealm",
+	Short: "Import Keycloak realm",
+	Long: `Apply the settings of a realm export written by exportRealm to the tenant realm and import its clients, roles, groups and users,
+existing resources are skipped unless --overwrite is used and the masked client secrets are taken from Vault.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ImportRealm)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		response, err := run.ImportRealm(params.Tenant, params.File, params.Overwrite)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintRealmImport(os.Stdout, response)
+	},
+}
+
+func (run *Run) ImportRealm(tenantName, filePath string, overwrite bool) (*models.KeycloakPartialImportResponse, error) {
+	var realm map[string]any
+	if err := helpers.ReadJSONFromFile(filePath, &realm); err != nil {
+		return nil, err
+	}
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.Password); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "IMPORTING REALM", "realm", tenantName, "file", filePath, "overwrite", overwrite)
+	return run.Config.KeycloakSvc.ImportRealm(tenantName, realm, overwrite)
+}
+
+func (run *Run) PrintRealmImport(writer io.Writer, response *models.KeycloakPartialImportResponse) error {
+	if run.Config.Action.IsDryRun() {
+		return nil
+	}
+	_, err := fmt.Fprintf(writer, "Imported realm resources: %d added, %d overwritten, %d skipped\n", response.Added, response.Overwritten, response.Skipped)
+
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(importRealmCmd)
+	importRealmCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	importRealmCmd.PersistentFlags().StringVarP(&params.File, action.RealmFile.Long, action.RealmFile.Short, "", action.RealmFile.Description)
+	importRealmCmd.PersistentFlags().BoolVarP(&params.Overwrite, action.Overwrite.Long, action.Overwrite.Short, false, action.Overwrite.Description)
+
+	if err := importRealmCmd.MarkPersistentFlagRequired(action.Tenant.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Tenant, err).Error())
+		os.Exit(1)
+	}
+	if err := importRealmCmd.MarkPersistentFlagRequired(action.RealmFile.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.RealmFile, err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var importTenantDataCmd = &cobra.Command{
+	Use:   "importTenantData",
+	Short: "Import tenant data",
+	Long: `Replace the <tenant>_mod_* schemas of an entitled tenant with the schemas of an archive written by exportTenantData,
+the schemas are renamed when the archive was exported from another tenant and a warning is logged for every module version that differs.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ImportTenantData)
+		if err != nil {
+			return err
+		}
+
+		archive, err := run.ImportTenantData(params.Tenant, params.File)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintTenantDataArchive(os.Stdout, archive, params.File)
+	},
+}
+
+// ImportTenantData imports the module schemas of an archive into an entitled tenant, module versions that differ
+// from the exported versions are logged as warnings and do not stop the import
+func (run *Run) ImportTenantData(tenantName, filePath string) (*models.TenantDataArchive, error) {
+	archive, err := run.Config.SnapshotSvc.ReadTenantDataArchive(filePath)
+	if err != nil {
+		return nil, err
+	}
+	moduleIDs, err := run.getEntitledModuleIDs(tenantName)
+	if err != nil {
+		return nil, err
+	}
+	for _, mismatch := range run.Config.SnapshotSvc.GetTenantDataModuleMismatches(archive, moduleIDs) {
+		slog.Warn(run.Config.Action.Name, "text", "Module version differs from the exported version", "schema", mismatch.Schema,
+			"archiveModule", mismatch.ArchiveModuleID, "tenantModule", mismatch.TenantModuleID)
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "IMPORTING TENANT DATA", "tenant", tenantName, "sourceTenant", archive.Tenant, "file", filePath)
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	if err := run.Config.SnapshotSvc.ImportTenantData(client, tenantName, filePath, archive); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func init() {
+	rootCmd.AddCommand(importTenantDataCmd)
+	importTenantDataCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	importTenantDataCmd.PersistentFlags().StringVarP(&params.File, action.TenantDataFile.Long, action.TenantDataFile.Short, "", action.TenantDataFile.Description)
+
+	if err := importTenantDataCmd.MarkPersistentFlagRequired(action.Tenant.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Tenant, err).Error())
+		os.Exit(1)
+	}
+	if err := importTenantDataCmd.MarkPersistentFlagRequired(action.TenantDataFile.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.TenantDataFile, err).Error())
+		os.Exit(1)
+	}
+}
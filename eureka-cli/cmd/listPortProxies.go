@@ -0,0 +1,92 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// listPortProxiesCmd represents the listPortProxies command
+var listPortProxiesCmd = &cobra.Command{
+	Use:   "listPortProxies",
+	Short: "List port proxies",
+	Long:  `List the port proxies created by createPortProxy together with the state of their processes.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.ListPortProxies)
+		if err != nil {
+			return err
+		}
+
+		proxies, err := run.Config.PortProxySvc.ListPortProxies()
+		if err != nil {
+			return err
+		}
+
+		return run.PrintPortProxies(os.Stdout, proxies, params.Output)
+	},
+}
+
+func (run *Run) PrintPortProxies(writer io.Writer, proxies []models.PortProxy, output string) error {
+	if output == constant.JSONOutput {
+		if proxies == nil {
+			proxies = []models.PortProxy{}
+		}
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(proxies)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tFROM\tTO\tPID\tSTATE\tSTARTED")
+	for _, proxy := range proxies {
+		state := "running"
+		if !proxy.Running {
+			state = "stopped"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%s\t%s\n", proxy.Name, proxy.ListenAddress, proxy.TargetAddress, proxy.PID, state, proxy.StartedAt.Local().Format(time.DateTime))
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(listPortProxiesCmd)
+	listPortProxiesCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := listPortProxiesCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// listProfilesCmd represents the listProfiles command
+var listProfilesCmd = &cobra.Command{
+	Use:   "listProfiles",
+	Short: "List profiles",
+	Long:  `List the built-in and user-defined profiles found in the project-local and home .eureka directories.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.ListProfiles)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintProfiles(os.Stdout, run.Config.ConfigSvc.ListProfiles(), params.Output)
+	},
+}
+
+func (run *Run) PrintProfiles(writer io.Writer, profiles []models.ProfileSummary, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(profiles)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tAPPLICATION\tPORTS\tCHILD APP\tEXTENDS\tFILE")
+	for _, profile := range profiles {
+		if profile.Error != "" {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t\t\t\t%s\n", profile.Name, profile.Error, profile.FilePath)
+			continue
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%s\t%s\n", profile.Name, profile.Application, getPortRange(profile), profile.ChildApp, profile.Extends, profile.FilePath)
+	}
+
+	return tw.Flush()
+}
+
+func getPortRange(profile models.ProfileSummary) string {
+	if profile.PortStart == 0 && profile.PortEnd == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d-%d", profile.PortStart, profile.PortEnd)
+}
+
+func init() {
+	rootCmd.AddCommand(listProfilesCmd)
+	listProfilesCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := listProfilesCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
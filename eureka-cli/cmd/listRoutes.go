@@ -0,0 +1,89 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var listRoutesCmd = &cobra.Command{
+	Use:   "listRoutes",
+	Short: "List Kong routes",
+	Long:  `List the Kong gateway routes with their expression, methods, module and the discovery location their service forwards to.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.ListRoutes)
+		if err != nil {
+			return err
+		}
+
+		routes, err := run.Config.KongSvc.ListRoutes(params.ModuleName)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintKongRoutes(os.Stdout, routes, params.Output)
+	},
+}
+
+func (run *Run) PrintKongRoutes(writer io.Writer, routes []models.KongRouteInfo, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(routes)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "MODULE\tMETHODS\tROUTE\tLOCATION")
+	for _, route := range routes {
+		rule := route.Expression
+		if rule == "" {
+			rule = strings.Join(route.Paths, ",")
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", route.ModuleID, strings.Join(route.Methods, ","), rule, route.Location)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(listRoutesCmd)
+	listRoutesCmd.PersistentFlags().StringVarP(&params.ModuleName, action.ModuleName.Long, action.ModuleName.Short, "", action.ModuleName.Description)
+	listRoutesCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := listRoutesCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// listTopicsCmd represents the listTopics command
+var listTopicsCmd = &cobra.Command{
+	Use:   "listTopics",
+	Short: "List Kafka topics",
+	Long:  `List the Kafka topics of the environment or of a tenant with the number of retained messages.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.ListTopics)
+		if err != nil {
+			return err
+		}
+
+		topics, err := run.Config.KafkaSvc.ListTopics(params.Tenant)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintTopics(os.Stdout, topics, params.Output)
+	},
+}
+
+func (run *Run) PrintTopics(writer io.Writer, topics []models.KafkaTopicSummary, output string) error {
+	if output == constant.JSONOutput {
+		if topics == nil {
+			topics = []models.KafkaTopicSummary{}
+		}
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(topics)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TOPIC\tTENANT\tPARTITIONS\tMESSAGES")
+	for _, topic := range topics {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\t%d\n", topic.Name, topic.Tenant, topic.Partitions, topic.Messages)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(listTopicsCmd)
+	listTopicsCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	listTopicsCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := listTopicsCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
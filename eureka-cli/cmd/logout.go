@@ -0,0 +1,75 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"log/slog"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/spf13/cobra"
+)
+
+// logoutCmd represents the logout command
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Clear cached tokens",
+	Long:  `Clear the Vault root token and the Keycloak access tokens cached across invocations, the next command fetches new tokens.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.Logout)
+		if err != nil {
+			return err
+		}
+
+		return run.Logout()
+	},
+}
+
+func (run *Run) Logout() error {
+	filePath, err := run.Config.TokenCacheSvc.GetTokenCacheFilePath()
+	if err != nil {
+		return err
+	}
+	if run.Config.Action.IsDryRun() {
+		slog.Info(run.Config.Action.Name, "text", "Skipping token cache removal in dry run mode", "path", filePath)
+		return nil
+	}
+
+	count, err := run.Config.TokenCacheSvc.Clear()
+	if err != nil {
+		return err
+	}
+	slog.Info(run.Config.Action.Name, "text", "Cleared token cache", "path", filePath, "tokens", count)
+
+	return nil
+}
+
+// clearTokenCache drops the cached tokens when the system containers issuing them are replaced,
+// a failure only logs a warning since the tokens are refreshed when a request rejects them
+func (run *Run) clearTokenCache() {
+	if run.Config.TokenCacheSvc == nil || run.Config.Action.IsDryRun() {
+		return
+	}
+	count, err := run.Config.TokenCacheSvc.Clear()
+	if err != nil {
+		slog.Warn(run.Config.Action.Name, "text", "Failed to clear token cache", "error", err)
+		return
+	}
+	slog.Debug(run.Config.Action.Name, "text", "Cleared token cache", "tokens", count)
+}
+
+func init() {
+	rootCmd.AddCommand(logoutCmd)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const logColorReset = "\033[0m"
+
+var logColors = []string{"\033[36m", "\033[33m", "\033[32m", "\033[35m", "\033[34m", "\033[96m", "\033[93m", "\033[92m", "\033[95m", "\033[94m"}
+
+// logsCmd represents the logs command
+var logsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Show module logs",
+	Long:  `Show the logs of one or more modules, their sidecars and the management modules interleaved by timestamp.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		options, err := getLogOptions()
+		if err != nil {
+			return err
+		}
+
+		run, err := New(action.Logs)
+		if err != nil {
+			return err
+		}
+
+		return run.Logs(os.Stdout, options)
+	},
+}
+
+func getLogOptions() (models.LogOptions, error) {
+	if len(params.ModuleNames) == 0 && !params.All {
+		return models.LogOptions{}, errors.LogModulesNotSpecified()
+	}
+
+	options := models.LogOptions{Follow: params.Follow, Since: params.Since, Level: strings.ToUpper(params.Level)}
+	if options.Level != "" && !slices.Contains(constant.GetLogLevels(), options.Level) {
+		return models.LogOptions{}, errors.UnsupportedLogLevel(params.Level, constant.GetLogLevels())
+	}
+	if params.Grep != "" {
+		grep, err := regexp.Compile(params.Grep)
+		if err != nil {
+			return models.LogOptions{}, errors.InvalidLogPattern(params.Grep, err)
+		}
+		options.Grep = grep
+	}
+
+	return options, nil
+}
+
+func (run *Run) Logs(writer io.Writer, options models.LogOptions) error {
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	var moduleNames []string
+	if !params.All {
+		moduleNames = params.ModuleNames
+	}
+	containerNames, err := run.Config.ModuleSvc.GetLogContainers(client, moduleNames, params.Sidecar)
+	if err != nil {
+		return err
+	}
+	slog.Debug(run.Config.Action.Name, "text", "Reading container logs", "containers", containerNames, "follow", options.Follow)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	printer := run.newLogPrinter(writer, containerNames, helpers.IsTerminal(os.Stdout))
+	return run.Config.ModuleSvc.ReadModuleLogs(ctx, client, containerNames, options, printer.print)
+}
+
+// logPrinter prefixes every log line with the name of its module or sidecar, padded to a common width
+// and colored per container when writing to a terminal
+type logPrinter struct {
+	writer io.Writer
+	names  map[string]string
+	colors map[string]string
+	width  int
+}
+
+func (run *Run) newLogPrinter(writer io.Writer, containerNames []string, colored bool) *logPrinter {
+	printer := &logPrinter{writer: writer, names: make(map[string]string), colors: make(map[string]string)}
+	prefix := fmt.Sprintf("eureka-%s-", run.Config.Action.ConfigProfileName)
+	for idx, containerName := range containerNames {
+		name, found := strings.CutPrefix(containerName, prefix)
+		if !found {
+			name = strings.TrimPrefix(containerName, "eureka-")
+		}
+		printer.names[containerName] = name
+		printer.width = max(printer.width, len(name))
+		if colored {
+			printer.colors[containerName] = logColors[idx%len(logColors)]
+		}
+	}
+
+	return printer
+}
+
+func (p *logPrinter) print(line models.LogLine) {
+	color, reset := p.colors[line.Container], ""
+	if color != "" {
+		reset = logColorReset
+	}
+	_, _ = fmt.Fprintf(p.writer, "%s%-*s |%s %s\n", color, p.width, p.names[line.Container], reset, line.Text)
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+	logsCmd.PersistentFlags().StringSliceVarP(&params.ModuleNames, action.ModuleName.Long, action.ModuleName.Short, []string{}, action.ModuleName.Description)
+	logsCmd.PersistentFlags().BoolVarP(&params.All, action.AllModules.Long, action.AllModules.Short, false, action.AllModules.Description)
+	logsCmd.PersistentFlags().BoolVarP(&params.Sidecar, action.Sidecar.Long, action.Sidecar.Short, false, action.Sidecar.Description)
+	logsCmd.PersistentFlags().BoolVarP(&params.Follow, action.Follow.Long, action.Follow.Short, false, action.Follow.Description)
+	logsCmd.PersistentFlags().StringVarP(&params.Since, action.Since.Long, action.Since.Short, "", action.Since.Description)
+	logsCmd.PersistentFlags().StringVarP(&params.Grep, action.Grep.Long, action.Grep.Short, "", action.Grep.Description)
+	logsCmd.PersistentFlags().StringVarP(&params.Level, action.Level.Long, action.Level.Short, "", fmt.Sprintf(action.Level.Description, constant.GetLogLevels()))
+
+	if err := logsCmd.RegisterFlagCompletionFunc(action.ModuleName.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return helpers.GetBackendModuleNames(viper.GetStringMap(field.BackendModules)), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+	if err := logsCmd.RegisterFlagCompletionFunc(action.Level.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetLogLevels(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// migrateConsortiumUsersCmd represents the migrateConsortiumUsers command
+var migrateConsortiumUsersCmd = &cobra.Command{
+	Use:   "migrateConsortiumUsers",
+	Short: "Migrate consortium users",
+	Long:  `Create the identity providers of the consortium member tenants in the central tenant realm and migrate the member tenant users to them, or delete both with --delete.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.MigrateConsortiumUsers)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		return run.MigrateConsortiumUsers(os.Stdout)
+	},
+}
+
+func (run *Run) MigrateConsortiumUsers(writer io.Writer) error {
+	consortiumNames := helpers.SortedMapKeys(run.Config.Action.ConfigConsortiums)
+	if params.Consortium != "" {
+		if !slices.Contains(consortiumNames, params.Consortium) {
+			return errors.ConsortiumNotFound(params.Consortium)
+		}
+		consortiumNames = []string{params.Consortium}
+	}
+
+	var results []models.ConsortiumUserMigration
+	for _, consortiumName := range consortiumNames {
+		consortiumResults, err := run.migrateConsortiumUsers(consortiumName)
+		if err != nil {
+			return err
+		}
+		results = append(results, consortiumResults...)
+	}
+	if err := run.PrintConsortiumUserMigrations(writer, results, params.Output); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Status == constant.UserMigrationFailed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.ConsortiumUserMigrationFailed(failed, len(results))
+	}
+
+	return nil
+}
+
+func (run *Run) migrateConsortiumUsers(consortiumName string) ([]models.ConsortiumUserMigration, error) {
+	centralTenant := run.Config.ConsortiumSvc.GetConsortiumCentralTenant(consortiumName)
+	if centralTenant == "" {
+		return nil, errors.ConsortiumMissingCentralTenant(consortiumName)
+	}
+	if _, err := run.GetKeycloakAccessToken(constant.DefaultToken, centralTenant); err != nil {
+		return nil, err
+	}
+
+	consortium, err := run.Config.ConsortiumSvc.GetConsortiumByName(centralTenant, consortiumName)
+	if err != nil {
+		return nil, err
+	}
+	if consortium == nil {
+		return nil, errors.ConsortiumNotFound(consortiumName)
+	}
+	consortiumID := helpers.GetString(consortium.(map[string]any), "id")
+	consortiumTenants := run.Config.ConsortiumSvc.GetSortedConsortiumTenants(consortiumName)
+	if params.Delete {
+		slog.Info(run.Config.Action.Name, "text", "DELETING CONSORTIUM USERS", "consortium", consortiumName, "tenants", consortiumTenants)
+	} else {
+		slog.Info(run.Config.Action.Name, "text", "MIGRATING CONSORTIUM USERS", "consortium", consortiumName, "tenants", consortiumTenants)
+	}
+
+	return run.Config.ConsortiumSvc.MigrateConsortiumUsers(centralTenant, consortiumID, consortiumTenants, params.Delete), nil
+}
+
+func (run *Run) PrintConsortiumUserMigrations(writer io.Writer, results []models.ConsortiumUserMigration, output string) error {
+	if output == constant.JSONOutput {
+		if results == nil {
+			results = []models.ConsortiumUserMigration{}
+		}
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TENANT\tUSERS\tSTATUS\tERROR")
+	for _, result := range results {
+		users := "-"
+		if result.Users != nil {
+			users = strconv.Itoa(*result.Users)
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.Tenant, users, result.Status, result.Error)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(migrateConsortiumUsersCmd)
+	migrateConsortiumUsersCmd.PersistentFlags().StringVarP(&params.Consortium, action.Consortium.Long, action.Consortium.Short, "", action.Consortium.Description)
+	migrateConsortiumUsersCmd.PersistentFlags().BoolVarP(&params.Delete, action.Delete.Long, action.Delete.Short, false, action.Delete.Description)
+	migrateConsortiumUsersCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := migrateConsortiumUsersCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// publishMessageCmd represents the publishMessage command
+var publishMessageCmd = &cobra.Command{
+	Use:   "publishMessage",
+	Short: "Publish a Kafka message",
+	Long: `Publish a JSON message read from a file to a Kafka topic, e.g. to inject a test event for a tenant.
+A message key picks the partition like the Java producer does, so that the message is ordered with the other messages of the same record.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.PublishMessage)
+		if err != nil {
+			return err
+		}
+
+		return run.PublishMessage(os.Stdout)
+	},
+}
+
+func (run *Run) PublishMessage(writer io.Writer) error {
+	value, err := os.ReadFile(params.File)
+	if err != nil {
+		return err
+	}
+	if !json.Valid(value) {
+		return errors.KafkaMessageNotJSON(params.File)
+	}
+	headers, err := getMessageHeaders(params.Headers)
+	if err != nil {
+		return err
+	}
+
+	topicName := run.Config.KafkaSvc.GetTopicName(params.Topic, params.Tenant)
+	slog.Info(run.Config.Action.Name, "text", "PUBLISHING MESSAGE", "topic", topicName, "file", params.File, "key", params.Key)
+	message := models.KafkaRecord{Topic: topicName, Partition: -1, Value: value, Headers: headers}
+	if params.Key != "" {
+		message.Key = []byte(params.Key)
+	}
+	record, err := run.Config.KafkaSvc.PublishMessage(message)
+	if err != nil {
+		return err
+	}
+	if run.Config.Action.IsDryRun() {
+		return nil
+	}
+	_, err = fmt.Fprintf(writer, "Published message to %s [%d] at offset %d\n", record.Topic, record.Partition, record.Offset)
+
+	return err
+}
+
+// getMessageHeaders parses the name=value pairs of the header flags in the order they were given
+func getMessageHeaders(pairs []string) ([]models.KafkaRecordHeader, error) {
+	var headers []models.KafkaRecordHeader
+	for _, pair := range pairs {
+		name, value, found := strings.Cut(pair, "=")
+		if !found || strings.TrimSpace(name) == "" {
+			return nil, errors.KafkaMessageHeaderInvalid(pair)
+		}
+		headers = append(headers, models.KafkaRecordHeader{Key: strings.TrimSpace(name), Value: []byte(value)})
+	}
+
+	return headers, nil
+}
+
+func init() {
+	rootCmd.AddCommand(publishMessageCmd)
+	publishMessageCmd.PersistentFlags().StringVarP(&params.Topic, action.Topic.Long, action.Topic.Short, "", action.Topic.Description)
+	publishMessageCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	publishMessageCmd.PersistentFlags().StringVarP(&params.File, action.File.Long, action.File.Short, "", action.File.Description)
+	publishMessageCmd.PersistentFlags().StringVarP(&params.Key, action.MessageKey.Long, action.MessageKey.Short, "", action.MessageKey.Description)
+	publishMessageCmd.PersistentFlags().StringArrayVarP(&params.Headers, action.Header.Long, action.Header.Short, []string{}, action.Header.Description)
+
+	if err := publishMessageCmd.MarkPersistentFlagRequired(action.Topic.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Topic, err).Error())
+		os.Exit(1)
+	}
+	if err := publishMessageCmd.MarkPersistentFlagRequired(action.File.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.File, err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// pullImagesCmd represents the pullImages command
+var pullImagesCmd = &cobra.Command{
+	Use:   "pullImages",
+	Short: "Pull images",
+	Long:  `Pull all module and sidecar images of the profile ahead of deployment and report the local image cache.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.PullImages)
+		if err != nil {
+			return err
+		}
+
+		reports, pullErr := run.PullImages()
+		if reports != nil {
+			if err := run.PrintImageReport(os.Stdout, reports, params.Output); err != nil {
+				return err
+			}
+		}
+
+		return pullErr
+	},
+}
+
+// PullImages pulls the module, management module and sidecar images of the profile,
+// the image report is returned together with the aggregated pull errors
+func (run *Run) PullImages() ([]models.ImageReport, error) {
+	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULES")
+	backendModules, err := run.ReadAllBackendModules()
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULE REGISTRIES")
+	modules, lock, err := run.GetRegistryModules(true)
+	if err != nil {
+		return nil, err
+	}
+
+	sidecarImage, _, err := run.getSidecarImage(modules, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	slog.Info(run.Config.Action.Name, "text", "PULLING IMAGES")
+	images := run.Config.ModuleSvc.GetModuleImages(&models.Containers{
+		Modules:        modules,
+		BackendModules: backendModules,
+	}, sidecarImage)
+	var imageNames []string
+	for idx, image := range images {
+		if !image.LocalBuild {
+			images[idx].Digest = lock.GetDigest(image.Image)
+			imageNames = append(imageNames, models.PinImage(image.Image, images[idx].Digest))
+		}
+	}
+	pulledImages, pullErr := run.Config.ModuleSvc.PullModules(client, imageNames)
+
+	slog.Info(run.Config.Action.Name, "text", "INSPECTING LOCAL IMAGES")
+	reports, err := run.Config.ModuleSvc.GetImageReport(client, images, pulledImages)
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, pullErr
+}
+
+func (run *Run) PrintImageReport(writer io.Writer, reports []models.ImageReport, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(reports)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "MODULE\tIMAGE\tPRESENT\tPULLED\tSIZE\tSTALE\tOTHER CACHED TAGS")
+	for _, report := range reports {
+		size := "-"
+		if report.Present {
+			size = fmt.Sprintf("%d MiB", helpers.ConvertMemory(helpers.BytesToMib, report.Size))
+		}
+		stale := "no"
+		if report.Stale {
+			stale = fmt.Sprintf("yes (LSP/FAR %s)", report.RegistryVersion)
+		}
+		cachedTags := "-"
+		if len(report.CachedTags) > 0 {
+			cachedTags = strings.Join(report.CachedTags, ", ")
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%t\t%t\t%s\t%s\t%s\n", report.Module, report.Image, report.Present, report.Pulled, size, stale, cachedTags)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(pullImagesCmd)
+	pullImagesCmd.PersistentFlags().IntVarP(&params.Parallelism, action.Parallelism.Long, action.Parallelism.Short, constant.DefaultParallelism, action.Parallelism.Description)
+	pullImagesCmd.PersistentFlags().BoolVarP(&params.Locked, action.Locked.Long, action.Locked.Short, false, action.Locked.Description)
+	pullImagesCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := pullImagesCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/spf13/cobra"
+)
+
+// purgeTopicsCmd represents the purgeTopics command
+var purgeTopicsCmd = &cobra.Command{
+	Use:   "purgeTopics",
+	Short: "Purge Kafka topics",
+	Long: `Delete all messages of the Kafka topics of a tenant, the topics and the consumer group offsets are kept.
+Topics shared by all tenants are only purged with --force.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.PurgeTopics)
+		if err != nil {
+			return err
+		}
+
+		return run.PurgeTopics()
+	},
+}
+
+func (run *Run) PurgeTopics() error {
+	slog.Info(run.Config.Action.Name, "text", "PURGING TOPICS", "tenant", params.Tenant)
+	return run.Config.KafkaSvc.PurgeTopics(params.Tenant)
+}
+
+func init() {
+	rootCmd.AddCommand(purgeTopicsCmd)
+	purgeTopicsCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	purgeTopicsCmd.PersistentFlags().BoolVarP(&params.Force, action.Force.Long, action.Force.Short, false, action.Force.Description)
+
+	if err := purgeTopicsCmd.MarkPersistentFlagRequired(action.Tenant.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Tenant, err).Error())
+		os.Exit(1)
+	}
+}
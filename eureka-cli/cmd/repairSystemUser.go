@@ -0,0 +1,107 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// repairSystemUserCmd represents the repairSystemUser command
+var repairSystemUserCmd = &cobra.Command{
+	Use:   "repairSystemUser",
+	Short: "Repair system user credentials",
+	Long:  `Generate new passwords for Keycloak users of a tenant, reset them in the tenant realm and store them in the tenant Vault secret.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.RepairSystemUser)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		return run.RepairSystemUser(os.Stdout)
+	},
+}
+
+func (run *Run) RepairSystemUser(writer io.Writer) error {
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.Password); err != nil {
+		return err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "REPAIRING SYSTEM USERS", "tenant", params.Tenant, "usernames", params.Usernames)
+	resets := make([]models.KeycloakPasswordReset, 0, len(params.Usernames))
+	secrets := make(map[string]any, len(params.Usernames))
+	for _, username := range params.Usernames {
+		reset := models.KeycloakPasswordReset{Username: username, Password: rand.Text()}
+		if err := run.Config.KeycloakSvc.ResetUserPassword(params.Tenant, username, reset.Password); err != nil {
+			slog.Warn(run.Config.Action.Name, "text", "Keycloak password reset failed, the password is stored in Vault only", "username", username, "error", err)
+			reset.Error = err.Error()
+		}
+		secrets[username] = reset.Password
+		resets = append(resets, reset)
+	}
+	if err := run.Config.VaultSvc.SetSecretKeys(fmt.Sprintf("folio/%s", params.Tenant), secrets); err != nil {
+		return err
+	}
+
+	return run.PrintPasswordResets(writer, resets, params.Reveal)
+}
+
+func (run *Run) PrintPasswordResets(writer io.Writer, resets []models.KeycloakPasswordReset, reveal bool) error {
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "USERNAME\tPASSWORD\tKEYCLOAK")
+	for _, reset := range resets {
+		password, status := reset.Password, "Password reset"
+		if !reveal {
+			password = helpers.MaskSecret(password)
+		}
+		if reset.Error != "" {
+			status = reset.Error
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", reset.Username, password, status)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(repairSystemUserCmd)
+	repairSystemUserCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	repairSystemUserCmd.PersistentFlags().StringSliceVarP(&params.Usernames, action.Username.Long, action.Username.Short, []string{}, action.Username.Description)
+	repairSystemUserCmd.PersistentFlags().BoolVarP(&params.Reveal, action.Reveal.Long, action.Reveal.Short, false, action.Reveal.Description)
+
+	if err := repairSystemUserCmd.MarkPersistentFlagRequired(action.Tenant.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Tenant, err).Error())
+		os.Exit(1)
+	}
+	if err := repairSystemUserCmd.MarkPersistentFlagRequired(action.Username.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Username, err).Error())
+		os.Exit(1)
+	}
+}
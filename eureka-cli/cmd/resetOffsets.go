@@ -0,0 +1,82 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// resetOffsetsCmd represents the resetOffsets command
+var resetOffsetsCmd = &cobra.Command{
+	Use:   "resetOffsets",
+	Short: "Reset Kafka consumer group offsets",
+	Long:  `Reset the committed offsets of a Kafka consumer group without active members to the earliest or the latest offsets.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if params.ToEarliest == params.ToLatest {
+			return errors.OneFlagRequired(action.ToEarliest, action.ToLatest)
+		}
+
+		run, err := New(action.ResetOffsets)
+		if err != nil {
+			return err
+		}
+
+		return run.ResetOffsets(os.Stdout)
+	},
+}
+
+func (run *Run) ResetOffsets(writer io.Writer) error {
+	timestamp := int64(constant.KafkaOffsetLatest)
+	if params.ToEarliest {
+		timestamp = constant.KafkaOffsetEarliest
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "RESETTING CONSUMER GROUP OFFSETS", "consumerGroup", params.Group, "tenant", params.Tenant)
+	resets, err := run.Config.KafkaSvc.ResetOffsets(params.Group, params.Tenant, timestamp)
+	if err != nil {
+		return err
+	}
+
+	return run.PrintOffsetResets(writer, resets)
+}
+
+func (run *Run) PrintOffsetResets(writer io.Writer, resets []models.KafkaOffsetReset) error {
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TOPIC\tPARTITION\tPREVIOUS\tNEW")
+	for _, reset := range resets {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%d\t%d\n", reset.Topic, reset.Partition, reset.PreviousOffset, reset.NewOffset)
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(resetOffsetsCmd)
+	resetOffsetsCmd.PersistentFlags().StringVarP(&params.Group, action.Group.Long, action.Group.Short, "", action.Group.Description)
+	resetOffsetsCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	resetOffsetsCmd.PersistentFlags().BoolVarP(&params.ToEarliest, action.ToEarliest.Long, action.ToEarliest.Short, false, action.ToEarliest.Description)
+	resetOffsetsCmd.PersistentFlags().BoolVarP(&params.ToLatest, action.ToLatest.Long, action.ToLatest.Short, false, action.ToLatest.Description)
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"embed"
 	"fmt"
@@ -23,11 +24,14 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -68,20 +72,41 @@ func initConfig() {
 	err := viper.ReadInConfig()
 	cobra.CheckErr(err)
 
+	err = resolveConfigProfile()
+	cobra.CheckErr(err)
+
 	logger, err = setDefaultLogger()
 	cobra.CheckErr(err)
 }
 
+// resolveConfigProfile replaces the read config with the deep-merged result
+// of the profile chain when the config file extends another profile
+func resolveConfigProfile() error {
+	if !viper.IsSet(field.ProfileExtends) {
+		return nil
+	}
+
+	resolved, extended, err := configsvc.ResolveConfigFile(viper.ConfigFileUsed())
+	if err != nil || !extended {
+		return err
+	}
+	viper.SetConfigType(constant.ConfigType)
+
+	return viper.ReadConfig(bytes.NewReader(resolved))
+}
+
 func setConfig(params *action.Param) {
 	if params.ConfigFile == "" {
-		home, err := os.UserHomeDir()
-		cobra.CheckErr(err)
-
-		viper.AddConfigPath(filepath.Join(home, constant.ConfigDir))
+		for _, profileDir := range configsvc.GetProfileDirs() {
+			viper.AddConfigPath(profileDir)
+		}
 		viper.SetConfigType(constant.ConfigType)
 		if params.Profile == "" {
 			params.Profile = constant.GetDefaultProfile()
 		}
+		if profiles := configsvc.GetProfiles(); !slices.Contains(profiles, params.Profile) {
+			cobra.CheckErr(errors.ProfileNotFound(params.Profile, profiles))
+		}
 		if slog.Default().Enabled(context.Background(), slog.LevelDebug) {
 			fmt.Println("Using profile:", params.Profile)
 		}
@@ -148,12 +173,15 @@ func createHomeDir(overwriteFiles bool) {
 }
 
 func init() {
-	profiles := constant.GetProfiles()
+	profiles := configsvc.GetProfiles()
 	cobra.OnInitialize(initConfig)
 	rootCmd.PersistentFlags().StringVarP(&params.Profile, action.Profile.Long, action.Profile.Short, "combined", fmt.Sprintf(action.Profile.Description, profiles))
 	rootCmd.PersistentFlags().StringVarP(&params.ConfigFile, action.ConfigFile.Long, action.ConfigFile.Short, "", action.ConfigFile.Description)
 	rootCmd.PersistentFlags().BoolVarP(&params.OverwriteFiles, action.OverwriteFiles.Long, action.OverwriteFiles.Short, false, fmt.Sprintf(action.OverwriteFiles.Description, constant.ConfigDir))
 	rootCmd.PersistentFlags().BoolVarP(&params.EnableDebug, action.EnableDebug.Long, action.EnableDebug.Short, false, action.EnableDebug.Description)
+	rootCmd.PersistentFlags().BoolVarP(&params.DryRun, action.DryRun.Long, action.DryRun.Short, false, action.DryRun.Description)
+	rootCmd.PersistentFlags().BoolVarP(&params.Offline, action.Offline.Long, action.Offline.Short, false, action.Offline.Description)
+	rootCmd.PersistentFlags().BoolVarP(&params.SkipValidation, action.SkipValidation.Long, action.SkipValidation.Short, false, action.SkipValidation.Description)
 
 	if err := rootCmd.RegisterFlagCompletionFunc(action.Profile.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return profiles, cobra.ShellCompDirectiveNoFileComp
@@ -16,14 +16,21 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
+	"github.com/spf13/viper"
 )
 
 // Run is a container that holds the RunConfig instance
@@ -32,18 +39,100 @@ type Run struct {
 }
 
 func New(name string) (*Run, error) {
+	validateConfig := !slices.Contains([]string{action.ValidateConfig, action.ShowConfig}, name) && !params.SkipValidation
+	useSnapshot := name != action.SnapshotRegistry
 	gatewayURLTemplate, err := action.GetGatewayURLTemplate(name)
 	if err != nil {
 		return nil, err
 	}
 	action := action.New(name, gatewayURLTemplate, &params)
+	if useSnapshot && action.IsOffline() {
+		action.RegistrySnapshotDir, err = helpers.FindRegistrySnapshotDir(action.ConfigRegistrySnapshot)
+		if err != nil {
+			return nil, err
+		}
+		slog.Info(action.Name, "text", "Using registry snapshot", "dir", action.RegistrySnapshotDir)
+	}
 
 	runConfig, err := runconfig.New(action, logger)
 	if err != nil {
 		return nil, err
 	}
+	run := &Run{Config: runConfig}
+	if validateConfig {
+		if err := run.PreflightConfig(viper.ConfigFileUsed()); err != nil {
+			return nil, err
+		}
+	}
+
+	return run, nil
+}
+
+// PreflightConfig validates the config file before a command uses it,
+// logging every found issue and failing only when errors are present
+func (run *Run) PreflightConfig(filePath string) error {
+	if filePath == "" {
+		return nil
+	}
+
+	report, err := run.Config.ConfigSvc.ValidateConfig(filePath)
+	if err != nil {
+		return err
+	}
+	for _, issue := range report.Issues {
+		if issue.Severity == constant.ErrorSeverity {
+			slog.Error(run.Config.Action.Name, "text", "Config validation error", "file", issue.GetFilePath(filePath), "issue", issue.String())
+		} else {
+			slog.Debug(run.Config.Action.Name, "text", "Config validation warning", "file", issue.GetFilePath(filePath), "issue", issue.String())
+		}
+	}
+	if errorCount := report.CountIssues(constant.ErrorSeverity); errorCount > 0 {
+		return errors.ConfigInvalid(filePath, errorCount)
+	}
 
-	return &Run{Config: runConfig}, nil
+	return nil
+}
+
+// GetRegistryModules resolves the module versions from the LSP/FAR registries, or from the profile lock file
+// when the --locked flag is used in which case the lock is returned alongside the modules
+func (run *Run) GetRegistryModules(verbose bool) (*models.ProxyModulesByRegistry, *models.Lock, error) {
+	if !run.Config.Action.IsLocked() {
+		modules, err := run.Config.RegistrySvc.GetModules(verbose)
+		if err != nil {
+			return nil, nil, err
+		}
+		run.Config.RegistrySvc.ExtractModuleMetadata(modules)
+
+		return modules, nil, nil
+	}
+
+	lock, err := run.Config.LockSvc.ReadLock()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := run.Config.LockSvc.ValidateLock(lock); err != nil {
+		return nil, nil, err
+	}
+	slog.Info(run.Config.Action.Name, "text", "Using lock file", "path", run.Config.LockSvc.GetLockFilePath(), "modules", len(lock.Modules), "createdAt", lock.CreatedAt)
+	modules := run.Config.RegistrySvc.GetLockedModules(lock)
+	run.Config.RegistrySvc.ExtractModuleMetadata(modules)
+
+	return modules, lock, nil
+}
+
+// ReadAllBackendModules reads the backend modules together with the management modules of the profile
+func (run *Run) ReadAllBackendModules() (map[string]models.BackendModule, error) {
+	backendModules, err := run.Config.ModuleProps.ReadBackendModules(false, false)
+	if err != nil {
+		return nil, err
+	}
+	managementModules, err := run.Config.ModuleProps.ReadBackendModules(true, false)
+	if err != nil {
+		return nil, err
+	}
+	maps.Copy(backendModules, managementModules)
+
+	return backendModules, nil
 }
 
 func (run *Run) PingKongStatus() error {
@@ -51,12 +140,44 @@ func (run *Run) PingKongStatus() error {
 	return run.Config.HTTPClient.PingRetry(requestURL)
 }
 
+func (run *Run) JournalStep(step string, partition string, fn func() error) error {
+	journalSvc := run.Config.JournalSvc
+	if journalSvc == nil || !journalSvc.IsEnabled() {
+		return fn()
+	}
+	if journalSvc.IsSucceeded(step, partition) {
+		slog.Info(run.Config.Action.Name, "text", "Skipping already succeeded step", "step", step, "partition", partition)
+		return nil
+	}
+
+	// The step is restored on return so that a later tenant partition outside of a journal step never inherits it
+	previousStep := journalSvc.GetCurrentStep()
+	journalSvc.SetCurrentStep(step)
+	defer journalSvc.SetCurrentStep(previousStep)
+	if err := journalSvc.Record(step, partition, constant.Started, nil); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		if recordErr := journalSvc.Record(step, partition, constant.Failed, err); recordErr != nil {
+			slog.Warn(run.Config.Action.Name, "text", "Failed to record failed step", "step", step, "error", recordErr)
+		}
+		return err
+	}
+
+	return journalSvc.Record(step, partition, constant.Succeeded, nil)
+}
+
 func (run *Run) ConsortiumPartition(fn func(string, constant.TenantType) error) error {
 	if !action.IsSet(field.Consortiums) {
 		return fn(constant.NoneConsortium, constant.Default)
 	}
-	for consortiumName := range run.Config.Action.ConfigConsortiums {
+
+	total := len(run.Config.Action.ConfigConsortiums) * len(constant.GetTenantTypes())
+	current := 0
+	for _, consortiumName := range helpers.SortedMapKeys(run.Config.Action.ConfigConsortiums) {
 		for _, tenantType := range constant.GetTenantTypes() {
+			current++
+			slog.Info(run.Config.Action.Name, "text", "Processing consortium partition", "consortium", consortiumName, "tenantType", tenantType, "partition", fmt.Sprintf("%d/%d", current, total))
 			if err := fn(consortiumName, tenantType); err != nil {
 				return err
 			}
@@ -83,17 +204,34 @@ func (run *Run) TenantPartition(consortiumName string, tenantType constant.Tenan
 		return err
 	}
 
-	for _, value := range tenants {
+	step := run.getJournalCurrentStep()
+	for idx, value := range tenants {
 		entry := value.(map[string]any)
 		configTenant := helpers.GetString(entry, "name")
 		if !helpers.HasTenant(configTenant, run.Config.Action.ConfigTenants) {
 			continue
 		}
+
+		partition := getPartitionKey(consortiumName, string(tenantType), configTenant)
+		if step != "" && run.Config.JournalSvc.IsSucceeded(step, partition) {
+			slog.Info(run.Config.Action.Name, "text", "Skipping already succeeded tenant", "step", step, "tenant", configTenant)
+			continue
+		}
+		slog.Info(run.Config.Action.Name, "text", "Processing tenant partition", "tenant", configTenant, "partition", fmt.Sprintf("%d/%d", idx+1, len(tenants)))
+
 		if err := run.setKeycloakAccessTokenIntoContext(configTenant); err != nil {
 			return err
 		}
 		configDescription := helpers.GetString(entry, "description")
-		if err = fn(configTenant, configDescription); err != nil {
+		if step == "" {
+			if err = fn(configTenant, configDescription); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = run.JournalStep(step, partition, func() error {
+			return fn(configTenant, configDescription)
+		}); err != nil {
 			return err
 		}
 	}
@@ -101,6 +239,18 @@ func (run *Run) TenantPartition(consortiumName string, tenantType constant.Tenan
 	return nil
 }
 
+func (run *Run) getJournalCurrentStep() string {
+	if run.Config.JournalSvc == nil || !run.Config.JournalSvc.IsEnabled() {
+		return ""
+	}
+
+	return run.Config.JournalSvc.GetCurrentStep()
+}
+
+func getPartitionKey(parts ...string) string {
+	return strings.Join(parts, "/")
+}
+
 func (run *Run) CheckDeployedModuleReadiness(moduleType string, modules map[string]int) error {
 	var (
 		wg    sync.WaitGroup
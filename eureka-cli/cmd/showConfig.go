@@ -0,0 +1,67 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// showConfigCmd represents the showConfig command
+var showConfigCmd = &cobra.Command{
+	Use:   "showConfig",
+	Short: "Show config",
+	Long:  `Show the config file of the current profile, optionally with the extended profiles merged.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.ShowConfig)
+		if err != nil {
+			return err
+		}
+
+		return run.ShowConfig(os.Stdout, viper.ConfigFileUsed())
+	},
+}
+
+func (run *Run) ShowConfig(writer io.Writer, filePath string) error {
+	slog.Debug(run.Config.Action.Name, "text", "Showing config", "file", filePath, "resolved", run.Config.Action.Param.Resolved)
+	if !run.Config.Action.Param.Resolved {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(content)
+
+		return err
+	}
+
+	resolved, err := run.Config.ConfigSvc.ResolveConfig(filePath)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(resolved)
+
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(showConfigCmd)
+	showConfigCmd.PersistentFlags().BoolVarP(&params.Resolved, action.Resolved.Long, action.Resolved.Short, false, action.Resolved.Description)
+}
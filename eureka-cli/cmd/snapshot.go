@@ -0,0 +1,32 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Manage environment snapshots",
+	Long: `Save the state of the whole environment into a named snapshot under ~/.eureka/snapshots and restore it later,
+a snapshot holds a dump of all PostgreSQL databases including the Keycloak realms, the Vault secrets,
+the committed Kafka consumer group offsets and the lock file of the deployed modules.`,
+}
+
+func init() {
+	rootCmd.AddCommand(snapshotCmd)
+}
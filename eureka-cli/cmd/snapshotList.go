@@ -0,0 +1,86 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List environment snapshots",
+	Long:  `List the environment snapshots with their profile, size and creation date.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.SnapshotList)
+		if err != nil {
+			return err
+		}
+
+		snapshots, err := run.Config.SnapshotSvc.ListSnapshots()
+		if err != nil {
+			return err
+		}
+
+		return run.PrintEnvironmentSnapshots(os.Stdout, snapshots, params.Output)
+	},
+}
+
+func (run *Run) PrintEnvironmentSnapshots(writer io.Writer, snapshots []models.EnvironmentSnapshotSummary, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(snapshots)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tPROFILE\tSIZE\tCREATED")
+	for _, snapshot := range snapshots {
+		size := fmt.Sprintf("%d MiB", helpers.ConvertMemory(helpers.BytesToMib, snapshot.Size))
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", snapshot.Name, snapshot.Profile, size, snapshot.CreatedAt.Local().Format(time.DateTime))
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotListCmd)
+	snapshotListCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := snapshotListCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// snapshotRegistryCmd represents the snapshotRegistry command
+var snapshotRegistryCmd = &cobra.Command{
+	Use:   "snapshotRegistry [name]",
+	Short: "Snapshot registry",
+	Long: `Save the LSP platform descriptor, FAR application descriptors and module descriptors of the configured platform
+into a named registry snapshot, used by the --offline flag or the registry.snapshot config key, an existing snapshot is only replaced with --overwrite.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.SnapshotRegistry)
+		if err != nil {
+			return err
+		}
+
+		var snapshotName string
+		if len(args) > 0 {
+			snapshotName = args[0]
+		}
+		snapshot, err := run.Config.RegistrySvc.SnapshotRegistry(snapshotName)
+		if err != nil {
+			return err
+		}
+
+		return run.PrintRegistrySnapshot(os.Stdout, snapshot)
+	},
+}
+
+func (run *Run) PrintRegistrySnapshot(writer io.Writer, snapshot *models.RegistrySnapshot) error {
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "Name\t%s\n", snapshot.Name)
+	_, _ = fmt.Fprintf(tw, "Directory\t%s\n", run.Config.Action.RegistrySnapshotDir)
+	_, _ = fmt.Fprintf(tw, "Platform\t%s %s\n", snapshot.Platform, snapshot.PlatformVersion)
+	_, _ = fmt.Fprintf(tw, "Applications\t%d\n", len(snapshot.Applications))
+	_, _ = fmt.Fprintf(tw, "Modules\t%d\n", snapshot.Modules)
+	if len(snapshot.MissingModules) > 0 {
+		_, _ = fmt.Fprintf(tw, "Missing modules\t%s\n", strings.Join(snapshot.MissingModules, ", "))
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(snapshotRegistryCmd)
+	snapshotRegistryCmd.PersistentFlags().BoolVarP(&params.Overwrite, action.Overwrite.Long, action.Overwrite.Short, false, action.Overwrite.Description)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore <name>",
+	Short: "Restore an environment snapshot",
+	Long: `Undeploy all modules, restore the PostgreSQL databases, the Vault secrets and the Kafka consumer group offsets of a snapshot
+and redeploy the management and backend modules that were running when the snapshot was saved with their pinned images,
+the lock file of the snapshot replaces the lock file of the profile.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.SnapshotRestore)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		snapshot, err := run.SnapshotRestore(args[0])
+		if err != nil {
+			return err
+		}
+
+		return run.PrintEnvironmentSnapshot(os.Stdout, snapshot)
+	},
+}
+
+// SnapshotRestore brings the environment back to the state of a snapshot saved for the current profile
+func (run *Run) SnapshotRestore(name string) (*models.EnvironmentSnapshot, error) {
+	snapshot, err := run.Config.SnapshotSvc.ReadManifest(name)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Profile != run.Config.Action.ConfigProfileName {
+		return nil, errors.EnvironmentSnapshotProfileMismatch(name, snapshot.Profile, run.Config.Action.ConfigProfileName)
+	}
+	snapshotDir, err := run.Config.SnapshotSvc.GetSnapshotDir(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := run.UndeployModules(false); err != nil {
+		return nil, err
+	}
+	if err := run.UndeployManagement(); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "RESTORING DATABASES")
+	if err := run.restoreDatabases(snapshotDir); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "RESTORING VAULT SECRETS")
+	if _, err := run.Config.SnapshotSvc.RestoreSecrets(snapshotDir); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "RESTORING CONSUMER GROUP OFFSETS")
+	restoredGroups := run.Config.SnapshotSvc.RestoreConsumerGroupOffsets(snapshot.ConsumerGroups)
+	slog.Info(run.Config.Action.Name, "text", "Restored consumer group offsets", "consumerGroups", restoredGroups, "total", len(snapshot.ConsumerGroups))
+
+	// Snapshots saved by older versions may lack the lock file of the running modules
+	var moduleNames []string
+	if snapshot.Lock == nil {
+		slog.Warn(run.Config.Action.Name, "text", "Snapshot has no lock file, redeploying the modules of the config")
+	} else {
+		slog.Info(run.Config.Action.Name, "text", "RESTORING LOCK FILE")
+		if run.Config.Action.IsDryRun() {
+			slog.Info(run.Config.Action.Name, "text", "Skipping writing of lock file in dry run mode", "path", run.Config.LockSvc.GetLockFilePath())
+		} else if err := run.Config.LockSvc.WriteLock(snapshot.Lock); err != nil {
+			return nil, err
+		}
+		run.Config.Action.Param.Locked = true
+		moduleNames = run.getSnapshotModuleNames(snapshot.Lock)
+	}
+
+	if err := run.DeployManagement(); err != nil {
+		return nil, err
+	}
+	if moduleNames != nil && len(moduleNames) == 0 {
+		slog.Info(run.Config.Action.Name, "text", "Snapshot has no running backend modules to redeploy")
+		return snapshot, nil
+	}
+	if err := run.deployModules(moduleNames, false); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// getSnapshotModuleNames returns the backend modules that were running when the snapshot was saved, a module
+// that is missing from the config is added with its default properties so that the same module set is redeployed
+func (run *Run) getSnapshotModuleNames(lock *models.Lock) []string {
+	moduleNames := []string{}
+	for _, module := range lock.Modules {
+		if module.Image == "" || strings.HasPrefix(module.Name, constant.ManagementModulePattern) {
+			continue
+		}
+		moduleNames = append(moduleNames, module.Name)
+		if _, ok := run.Config.Action.ConfigBackendModules[module.Name]; ok {
+			continue
+		}
+		slog.Warn(run.Config.Action.Name, "text", "Snapshot module is missing from the config, deploying it with the default properties", "module", module.Name)
+		if run.Config.Action.ConfigBackendModules == nil {
+			run.Config.Action.ConfigBackendModules = make(map[string]any)
+		}
+		run.Config.Action.ConfigBackendModules[module.Name] = nil
+	}
+
+	return moduleNames
+}
+
+// restoreDatabases restores the databases and waits until Kong and Keycloak serve the restored state
+func (run *Run) restoreDatabases(snapshotDir string) error {
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	if err := run.Config.SnapshotSvc.RestoreDatabases(client, snapshotDir); err != nil {
+		return err
+	}
+
+	return run.Config.SystemSvc.WaitForSystemReadiness(client, []string{constant.KongContainer, constant.KeycloakContainer, constant.KeycloakProxyContainer})
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+var snapshotSaveCmd = &cobra.Command{
+	Use:   "save <name>",
+	Short: "Save an environment snapshot",
+	Long: `Save the PostgreSQL databases, the Vault secrets, the Kafka consumer group offsets and a lock file pinning the images
+and digests of the running module, management module and sidecar containers of the profile into a new snapshot.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.SnapshotSave)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		snapshot, err := run.SnapshotSave(args[0])
+		if err != nil {
+			return err
+		}
+
+		return run.PrintEnvironmentSnapshot(os.Stdout, snapshot)
+	},
+}
+
+// SnapshotSave saves the environment into a new snapshot, the manifest is written last so that
+// a snapshot that failed halfway is neither listed nor restored
+func (run *Run) SnapshotSave(name string) (*models.EnvironmentSnapshot, error) {
+	slog.Info(run.Config.Action.Name, "text", "READING LOCK FILE")
+	lock, err := run.Config.LockSvc.ReadLock()
+	if errors.Is(err, appErrors.ErrNotFound) {
+		slog.Debug(run.Config.Action.Name, "text", "Capturing the running modules without a lock file", "error", err)
+	} else if err != nil {
+		return nil, err
+	}
+	snapshotDir, err := run.Config.SnapshotSvc.CreateSnapshotDir(name)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &models.EnvironmentSnapshot{
+		Name:          name,
+		Profile:       run.Config.Action.ConfigProfileName,
+		ApplicationID: run.Config.Action.ConfigApplicationID,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	slog.Info(run.Config.Action.Name, "text", "CAPTURING RUNNING MODULES")
+	if snapshot.Lock, err = run.Config.LockSvc.CaptureLock(client, lock); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "DUMPING DATABASES")
+	if snapshot.Databases, err = run.Config.SnapshotSvc.DumpDatabases(client, snapshotDir); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "SAVING VAULT SECRETS")
+	if snapshot.Secrets, err = run.Config.SnapshotSvc.SaveSecrets(snapshotDir); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "SAVING CONSUMER GROUP OFFSETS")
+	if snapshot.ConsumerGroups, err = run.Config.SnapshotSvc.SaveConsumerGroupOffsets(); err != nil {
+		return nil, err
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "WRITING SNAPSHOT MANIFEST")
+	if err := run.Config.SnapshotSvc.WriteManifest(snapshotDir, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func (run *Run) PrintEnvironmentSnapshot(writer io.Writer, snapshot *models.EnvironmentSnapshot) error {
+	snapshotDir, err := run.Config.SnapshotSvc.GetSnapshotDir(snapshot.Name)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "Name\t%s\n", snapshot.Name)
+	_, _ = fmt.Fprintf(tw, "Directory\t%s\n", snapshotDir)
+	_, _ = fmt.Fprintf(tw, "Profile\t%s\n", snapshot.Profile)
+	_, _ = fmt.Fprintf(tw, "Created\t%s\n", snapshot.CreatedAt.Local().Format(time.DateTime))
+	_, _ = fmt.Fprintf(tw, "Databases\t%d\n", len(snapshot.Databases))
+	_, _ = fmt.Fprintf(tw, "Secrets\t%d\n", snapshot.Secrets)
+	_, _ = fmt.Fprintf(tw, "Consumer groups\t%d\n", len(snapshot.ConsumerGroups))
+	if snapshot.Lock != nil {
+		_, _ = fmt.Fprintf(tw, "Modules\t%d\n", len(snapshot.Lock.Modules))
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	snapshotCmd.AddCommand(snapshotSaveCmd)
+}
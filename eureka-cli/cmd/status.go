@@ -0,0 +1,358 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/kongsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show environment status",
+	Long:  `Show the health of system containers, modules, sidecars, Kong routes, applications, tenants and entitlements.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.Status)
+		if err != nil {
+			return err
+		}
+
+		report, err := run.Status()
+		if err != nil {
+			return err
+		}
+		if err := run.PrintStatus(os.Stdout, report, params.Output); err != nil {
+			return err
+		}
+		if !report.Healthy {
+			return errors.EnvironmentUnhealthy(countUnhealthy(report))
+		}
+
+		return nil
+	},
+}
+
+func (run *Run) Status() (*models.StatusReport, error) {
+	slog.Info(run.Config.Action.Name, "text", "COLLECTING ENVIRONMENT STATUS")
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	report := &models.StatusReport{
+		Profile:     run.Config.Action.ConfigProfileName,
+		Application: run.Config.Action.ConfigApplicationID,
+	}
+	if err := run.collectSystemStatus(client, report); err != nil {
+		return nil, err
+	}
+	if err := run.collectModuleStatus(client, report); err != nil {
+		return nil, err
+	}
+	run.collectRouteStatus(report)
+	run.collectManagementStatus(client, report)
+
+	report.Healthy = countUnhealthy(report) == 0
+
+	return report, nil
+}
+
+func (run *Run) collectSystemStatus(client *client.Client, report *models.StatusReport) error {
+	filters := filters.NewArgs(filters.KeyValuePair{
+		Key:   "label",
+		Value: fmt.Sprintf("%s=%s", constant.DockerComposeLabelKey, constant.DockerComposeProject),
+	})
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(client, filters)
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range containers {
+		name := summary.Labels[constant.DockerComposeServiceKey]
+		if name == "" {
+			name = getContainerName(summary)
+		}
+		state, healthy := getContainerHealth(summary)
+		report.Components = append(report.Components, models.StatusEntry{
+			Category: constant.SystemCategory,
+			Name:     name,
+			State:    state,
+			Detail:   summary.Status,
+			Healthy:  healthy,
+		})
+	}
+
+	return nil
+}
+
+func (run *Run) collectModuleStatus(client *client.Client, report *models.StatusReport) error {
+	filters := filters.NewArgs(filters.KeyValuePair{
+		Key:   "name",
+		Value: fmt.Sprintf(constant.ProfileContainerPattern, run.Config.Action.ConfigProfileName),
+	})
+	containers, err := run.Config.ModuleSvc.GetDeployedModules(client, filters)
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range containers {
+		name := getContainerName(summary)
+		category := constant.ModuleCategory
+		if strings.HasSuffix(name, "-sc") {
+			category = constant.SidecarCategory
+		}
+
+		state, healthy := getContainerHealth(summary)
+		entry := models.StatusEntry{Category: category, Name: name, State: state, Detail: summary.Status, Healthy: healthy}
+		if healthy {
+			entry.State, entry.Detail, entry.Healthy = run.checkAdminHealth(summary)
+		}
+		report.Components = append(report.Components, entry)
+	}
+
+	return nil
+}
+
+func (run *Run) checkAdminHealth(summary container.Summary) (state string, detail string, healthy bool) {
+	port := getServerPublicPort(summary.Ports)
+	if port == 0 {
+		return "unknown", "no published server port", false
+	}
+
+	requestURL := run.Config.Action.GetRequestURL(strconv.Itoa(port), "/admin/health")
+	statusCode, err := run.Config.HTTPClient.Ping(requestURL)
+	if err != nil {
+		return "unhealthy", err.Error(), false
+	}
+	if statusCode != http.StatusOK {
+		return "unhealthy", fmt.Sprintf("/admin/health returned %d", statusCode), false
+	}
+
+	return "healthy", fmt.Sprintf("/admin/health on port %d", port), true
+}
+
+func (run *Run) collectRouteStatus(report *models.StatusReport) {
+	expressions := kongsvc.GetRouteReadinessExpressions()
+	routes, err := run.Config.KongSvc.FindRouteByExpressions(expressions)
+	if err != nil {
+		report.Components = append(report.Components, models.StatusEntry{
+			Category: constant.RouteCategory,
+			Name:     "kong",
+			State:    "unreachable",
+			Detail:   err.Error(),
+		})
+		return
+	}
+
+	for _, expression := range expressions {
+		entry := models.StatusEntry{Category: constant.RouteCategory, Name: expression, State: "missing"}
+		for _, route := range routes {
+			if route.Expression == expression {
+				entry.State, entry.Detail, entry.Healthy = "ready", route.Name, true
+				break
+			}
+		}
+		report.Components = append(report.Components, entry)
+	}
+}
+
+func (run *Run) collectManagementStatus(client *client.Client, report *models.StatusReport) {
+	if err := run.setVaultRootTokenIntoContext(client); err != nil {
+		report.Components = append(report.Components, newUnreachableEntry(constant.SystemCategory, constant.VaultContainer, err))
+		return
+	}
+	if err := run.setKeycloakMasterAccessTokenIntoContext(constant.ClientCredentials); err != nil {
+		report.Components = append(report.Components, newUnreachableEntry(constant.SystemCategory, constant.KeycloakContainer, err))
+		return
+	}
+
+	applications, err := run.Config.ManagementSvc.GetApplications()
+	if err != nil {
+		report.Components = append(report.Components, newUnreachableEntry(constant.ApplicationCategory, "mgr-applications", err))
+	} else {
+		run.collectApplicationStatus(applications, report)
+	}
+
+	tenants, err := run.Config.ManagementSvc.GetTenants("", constant.All)
+	if err != nil {
+		report.Components = append(report.Components, newUnreachableEntry(constant.TenantCategory, "mgr-tenants", err))
+		return
+	}
+	run.collectTenantStatus(tenants, report)
+}
+
+func (run *Run) collectApplicationStatus(applications models.ApplicationsResponse, report *models.StatusReport) {
+	found := false
+	for _, descriptor := range applications.ApplicationDescriptors {
+		applicationID := helpers.GetString(descriptor, "id")
+		if applicationID == run.Config.Action.ConfigApplicationID {
+			found = true
+		}
+		report.Components = append(report.Components, models.StatusEntry{
+			Category: constant.ApplicationCategory,
+			Name:     applicationID,
+			State:    "registered",
+			Detail:   fmt.Sprintf("%d module(s)", len(helpers.GetAnySlice(descriptor, "modules"))),
+			Healthy:  true,
+		})
+	}
+	if !found {
+		report.Components = append(report.Components, models.StatusEntry{
+			Category: constant.ApplicationCategory,
+			Name:     run.Config.Action.ConfigApplicationID,
+			State:    "missing",
+		})
+	}
+}
+
+func (run *Run) collectTenantStatus(tenants []any, report *models.StatusReport) {
+	existingTenants := make(map[string]bool, len(tenants))
+	for _, value := range tenants {
+		existingTenants[helpers.GetString(value.(map[string]any), "name")] = true
+	}
+
+	for _, tenantName := range helpers.SortedMapKeys(run.Config.Action.ConfigTenants) {
+		if !existingTenants[tenantName] {
+			report.Components = append(report.Components, models.StatusEntry{Category: constant.TenantCategory, Name: tenantName, State: "missing"})
+			continue
+		}
+		report.Components = append(report.Components, models.StatusEntry{Category: constant.TenantCategory, Name: tenantName, State: "created", Healthy: true})
+
+		entry := models.StatusEntry{Category: constant.EntitlementCategory, Name: tenantName, State: "missing", Detail: run.Config.Action.ConfigApplicationID}
+		entitlements, err := run.Config.ManagementSvc.GetTenantEntitlements(tenantName, false)
+		if err != nil {
+			entry.State, entry.Detail = "unreachable", err.Error()
+		}
+		for _, entitlement := range entitlements.Entitlements {
+			if entitlement.ApplicationID == run.Config.Action.ConfigApplicationID {
+				entry.State, entry.Healthy = "entitled", true
+				break
+			}
+		}
+		report.Components = append(report.Components, entry)
+	}
+}
+
+func (run *Run) PrintStatus(writer io.Writer, report *models.StatusReport, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "CATEGORY\tNAME\tSTATE\tHEALTHY\tDETAIL")
+	for _, entry := range report.Components {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%t\t%s\n", entry.Category, entry.Name, entry.State, entry.Healthy, entry.Detail)
+	}
+
+	return tw.Flush()
+}
+
+func newUnreachableEntry(category, name string, err error) models.StatusEntry {
+	return models.StatusEntry{Category: category, Name: name, State: "unreachable", Detail: err.Error()}
+}
+
+func countUnhealthy(report *models.StatusReport) int {
+	var unhealthy int
+	for _, entry := range report.Components {
+		if !entry.Healthy {
+			unhealthy++
+		}
+	}
+
+	return unhealthy
+}
+
+func getContainerName(summary container.Summary) string {
+	if len(summary.Names) == 0 {
+		return summary.ID
+	}
+
+	return strings.TrimPrefix(summary.Names[0], "/")
+}
+
+// getContainerHealth derives the health of a container from its state and the healthcheck part of its status,
+// e.g. "Up 5 minutes (healthy)"; one-shot containers that exited successfully are considered healthy
+func getContainerHealth(summary container.Summary) (string, bool) {
+	switch summary.State {
+	case container.StateRunning:
+		switch {
+		case strings.Contains(summary.Status, "(unhealthy)"):
+			return "unhealthy", false
+		case strings.Contains(summary.Status, "(health: starting)"):
+			return "starting", false
+		case strings.Contains(summary.Status, "(healthy)"):
+			return "healthy", true
+		default:
+			return string(summary.State), true
+		}
+	case container.StateExited:
+		if strings.HasPrefix(summary.Status, "Exited (0)") {
+			return "completed", true
+		}
+		return string(summary.State), false
+	default:
+		return string(summary.State), false
+	}
+}
+
+func getServerPublicPort(ports []container.Port) int {
+	for _, port := range ports {
+		if port.PublicPort != 0 && strconv.Itoa(int(port.PrivatePort)) != constant.PrivateDebugPort {
+			return int(port.PublicPort)
+		}
+	}
+
+	return 0
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	statusCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := statusCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
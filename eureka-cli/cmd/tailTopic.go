@@ -0,0 +1,120 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// tailTopicCmd represents the tailTopic command
+var tailTopicCmd = &cobra.Command{
+	Use:   "tailTopic",
+	Short: "Tail a Kafka topic",
+	Long:  `Print the messages of a Kafka topic with their keys and headers as they arrive, JSON payloads are pretty-printed.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var filter *helpers.JSONPathFilter
+		if params.Filter != "" {
+			var err error
+			if filter, err = helpers.ParseJSONPathFilter(params.Filter); err != nil {
+				return err
+			}
+		}
+
+		run, err := New(action.TailTopic)
+		if err != nil {
+			return err
+		}
+
+		return run.TailTopic(os.Stdout, filter)
+	},
+}
+
+func (run *Run) TailTopic(writer io.Writer, filter *helpers.JSONPathFilter) error {
+	topicName := run.Config.KafkaSvc.GetTopicName(params.Topic, params.Tenant)
+	slog.Info(run.Config.Action.Name, "text", "TAILING TOPIC", "topic", topicName, "fromBeginning", params.FromBeginning)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	printer := &messagePrinter{writer: writer, filter: filter}
+	return run.Config.KafkaSvc.TailTopic(ctx, topicName, params.FromBeginning, printer.print)
+}
+
+// messagePrinter prints a message header line followed by the key, the headers and the payload, which is indented
+// when it holds JSON, messages whose payload does not match the filter are skipped
+type messagePrinter struct {
+	writer io.Writer
+	filter *helpers.JSONPathFilter
+}
+
+func (p *messagePrinter) print(record models.KafkaRecord) {
+	if p.filter != nil {
+		var document any
+		if err := json.Unmarshal(record.Value, &document); err != nil || !p.filter.Match(document) {
+			return
+		}
+	}
+
+	_, _ = fmt.Fprintf(p.writer, "--- %s [%d] offset %d at %s\n", record.Topic, record.Partition, record.Offset, record.Timestamp.UTC().Format(time.RFC3339Nano))
+	if record.Key != nil {
+		_, _ = fmt.Fprintf(p.writer, "Key: %s\n", record.Key)
+	}
+	if len(record.Headers) > 0 {
+		headers := make([]string, 0, len(record.Headers))
+		for _, header := range record.Headers {
+			headers = append(headers, fmt.Sprintf("%s=%s", header.Key, header.Value))
+		}
+		_, _ = fmt.Fprintf(p.writer, "Headers: %s\n", strings.Join(headers, ", "))
+	}
+
+	var payload bytes.Buffer
+	switch {
+	case record.Value == nil:
+		payload.WriteString("<null>")
+	case json.Indent(&payload, record.Value, "", "  ") != nil:
+		payload.Reset()
+		payload.Write(record.Value)
+	}
+	_, _ = fmt.Fprintf(p.writer, "%s\n", payload.Bytes())
+}
+
+func init() {
+	rootCmd.AddCommand(tailTopicCmd)
+	tailTopicCmd.PersistentFlags().StringVarP(&params.Topic, action.Topic.Long, action.Topic.Short, "", action.Topic.Description)
+	tailTopicCmd.PersistentFlags().StringVarP(&params.Tenant, action.Tenant.Long, action.Tenant.Short, "", action.Tenant.Description)
+	tailTopicCmd.PersistentFlags().BoolVarP(&params.FromBeginning, action.FromBeginning.Long, action.FromBeginning.Short, false, action.FromBeginning.Description)
+	tailTopicCmd.PersistentFlags().StringVarP(&params.Filter, action.Filter.Long, action.Filter.Short, "", action.Filter.Description)
+
+	if err := tailTopicCmd.MarkPersistentFlagRequired(action.Topic.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Topic, err).Error())
+		os.Exit(1)
+	}
+}
@@ -41,7 +41,12 @@ var undeploySystemCmd = &cobra.Command{
 func (run *Run) UndeploySystem() error {
 	slog.Info(run.Config.Action.Name, "text", "UNDEPLOYING SYSTEM CONTAINERS")
 	preparedCommand := exec.Command("docker", "compose", "--progress", "plain", "--ansi", "never", "--project-name", "eureka", "down", "--volumes", "--remove-orphans")
-	return run.Config.ExecSvc.Exec(preparedCommand)
+	if err := run.Config.ExecSvc.Exec(preparedCommand); err != nil {
+		return err
+	}
+	run.clearTokenCache()
+
+	return nil
 }
 
 func init() {
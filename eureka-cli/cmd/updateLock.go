@@ -0,0 +1,174 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+)
+
+// updateLockCmd represents the updateLock command
+var updateLockCmd = &cobra.Command{
+	Use:   "updateLock",
+	Short: "Update lock file",
+	Long:  `Resolve the latest module versions, image digests and sidecar image of the profile into its lock file and print the version diff.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.UpdateLock)
+		if err != nil {
+			return err
+		}
+
+		diffs, err := run.UpdateLock()
+		if err != nil {
+			return err
+		}
+
+		return run.PrintLockDiff(os.Stdout, diffs, params.Output)
+	},
+}
+
+// UpdateLock resolves the modules of the profile from the LSP/FAR registries into a new lock file,
+// the lock file is left untouched in dry run mode
+func (run *Run) UpdateLock() ([]models.LockDiff, error) {
+	var oldLock *models.Lock
+	if _, err := os.Stat(run.Config.LockSvc.GetLockFilePath()); err == nil {
+		if oldLock, err = run.Config.LockSvc.ReadLock(); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "READING BACKEND MODULE REGISTRIES")
+	modules, err := run.Config.RegistrySvc.GetModules(true)
+	if err != nil {
+		return nil, err
+	}
+	run.Config.RegistrySvc.ExtractModuleMetadata(modules)
+
+	sidecarImage, _, err := run.Config.ModuleSvc.GetSidecarImage(modules.EurekaModules)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := run.Config.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer run.Config.DockerClient.Close(client)
+
+	slog.Info(run.Config.Action.Name, "text", "RESOLVING IMAGE DIGESTS")
+	newLock, err := run.createLock(client, modules, sidecarImage)
+	if err != nil {
+		return nil, err
+	}
+	diffs := run.Config.LockSvc.DiffLock(oldLock, newLock)
+	if run.Config.Action.IsDryRun() {
+		return diffs, nil
+	}
+
+	return diffs, run.Config.LockSvc.WriteLock(newLock)
+}
+
+// WriteLock pins the deployed modules, their image digests and the sidecar image into the profile lock file
+func (run *Run) WriteLock(client *client.Client, modules *models.ProxyModulesByRegistry, sidecarImage string) error {
+	lock, err := run.createLock(client, modules, sidecarImage)
+	if err != nil {
+		return err
+	}
+
+	return run.Config.LockSvc.WriteLock(lock)
+}
+
+func (run *Run) createLock(client *client.Client, modules *models.ProxyModulesByRegistry, sidecarImage string) (*models.Lock, error) {
+	backendModules, err := run.ReadAllBackendModules()
+	if err != nil {
+		return nil, err
+	}
+	images := run.Config.ModuleSvc.GetModuleImages(&models.Containers{
+		Modules:        modules,
+		BackendModules: backendModules,
+	}, sidecarImage)
+
+	return run.Config.LockSvc.CreateLock(client, modules, images, sidecarImage)
+}
+
+func (run *Run) PrintLockDiff(writer io.Writer, diffs []models.LockDiff, output string) error {
+	if output == constant.JSONOutput {
+		if diffs == nil {
+			diffs = []models.LockDiff{}
+		}
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(diffs)
+	}
+	if len(diffs) == 0 {
+		_, err := fmt.Fprintln(writer, "Lock file is up to date")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tCHANGE\tOLD\tNEW")
+	for _, diff := range diffs {
+		oldValue, newValue := getLockDiffValue(diff.OldVersion, diff.OldDigest), getLockDiffValue(diff.NewVersion, diff.NewDigest)
+		if diff.Change != constant.LockChangeRebuilt {
+			oldValue, newValue = getLockDiffValue(diff.OldVersion, ""), getLockDiffValue(diff.NewVersion, "")
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", diff.Name, diff.Change, oldValue, newValue)
+	}
+
+	return tw.Flush()
+}
+
+func getLockDiffValue(version string, digest string) string {
+	if version == "" {
+		return "-"
+	}
+	if digest == "" {
+		return version
+	}
+	if len(digest) > constant.ShortDigestLength {
+		digest = digest[:constant.ShortDigestLength]
+	}
+
+	return fmt.Sprintf("%s (%s)", version, digest)
+}
+
+func init() {
+	rootCmd.AddCommand(updateLockCmd)
+	updateLockCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := updateLockCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
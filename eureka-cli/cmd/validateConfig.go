@@ -0,0 +1,94 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// validateConfigCmd represents the validateConfig command
+var validateConfigCmd = &cobra.Command{
+	Use:   "validateConfig",
+	Short: "Validate config",
+	Long:  `Validate the config file against the config schema, reporting unknown keys, wrong types, port conflicts and dangling references with their line and column.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.ValidateConfig)
+		if err != nil {
+			return err
+		}
+
+		report, err := run.ValidateConfig(viper.ConfigFileUsed())
+		if err != nil {
+			return err
+		}
+		if err := run.PrintValidationReport(os.Stdout, report, params.Output); err != nil {
+			return err
+		}
+		if errorCount := report.CountIssues(constant.ErrorSeverity); errorCount > 0 {
+			return errors.ConfigInvalid(report.FilePath, errorCount)
+		}
+
+		return nil
+	},
+}
+
+func (run *Run) ValidateConfig(filePath string) (*models.ValidationReport, error) {
+	slog.Info(run.Config.Action.Name, "text", "VALIDATING CONFIG", "file", filePath)
+	return run.Config.ConfigSvc.ValidateConfig(filePath)
+}
+
+func (run *Run) PrintValidationReport(writer io.Writer, report *models.ValidationReport, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	for _, issue := range report.Issues {
+		_, _ = fmt.Fprintf(writer, "%s:%s\n", issue.GetFilePath(report.FilePath), issue.String())
+	}
+	_, err := fmt.Fprintf(writer, "%d error(s), %d warning(s)\n", report.CountIssues(constant.ErrorSeverity), report.CountIssues(constant.WarningSeverity))
+
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(validateConfigCmd)
+	validateConfigCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := validateConfigCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/spf13/cobra"
+)
+
+// vaultCmd represents the vault command
+var vaultCmd = &cobra.Command{
+	Use:   "vault",
+	Short: "Manage Vault secrets",
+	Long:  `List, read, write and delete the secrets of the KV v2 secrets engine using the Vault root token, e.g. the tenant secrets under folio/<tenant>.`,
+}
+
+func init() {
+	rootCmd.AddCommand(vaultCmd)
+	vaultCmd.PersistentFlags().StringVarP(&params.Path, action.Path.Long, action.Path.Short, "", action.Path.Description)
+
+	if err := vaultCmd.MarkPersistentFlagRequired(action.Path.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Path, err).Error())
+		os.Exit(1)
+	}
+}
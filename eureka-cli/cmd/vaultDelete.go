@@ -0,0 +1,56 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"log/slog"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/spf13/cobra"
+)
+
+// vaultDeleteCmd represents the vault delete command
+var vaultDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete a Vault secret or secret key",
+	Long:  `Delete a key of a secret while keeping its other keys, or permanently delete all versions of the secret when no key is given.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.VaultDelete)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		return run.VaultDelete()
+	},
+}
+
+func (run *Run) VaultDelete() error {
+	if params.Key == "" {
+		slog.Info(run.Config.Action.Name, "text", "DELETING SECRET", "path", params.Path)
+		return run.Config.VaultSvc.DeleteSecret(params.Path)
+	}
+
+	slog.Info(run.Config.Action.Name, "text", "DELETING SECRET KEY", "path", params.Path, "key", params.Key)
+	return run.Config.VaultSvc.DeleteSecretKey(params.Path, params.Key)
+}
+
+func init() {
+	vaultCmd.AddCommand(vaultDeleteCmd)
+	vaultDeleteCmd.PersistentFlags().StringVarP(&params.Key, action.Key.Long, action.Key.Short, "", action.Key.Description)
+}
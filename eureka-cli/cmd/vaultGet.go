@@ -0,0 +1,112 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/spf13/cobra"
+)
+
+// vaultGetCmd represents the vault get command
+var vaultGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Get a Vault secret",
+	Long:  `Show the keys of a secret or the value of a single key, values are masked unless --reveal is given.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !slices.Contains(constant.GetOutputFormats(), params.Output) {
+			return errors.UnsupportedOutputFormat(params.Output)
+		}
+
+		run, err := New(action.VaultGet)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		return run.VaultGet(os.Stdout)
+	},
+}
+
+func (run *Run) VaultGet(writer io.Writer) error {
+	slog.Debug(run.Config.Action.Name, "text", "Reading secret", "path", params.Path, "key", params.Key)
+	secret, err := run.Config.VaultSvc.GetSecret(params.Path)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(secret))
+	for key, value := range secret {
+		values[key] = fmt.Sprint(value)
+		if !params.Reveal {
+			values[key] = helpers.MaskSecret(values[key])
+		}
+	}
+	if params.Key != "" {
+		value, ok := values[params.Key]
+		if !ok {
+			return errors.VaultSecretKeyNotFound(params.Path, params.Key)
+		}
+		_, err := fmt.Fprintln(writer, value)
+
+		return err
+	}
+
+	return run.PrintSecret(writer, values, params.Output)
+}
+
+func (run *Run) PrintSecret(writer io.Writer, values map[string]string, output string) error {
+	if output == constant.JSONOutput {
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(values)
+	}
+
+	tw := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "KEY\tVALUE")
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\n", key, values[key])
+	}
+
+	return tw.Flush()
+}
+
+func init() {
+	vaultCmd.AddCommand(vaultGetCmd)
+	vaultGetCmd.PersistentFlags().StringVarP(&params.Key, action.Key.Long, action.Key.Short, "", action.Key.Description)
+	vaultGetCmd.PersistentFlags().BoolVarP(&params.Reveal, action.Reveal.Long, action.Reveal.Short, false, action.Reveal.Description)
+	vaultGetCmd.PersistentFlags().StringVarP(&params.Output, action.Output.Long, action.Output.Short, constant.TableOutput, fmt.Sprintf(action.Output.Description, constant.GetOutputFormats()))
+
+	if err := vaultGetCmd.RegisterFlagCompletionFunc(action.Output.Long, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return constant.GetOutputFormats(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		slog.Error(errors.RegisterFlagCompletionFailed(err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/spf13/cobra"
+)
+
+// vaultListCmd represents the vault list command
+var vaultListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Vault secrets",
+	Long:  `List the secrets and folders under a path, folder names end with a slash.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.VaultList)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		return run.VaultList(os.Stdout)
+	},
+}
+
+func (run *Run) VaultList(writer io.Writer) error {
+	slog.Debug(run.Config.Action.Name, "text", "Listing secrets", "path", params.Path)
+	names, err := run.Config.VaultSvc.ListSecrets(params.Path)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		_, _ = fmt.Fprintln(writer, name)
+	}
+
+	return nil
+}
+
+func init() {
+	vaultCmd.AddCommand(vaultListCmd)
+}
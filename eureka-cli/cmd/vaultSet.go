@@ -0,0 +1,63 @@
+/*
+Copyright © 2025 Open Library Foundation
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/spf13/cobra"
+)
+
+// vaultSetCmd represents the vault set command
+var vaultSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set a Vault secret key",
+	Long:  `Add or replace a key of a secret while keeping its other keys, the secret is created when it does not exist.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		run, err := New(action.VaultSet)
+		if err != nil {
+			return err
+		}
+		if err := run.GetVaultRootToken(); err != nil {
+			return err
+		}
+
+		return run.VaultSet()
+	},
+}
+
+func (run *Run) VaultSet() error {
+	slog.Info(run.Config.Action.Name, "text", "SETTING SECRET KEY", "path", params.Path, "key", params.Key)
+	return run.Config.VaultSvc.SetSecretKeys(params.Path, map[string]any{params.Key: params.Value})
+}
+
+func init() {
+	vaultCmd.AddCommand(vaultSetCmd)
+	vaultSetCmd.PersistentFlags().StringVarP(&params.Key, action.Key.Long, action.Key.Short, "", action.Key.Description)
+	vaultSetCmd.PersistentFlags().StringVarP(&params.Value, action.Value.Long, action.Value.Short, "", action.Value.Description)
+
+	if err := vaultSetCmd.MarkPersistentFlagRequired(action.Key.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Key, err).Error())
+		os.Exit(1)
+	}
+	if err := vaultSetCmd.MarkPersistentFlagRequired(action.Value.Long); err != nil {
+		slog.Error(errors.MarkFlagRequiredFailed(action.Value, err).Error())
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,80 @@
+package configsvc
+
+import (
+	"cmp"
+	"os"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigProcessor defines the interface for config file operations
+type ConfigProcessor interface {
+	ConfigValidator
+	ConfigResolver
+	ConfigLister
+}
+
+// ConfigValidator defines the interface for validating config files against the config schema
+type ConfigValidator interface {
+	ValidateConfig(filePath string) (*models.ValidationReport, error)
+}
+
+// ConfigResolver defines the interface for resolving the profiles extended by config files
+type ConfigResolver interface {
+	ResolveConfig(filePath string) ([]byte, error)
+}
+
+// ConfigLister defines the interface for listing the profiles found in the profile directories
+type ConfigLister interface {
+	ListProfiles() []models.ProfileSummary
+}
+
+// ConfigSvc provides functionality for validating, resolving and discovering config files
+type ConfigSvc struct {
+	Action *action.Action
+	schema *schemaNode
+}
+
+// New creates a new ConfigSvc instance
+func New(action *action.Action) *ConfigSvc {
+	return &ConfigSvc{Action: action, schema: newConfigSchema()}
+}
+
+func (cs *ConfigSvc) ValidateConfig(filePath string) (*models.ValidationReport, error) {
+	documents, err := loadProfileChain(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ValidationReport{FilePath: filePath, Issues: []models.ValidationIssue{}}
+	v := &validator{report: report, origins: make(map[*yaml.Node]string)}
+	for _, document := range documents {
+		v.addOrigin(document.root, document.filePath)
+		v.validateNode(document.root, cs.schema, "")
+	}
+
+	root := mergeProfileChain(documents)
+	v.validatePorts(root)
+	v.validateReferences(root)
+
+	slices.SortStableFunc(report.Issues, func(a, b models.ValidationIssue) int {
+		return cmp.Or(cmp.Compare(a.File, b.File), cmp.Compare(a.Line, b.Line), cmp.Compare(a.Column, b.Column))
+	})
+
+	return report, nil
+}
+
+func (cs *ConfigSvc) ResolveConfig(filePath string) ([]byte, error) {
+	resolved, extended, err := ResolveConfigFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !extended {
+		return os.ReadFile(filePath)
+	}
+
+	return resolved, nil
+}
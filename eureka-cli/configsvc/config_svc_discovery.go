@@ -0,0 +1,133 @@
+package configsvc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"gopkg.in/yaml.v3"
+)
+
+// GetProfileDirs returns the directories scanned for config.<profile>.yaml files, a project-local
+// .eureka directory in the current working directory takes precedence over the .eureka home directory
+func GetProfileDirs() []string {
+	var profileDirs []string
+	if workDir, err := os.Getwd(); err == nil {
+		projectDir := filepath.Join(workDir, constant.ConfigDir)
+		if info, err := os.Stat(projectDir); err == nil && info.IsDir() {
+			profileDirs = append(profileDirs, projectDir)
+		}
+	}
+	if userHome, err := os.UserHomeDir(); err == nil {
+		homeDir := filepath.Join(userHome, constant.ConfigDir)
+		if !slices.Contains(profileDirs, homeDir) {
+			profileDirs = append(profileDirs, homeDir)
+		}
+	}
+
+	return profileDirs
+}
+
+// GetProfileFiles returns the config file of every profile found in the profile directories
+// keyed by the profile name, a profile found in multiple directories resolves to the first one
+func GetProfileFiles() map[string]string {
+	profileFiles := make(map[string]string)
+	for _, profileDir := range GetProfileDirs() {
+		filePaths, err := filepath.Glob(filepath.Join(profileDir, getConfigFileName("*")))
+		if err != nil {
+			continue
+		}
+		for _, filePath := range filePaths {
+			profile := getProfileName(filePath)
+			if _, exists := profileFiles[profile]; !exists && profile != "" {
+				profileFiles[profile] = filePath
+			}
+		}
+	}
+
+	return profileFiles
+}
+
+// GetProfiles returns the built-in profiles followed by the user-defined profiles found in the profile directories
+func GetProfiles() []string {
+	profiles := constant.GetProfiles()
+	var userProfiles []string
+	for profile := range GetProfileFiles() {
+		if !slices.Contains(profiles, profile) {
+			userProfiles = append(userProfiles, profile)
+		}
+	}
+	slices.Sort(userProfiles)
+
+	return append(profiles, userProfiles...)
+}
+
+func (cs *ConfigSvc) ListProfiles() []models.ProfileSummary {
+	profileFiles := GetProfileFiles()
+
+	var profiles []models.ProfileSummary
+	for _, profile := range GetProfiles() {
+		filePath, exists := profileFiles[profile]
+		if !exists {
+			continue
+		}
+		profiles = append(profiles, getProfileSummary(profile, filePath))
+	}
+
+	return profiles
+}
+
+func getProfileSummary(profile string, filePath string) models.ProfileSummary {
+	summary := models.ProfileSummary{Name: profile, FilePath: filePath}
+	documents, err := loadProfileChain(filePath)
+	if err != nil {
+		summary.Error = err.Error()
+		return summary
+	}
+	if len(documents) > 1 {
+		summary.Extends = getProfileName(documents[1].filePath)
+	}
+
+	root := mergeProfileChain(documents)
+	if applicationNode := getNode(root, field.Application, entry(field.ApplicationName)); applicationNode != nil {
+		summary.Application = applicationNode.Value
+	}
+	summary.PortStart, _ = getInt(getNode(root, field.Application, entry(field.ApplicationPortStart)))
+	summary.PortEnd, _ = getInt(getNode(root, field.Application, entry(field.ApplicationPortEnd)))
+	dependenciesNode := getNode(root, field.Application, entry(field.ApplicationDependencies))
+	summary.ChildApp = dependenciesNode != nil && dependenciesNode.Kind == yaml.MappingNode && len(dependenciesNode.Content) > 0
+
+	return summary
+}
+
+// findParentConfigFile looks up the config file of an extended profile next to the child config file first
+// and then in the profile directories, falling back to the path next to the child config file
+func findParentConfigFile(childDir string, profile string) string {
+	filePath := filepath.Join(childDir, getConfigFileName(profile))
+	if _, err := os.Stat(filePath); err == nil {
+		return filePath
+	}
+	for _, profileDir := range GetProfileDirs() {
+		if _, err := os.Stat(filepath.Join(profileDir, getConfigFileName(profile))); err == nil {
+			return filepath.Join(profileDir, getConfigFileName(profile))
+		}
+	}
+
+	return filePath
+}
+
+func getConfigFileName(profile string) string {
+	return fmt.Sprintf("%s.%s.%s", constant.ConfigPrefix, profile, constant.ConfigType)
+}
+
+func getProfileName(filePath string) string {
+	fileName := filepath.Base(filePath)
+	fileName = strings.TrimPrefix(fileName, constant.ConfigPrefix+".")
+
+	return strings.TrimSuffix(fileName, "."+constant.ConfigType)
+}
@@ -0,0 +1,86 @@
+package configsvc_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newProfileDirs(t *testing.T) (string, string) {
+	homeDir := filepath.Join(t.TempDir(), constant.ConfigDir)
+	workDir := t.TempDir()
+	projectDir := filepath.Join(workDir, constant.ConfigDir)
+	assert.NoError(t, os.MkdirAll(homeDir, 0700))
+	assert.NoError(t, os.MkdirAll(projectDir, 0700))
+	t.Setenv("HOME", filepath.Dir(homeDir))
+	t.Chdir(workDir)
+
+	return homeDir, projectDir
+}
+
+func TestGetProfileDirs(t *testing.T) {
+	// Arrange
+	homeDir, projectDir := newProfileDirs(t)
+
+	// Act
+	profileDirs := configsvc.GetProfileDirs()
+
+	// Assert
+	assert.Equal(t, []string{projectDir, homeDir}, profileDirs)
+}
+
+func TestGetProfiles_IncludesUserDefinedProfiles(t *testing.T) {
+	// Arrange
+	homeDir, projectDir := newProfileDirs(t)
+	assert.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.combined.yaml"), []byte("profile:\n  name: combined\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.acq.yaml"), []byte("profile:\n  name: acq\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(projectDir, "config.team.yaml"), []byte("profile:\n  name: team\n"), 0600))
+
+	// Act
+	profiles := configsvc.GetProfiles()
+
+	// Assert
+	assert.Equal(t, append(constant.GetProfiles(), "acq", "team"), profiles)
+}
+
+func TestGetProfileFiles_ProjectDirTakesPrecedence(t *testing.T) {
+	// Arrange
+	homeDir, projectDir := newProfileDirs(t)
+	assert.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.acq.yaml"), []byte("profile:\n  name: acq\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(projectDir, "config.acq.yaml"), []byte("profile:\n  name: acq\n"), 0600))
+
+	// Act
+	profileFiles := configsvc.GetProfileFiles()
+
+	// Assert
+	assert.Equal(t, map[string]string{"acq": filepath.Join(projectDir, "config.acq.yaml")}, profileFiles)
+}
+
+func TestListProfiles(t *testing.T) {
+	// Arrange
+	homeDir, projectDir := newProfileDirs(t)
+	combined := "application:\n  name: app-combined\n  port-start: 30000\n  port-end: 30999\n"
+	export := "application:\n  name: app-export\n  port-start: 31000\n  port-end: 31999\n  dependencies:\n    name: app-combined\n"
+	assert.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.combined.yaml"), []byte(combined), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(homeDir, "config.export.yaml"), []byte(export), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(projectDir, "config.team.yaml"), []byte("profile:\n  extends: combined\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(projectDir, "config.broken.yaml"), []byte("profile: [\n"), 0600))
+	svc := configsvc.New(&action.Action{Name: "test-action"})
+
+	// Act
+	profiles := svc.ListProfiles()
+
+	// Assert
+	assert.Len(t, profiles, 4)
+	assert.Equal(t, models.ProfileSummary{Name: "combined", FilePath: filepath.Join(homeDir, "config.combined.yaml"), Application: "app-combined", PortStart: 30000, PortEnd: 30999}, profiles[0])
+	assert.True(t, profiles[1].ChildApp)
+	assert.Equal(t, "broken", profiles[2].Name)
+	assert.NotEmpty(t, profiles[2].Error)
+	assert.Equal(t, models.ProfileSummary{Name: "team", FilePath: filepath.Join(projectDir, "config.team.yaml"), Application: "app-combined", PortStart: 30000, PortEnd: 30999, Extends: "combined"}, profiles[3])
+}
@@ -0,0 +1,153 @@
+package configsvc
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"gopkg.in/yaml.v3"
+)
+
+// profileDocument is a parsed config file taking part in a profile extends chain
+type profileDocument struct {
+	filePath string
+	root     *yaml.Node
+}
+
+// ResolveConfigFile reads a config file and deep-merges it on top of the profiles it extends,
+// the returned flag is false when the config file does not extend any profile
+func ResolveConfigFile(filePath string) ([]byte, bool, error) {
+	documents, err := loadProfileChain(filePath)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(documents) == 1 {
+		return nil, false, nil
+	}
+
+	var resolved bytes.Buffer
+	encoder := yaml.NewEncoder(&resolved)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(mergeProfileChain(documents)); err != nil {
+		return nil, false, errors.ConfigParseFailed(filePath, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, false, errors.ConfigParseFailed(filePath, err)
+	}
+
+	return resolved.Bytes(), true, nil
+}
+
+// loadProfileChain parses a config file followed by the config files of every profile it extends
+func loadProfileChain(filePath string) ([]profileDocument, error) {
+	var (
+		documents []profileDocument
+		visited   []string
+	)
+	for filePath != "" {
+		absPath, err := filepath.Abs(filePath)
+		if err != nil {
+			return nil, errors.ConfigParseFailed(filePath, err)
+		}
+		if slices.Contains(visited, absPath) {
+			var fileNames []string
+			for _, visitedPath := range append(visited, absPath) {
+				fileNames = append(fileNames, filepath.Base(visitedPath))
+			}
+			return nil, errors.ConfigExtendsCycle(fileNames)
+		}
+		visited = append(visited, absPath)
+
+		root, err := parseConfigFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, profileDocument{filePath: filePath, root: root})
+
+		filePath = ""
+		if parentNode := getNode(root, field.Profile, entry(field.ProfileExtends)); parentNode != nil && parentNode.Kind == yaml.ScalarNode && parentNode.Tag != nullTag {
+			filePath = findParentConfigFile(filepath.Dir(absPath), parentNode.Value)
+		}
+	}
+
+	return documents, nil
+}
+
+func parseConfigFile(filePath string) (*yaml.Node, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errors.ConfigParseFailed(filePath, err)
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return nil, errors.ConfigParseFailed(filePath, err)
+	}
+	if len(document.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+
+	return document.Content[0], nil
+}
+
+// mergeProfileChain merges the documents of a profile chain starting from the root parent,
+// the extends key is dropped from the result as the merged config no longer depends on other profiles
+func mergeProfileChain(documents []profileDocument) *yaml.Node {
+	merged := documents[len(documents)-1].root
+	for i := len(documents) - 2; i >= 0; i-- {
+		merged = mergeNodes(merged, documents[i].root)
+	}
+	if idx := findKey(merged, field.Profile); idx >= 0 && resolveAlias(merged.Content[idx+1]).Kind == yaml.MappingNode {
+		profileNode := *resolveAlias(merged.Content[idx+1])
+		profileNode.Content = slices.Clone(profileNode.Content)
+		removeKey(&profileNode, entry(field.ProfileExtends))
+		merged.Content[idx+1] = &profileNode
+	}
+
+	return merged
+}
+
+// mergeNodes deep-merges a child node on top of a parent node, maps are merged key by key
+// and an explicit null in the child deletes the key, any other child value replaces the parent value
+func mergeNodes(parent *yaml.Node, child *yaml.Node) *yaml.Node {
+	parent, child = resolveAlias(parent), resolveAlias(child)
+	if parent.Kind != yaml.MappingNode || child.Kind != yaml.MappingNode {
+		return child
+	}
+
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: child.Tag, Line: child.Line, Column: child.Column}
+	merged.Content = append(merged.Content, parent.Content...)
+	for i := 0; i+1 < len(child.Content); i += 2 {
+		keyNode, valueNode := child.Content[i], resolveAlias(child.Content[i+1])
+		idx := findKey(merged, keyNode.Value)
+		switch {
+		case valueNode.Tag == nullTag:
+			removeKey(merged, keyNode.Value)
+		case idx >= 0:
+			merged.Content[idx+1] = mergeNodes(merged.Content[idx+1], valueNode)
+		default:
+			merged.Content = append(merged.Content, keyNode, valueNode)
+		}
+	}
+
+	return merged
+}
+
+func findKey(node *yaml.Node, key string) int {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func removeKey(node *yaml.Node, key string) {
+	if idx := findKey(node, key); idx >= 0 {
+		node.Content = slices.Delete(node.Content, idx, idx+2)
+	}
+}
@@ -0,0 +1,159 @@
+package configsvc
+
+import (
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+)
+
+type schemaKind int
+
+const (
+	objectKind schemaKind = iota
+	mapKind
+	listKind
+	scalarKind
+	intKind
+	boolKind
+	unusedKind
+)
+
+// schemaNode describes the expected shape of a config value, objects have a fixed set of keys
+// while maps (e.g. tenants or environment variables) accept any key with values of the same shape
+type schemaNode struct {
+	kind   schemaKind
+	keys   map[string]*schemaNode
+	values *schemaNode
+}
+
+func objectOf(keys map[string]*schemaNode) *schemaNode {
+	return &schemaNode{kind: objectKind, keys: keys}
+}
+
+func mapOf(values *schemaNode) *schemaNode {
+	return &schemaNode{kind: mapKind, values: values}
+}
+
+func listOf(values *schemaNode) *schemaNode {
+	return &schemaNode{kind: listKind, values: values}
+}
+
+func scalar() *schemaNode {
+	return &schemaNode{kind: scalarKind}
+}
+
+func integer() *schemaNode {
+	return &schemaNode{kind: intKind}
+}
+
+func boolean() *schemaNode {
+	return &schemaNode{kind: boolKind}
+}
+
+// unused accepts a key of older configs that is no longer read, the key is reported as a warning instead of an unknown key
+func unused() *schemaNode {
+	return &schemaNode{kind: unusedKind}
+}
+
+// entry returns the last segment of a dotted field path, e.g. port-start for application.port-start
+func entry(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+func newConfigSchema() *schemaNode {
+	environment := mapOf(scalar())
+	resources := objectOf(map[string]*schemaNode{
+		field.ModuleResourceCpuCountEntry:          integer(),
+		field.ModuleResourceCpusEntry:              unused(),
+		field.ModuleResourceMemoryReservationEntry: integer(),
+		field.ModuleResourceMemoryEntry:            integer(),
+		field.ModuleResourceMemorySwapEntry:        integer(),
+		field.ModuleResourceOomKillDisableEntry:    boolean(),
+	})
+	backendModule := objectOf(map[string]*schemaNode{
+		field.ModuleDeployModuleEntry:        boolean(),
+		field.ModuleDeploySidecarEntry:       boolean(),
+		field.ModuleVersionEntry:             scalar(),
+		field.ModulePortEntry:                integer(),
+		field.ModulePrivatePortEntry:         integer(),
+		field.ModulePortServerEntry:          integer(),
+		field.ModuleUseVaultEntry:            boolean(),
+		field.ModuleUseOkapiURLEntry:         boolean(),
+		field.ModuleDisableSystemUserEntry:   boolean(),
+		field.ModuleLocalDescriptorPathEntry: scalar(),
+		field.ModuleEnvEntry:                 environment,
+		field.ModuleSidecarEnvEntry:          environment,
+		field.ModuleVolumesEntry:             listOf(scalar()),
+		field.ModuleResourceEntry:            resources,
+	})
+	frontendModule := objectOf(map[string]*schemaNode{
+		field.ModuleDeployModuleEntry:        boolean(),
+		field.ModuleVersionEntry:             scalar(),
+		field.ModuleLocalDescriptorPathEntry: scalar(),
+	})
+
+	return objectOf(map[string]*schemaNode{
+		field.Profile: objectOf(map[string]*schemaNode{
+			entry(field.ProfileName):    scalar(),
+			entry(field.ProfileExtends): scalar(),
+		}),
+		field.Application: objectOf(map[string]*schemaNode{
+			entry(field.ApplicationName):             scalar(),
+			entry(field.ApplicationVersion):          scalar(),
+			entry(field.ApplicationFetchDescriptors): boolean(),
+			entry(field.ApplicationPortStart):        integer(),
+			entry(field.ApplicationPortEnd):          integer(),
+			entry(field.ApplicationStripesBranch):    scalar(),
+			entry(field.ApplicationGatewayHostname):  scalar(),
+			entry(field.ApplicationDependencies):     mapOf(scalar()),
+		}),
+		field.Lsp: objectOf(map[string]*schemaNode{entry(field.LspURL): scalar()}),
+		field.Far: objectOf(map[string]*schemaNode{entry(field.FarURL): scalar()}),
+		field.Registry: objectOf(map[string]*schemaNode{
+			entry(field.RegistryURL):      scalar(),
+			entry(field.RegistrySnapshot): scalar(),
+		}),
+		field.Namespaces: objectOf(map[string]*schemaNode{entry(field.NamespacesPlatformCompleteUI): scalar()}),
+		field.Env:        environment,
+		field.Consortiums: mapOf(objectOf(map[string]*schemaNode{
+			field.ConsortiumCreateConsortiumEntry:      boolean(),
+			field.ConsortiumEnableCentralOrderingEntry: boolean(),
+		})),
+		field.Tenants: mapOf(objectOf(map[string]*schemaNode{
+			field.TenantsDeployUIEntry:            boolean(),
+			field.TenantsSingleTenantEntry:        boolean(),
+			field.TenantsEnableEcsRequestEntry:    boolean(),
+			field.TenantsConsortiumEntry:          scalar(),
+			field.TenantsCentralTenantEntry:       boolean(),
+			field.TenantsPlatformCompleteURLEntry: scalar(),
+		})),
+		field.Users: mapOf(objectOf(map[string]*schemaNode{
+			field.UsersConsortiumEntry:  scalar(),
+			field.UsersTenantEntry:      scalar(),
+			field.UsersPasswordEntry:    scalar(),
+			field.UsersLastNameEntry:    scalar(),
+			field.UsersFirstNameEntry:   scalar(),
+			field.UsersRolesEntry:       listOf(scalar()),
+			field.UsersPermissionsEntry: unused(),
+		})),
+		field.Roles: mapOf(objectOf(map[string]*schemaNode{
+			field.RolesConsortiumEntry:     scalar(),
+			field.RolesTenantEntry:         scalar(),
+			field.RolesCapabilitySetsEntry: listOf(scalar()),
+		})),
+		field.SidecarModule: objectOf(map[string]*schemaNode{
+			field.SidecarModuleImageEntry:             scalar(),
+			field.SidecarModuleCustomNamespaceEntry:   boolean(),
+			field.SidecarModuleVersionEntry:           scalar(),
+			entry(field.SidecarModuleEnv):             environment,
+			entry(field.SidecarModuleResources):       resources,
+			entry(field.SidecarModuleCmd):             listOf(scalar()),
+			entry(field.SidecarModuleNativeBinaryCmd): listOf(scalar()),
+		}),
+		field.BackendModules:        mapOf(backendModule),
+		field.FrontendModules:       mapOf(frontendModule),
+		field.CustomFrontendModules: mapOf(frontendModule),
+		field.ExtraVolumes:          listOf(scalar()),
+		field.TemplateEnv:           environment,
+	})
+}
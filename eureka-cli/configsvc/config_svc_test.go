@@ -0,0 +1,354 @@
+package configsvc_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func validate(t *testing.T, content string) *models.ValidationReport {
+	filePath := filepath.Join(t.TempDir(), "config.test.yaml")
+	assert.NoError(t, os.WriteFile(filePath, []byte(content), 0600))
+
+	report, err := configsvc.New(&action.Action{Name: "test-action"}).ValidateConfig(filePath)
+	assert.NoError(t, err)
+
+	return report
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	act := &action.Action{Name: "test-action"}
+
+	// Act
+	result := configsvc.New(act)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, act, result.Action)
+}
+
+func TestValidateConfig_Valid(t *testing.T) {
+	// Act
+	report := validate(t, `profile:
+  name: combined
+application:
+  name: app-combined
+  port-start: 30000
+  port-end: 30999
+consortiums:
+  ecs:
+    create-consortium: true
+tenants:
+  diku:
+    deploy-ui: true
+    consortium: ecs
+roles:
+  adm:
+    tenant: diku
+    capability-sets: ["all"]
+users:
+  diku_admin:
+    tenant: diku
+    roles: [adm]
+backend-modules:
+  mod-users:
+    port: 30001
+    resources:
+      cpu-count: 1
+frontend-modules: []
+`)
+
+	// Assert
+	assert.Empty(t, report.Issues)
+}
+
+func TestValidateConfig_UnknownKeyWithSuggestion(t *testing.T) {
+	// Act
+	report := validate(t, `application:
+  name: app-combined
+  port-strat: 30000
+`)
+
+	// Assert
+	assert.Len(t, report.Issues, 1)
+	issue := report.Issues[0]
+	assert.Equal(t, constant.ErrorSeverity, issue.Severity)
+	assert.Equal(t, "application.port-strat", issue.Path)
+	assert.Equal(t, 3, issue.Line)
+	assert.Equal(t, 3, issue.Column)
+	assert.Contains(t, issue.Message, `did you mean "port-start"?`)
+}
+
+func TestValidateConfig_UnusedKeys(t *testing.T) {
+	// Act
+	report := validate(t, `backend-modules:
+  mod-users:
+    resources:
+      cpus: 2
+      cpu-cont: 2
+users:
+  diku_admin:
+    permissions: ["perms.all"]
+`)
+
+	// Assert
+	assert.Equal(t, 2, report.CountIssues(constant.WarningSeverity))
+	assert.Equal(t, "backend-modules.mod-users.resources.cpus", report.Issues[0].Path)
+	assert.Equal(t, 4, report.Issues[0].Line)
+	assert.Contains(t, report.Issues[0].Message, "is not used")
+	assert.Equal(t, constant.ErrorSeverity, report.Issues[1].Severity)
+	assert.Contains(t, report.Issues[1].Message, `did you mean "cpu-count"?`)
+	assert.Equal(t, "users.diku_admin.permissions", report.Issues[2].Path)
+}
+
+func TestValidateConfig_WrongTypes(t *testing.T) {
+	// Act
+	report := validate(t, `application:
+  port-start: thirty
+tenants:
+  diku:
+    deploy-ui: "yes"
+backend-modules:
+  mod-users:
+    environment: [A, B]
+`)
+
+	// Assert
+	assert.Equal(t, 3, report.CountIssues(constant.ErrorSeverity))
+	assert.Equal(t, "application.port-start", report.Issues[0].Path)
+	assert.Contains(t, report.Issues[0].Message, "expected an integer")
+	assert.Equal(t, "tenants.diku.deploy-ui", report.Issues[1].Path)
+	assert.Contains(t, report.Issues[1].Message, "expected a boolean")
+	assert.Equal(t, "backend-modules.mod-users.environment", report.Issues[2].Path)
+	assert.Contains(t, report.Issues[2].Message, "expected a map")
+}
+
+func TestValidateConfig_DuplicateKey(t *testing.T) {
+	// Act
+	report := validate(t, `tenants:
+  diku: {}
+  diku: {}
+`)
+
+	// Assert
+	assert.Len(t, report.Issues, 1)
+	assert.Equal(t, 3, report.Issues[0].Line)
+	assert.Contains(t, report.Issues[0].Message, "duplicate key")
+}
+
+func TestValidateConfig_Ports(t *testing.T) {
+	// Act
+	report := validate(t, `application:
+  port-start: 30000
+  port-end: 30999
+backend-modules:
+  mod-a:
+    port: 30001
+  mod-b:
+    port: 30001
+  mod-c:
+    port: 9901
+  mod-d:
+    deploy-module: false
+    port: 30001
+`)
+
+	// Assert
+	assert.Len(t, report.Issues, 2)
+	assert.Equal(t, constant.ErrorSeverity, report.Issues[0].Severity)
+	assert.Equal(t, "backend-modules.mod-b.port", report.Issues[0].Path)
+	assert.Contains(t, report.Issues[0].Message, "already used by backend module mod-a")
+	assert.Equal(t, constant.WarningSeverity, report.Issues[1].Severity)
+	assert.Equal(t, "backend-modules.mod-c.port", report.Issues[1].Path)
+}
+
+func TestValidateConfig_InvertedPortRange(t *testing.T) {
+	// Act
+	report := validate(t, `application:
+  port-start: 30999
+  port-end: 30000
+`)
+
+	// Assert
+	assert.Len(t, report.Issues, 1)
+	assert.Equal(t, "application.port-end", report.Issues[0].Path)
+}
+
+func TestValidateConfig_DanglingReferences(t *testing.T) {
+	// Act
+	report := validate(t, `tenants:
+  diku:
+    consortium: missing
+roles:
+  adm:
+    tenant: other
+users:
+  diku_admin:
+    tenant: diku
+    roles: [adm, ghost]
+`)
+
+	// Assert
+	assert.Len(t, report.Issues, 3)
+	assert.Equal(t, `unknown consortium "missing"`, report.Issues[0].Message)
+	assert.Equal(t, `unknown tenant "other"`, report.Issues[1].Message)
+	assert.Equal(t, "users.diku_admin.roles[1]", report.Issues[2].Path)
+	assert.Equal(t, `unknown role "ghost"`, report.Issues[2].Message)
+}
+
+func TestValidateConfig_ParseError(t *testing.T) {
+	// Arrange
+	filePath := filepath.Join(t.TempDir(), "config.test.yaml")
+	assert.NoError(t, os.WriteFile(filePath, []byte("tenants: [\n"), 0600))
+
+	// Act
+	report, err := configsvc.New(&action.Action{Name: "test-action"}).ValidateConfig(filePath)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func writeProfiles(t *testing.T, profiles map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range profiles {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, "config."+name+".yaml"), []byte(content), 0600))
+	}
+
+	return dir
+}
+
+func TestResolveConfigFile_NoExtends(t *testing.T) {
+	// Arrange
+	dir := writeProfiles(t, map[string]string{"combined": "profile:\n  name: combined\n"})
+
+	// Act
+	resolved, extended, err := configsvc.ResolveConfigFile(filepath.Join(dir, "config.combined.yaml"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, extended)
+	assert.Nil(t, resolved)
+}
+
+func TestResolveConfigFile_MultiLevelChain(t *testing.T) {
+	// Arrange
+	dir := writeProfiles(t, map[string]string{
+		"combined": `profile:
+  name: combined
+application:
+  name: app-combined
+  port-start: 30000
+environment:
+  ENV: folio
+  JAVA_OPTIONS: -Xmx400m
+tenants:
+  diku:
+    deploy-ui: true
+extra-volumes:
+  - /a:/a
+`,
+		"ecs": `profile:
+  extends: combined
+  name: ecs
+application:
+  name: app-ecs
+tenants:
+  diku: null
+  ecs:
+    central-tenant: true
+extra-volumes:
+  - /b:/b
+`,
+		"ecs-migration": `profile:
+  extends: ecs
+environment:
+  JAVA_OPTIONS: null
+  KAFKA_HOST: kafka.eureka
+`,
+	})
+
+	// Act
+	resolved, extended, err := configsvc.ResolveConfigFile(filepath.Join(dir, "config.ecs-migration.yaml"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, extended)
+	var config map[string]any
+	assert.NoError(t, yaml.Unmarshal(resolved, &config))
+	assert.Equal(t, map[string]any{
+		"profile":       map[string]any{"name": "ecs"},
+		"application":   map[string]any{"name": "app-ecs", "port-start": 30000},
+		"environment":   map[string]any{"ENV": "folio", "KAFKA_HOST": "kafka.eureka"},
+		"tenants":       map[string]any{"ecs": map[string]any{"central-tenant": true}},
+		"extra-volumes": []any{"/b:/b"},
+	}, config)
+}
+
+func TestResolveConfigFile_Cycle(t *testing.T) {
+	// Arrange
+	dir := writeProfiles(t, map[string]string{
+		"a": "profile:\n  extends: b\n",
+		"b": "profile:\n  extends: a\n",
+	})
+
+	// Act
+	resolved, _, err := configsvc.ResolveConfigFile(filepath.Join(dir, "config.a.yaml"))
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrInvalidInput)
+	assert.Contains(t, err.Error(), "config.a.yaml -> config.b.yaml -> config.a.yaml")
+	assert.Nil(t, resolved)
+}
+
+func TestResolveConfigFile_MissingParent(t *testing.T) {
+	// Arrange
+	dir := writeProfiles(t, map[string]string{"ecs": "profile:\n  extends: missing\n"})
+
+	// Act
+	_, _, err := configsvc.ResolveConfigFile(filepath.Join(dir, "config.ecs.yaml"))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "config.missing.yaml")
+}
+
+func TestValidateConfig_ExtendedProfile(t *testing.T) {
+	// Arrange
+	dir := writeProfiles(t, map[string]string{
+		"combined": "tenants:\n  diku:\n    deploy-uii: true\nroles:\n  adm:\n    tenant: diku\n",
+		"ecs":      "profile:\n  extends: combined\nusers:\n  diku_admin:\n    tenant: diku\n    roles: [adm]\n",
+	})
+	filePath := filepath.Join(dir, "config.ecs.yaml")
+
+	// Act
+	report, err := configsvc.New(&action.Action{Name: "test-action"}).ValidateConfig(filePath)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, report.Issues, 1)
+	assert.Equal(t, filepath.Join(dir, "config.combined.yaml"), report.Issues[0].File)
+	assert.Equal(t, "tenants.diku.deploy-uii", report.Issues[0].Path)
+}
+
+func TestResolveConfig(t *testing.T) {
+	// Arrange
+	dir := writeProfiles(t, map[string]string{"combined": "profile:\n  name: combined\n"})
+	svc := configsvc.New(&action.Action{Name: "test-action"})
+
+	// Act
+	resolved, err := svc.ResolveConfig(filepath.Join(dir, "config.combined.yaml"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "profile:\n  name: combined\n", string(resolved))
+}
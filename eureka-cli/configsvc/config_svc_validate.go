@@ -0,0 +1,323 @@
+package configsvc
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	nullTag = "!!null"
+	intTag  = "!!int"
+	boolTag = "!!bool"
+
+	maxSuggestionDistance = 2
+)
+
+type validator struct {
+	report  *models.ValidationReport
+	origins map[*yaml.Node]string
+}
+
+// addOrigin records the config file every node was parsed from, so that issues found
+// in a merged profile chain point to the extended config file the value came from
+func (v *validator) addOrigin(node *yaml.Node, filePath string) {
+	v.origins[node] = filePath
+	for _, child := range node.Content {
+		v.addOrigin(child, filePath)
+	}
+}
+
+func (v *validator) addIssue(severity string, node *yaml.Node, path string, format string, args ...any) {
+	issue := models.ValidationIssue{
+		Severity: severity,
+		Path:     path,
+		Line:     node.Line,
+		Column:   node.Column,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	if filePath, ok := v.origins[node]; ok && filePath != v.report.FilePath {
+		issue.File = filePath
+	}
+	v.report.Issues = append(v.report.Issues, issue)
+}
+
+// ==================== Schema ====================
+
+func (v *validator) validateNode(node *yaml.Node, schema *schemaNode, path string) {
+	node = resolveAlias(node)
+	if node.Tag == nullTag {
+		return
+	}
+
+	switch schema.kind {
+	case objectKind, mapKind:
+		if node.Kind == yaml.SequenceNode && len(node.Content) == 0 {
+			return
+		}
+		if node.Kind != yaml.MappingNode {
+			v.addIssue(constant.ErrorSeverity, node, path, "expected a map but got %s", describeNode(node))
+			return
+		}
+		seen := make(map[string]bool)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			key := keyNode.Value
+			childPath := joinPath(path, key)
+			if seen[key] {
+				v.addIssue(constant.ErrorSeverity, keyNode, childPath, "duplicate key %q", key)
+				continue
+			}
+			seen[key] = true
+
+			childSchema := schema.values
+			if schema.kind == objectKind {
+				var ok bool
+				if childSchema, ok = schema.keys[key]; !ok {
+					v.addUnknownKeyIssue(keyNode, childPath, key, schema)
+					continue
+				}
+				if childSchema.kind == unusedKind {
+					v.addIssue(constant.WarningSeverity, keyNode, childPath, "key %q is not used and has no effect", key)
+					continue
+				}
+			}
+			v.validateNode(valueNode, childSchema, childPath)
+		}
+	case listKind:
+		if node.Kind != yaml.SequenceNode {
+			v.addIssue(constant.ErrorSeverity, node, path, "expected a list but got %s", describeNode(node))
+			return
+		}
+		for idx, item := range node.Content {
+			v.validateNode(item, schema.values, fmt.Sprintf("%s[%d]", path, idx))
+		}
+	case scalarKind:
+		if node.Kind != yaml.ScalarNode {
+			v.addIssue(constant.ErrorSeverity, node, path, "expected a value but got %s", describeNode(node))
+		}
+	case intKind:
+		if node.Kind != yaml.ScalarNode || node.Tag != intTag {
+			v.addIssue(constant.ErrorSeverity, node, path, "expected an integer but got %s", describeNode(node))
+		}
+	case boolKind:
+		if node.Kind != yaml.ScalarNode || node.Tag != boolTag {
+			v.addIssue(constant.ErrorSeverity, node, path, "expected a boolean but got %s", describeNode(node))
+		}
+	}
+}
+
+func (v *validator) addUnknownKeyIssue(keyNode *yaml.Node, path string, key string, schema *schemaNode) {
+	if suggestion := getClosestKey(key, schema.keys); suggestion != "" {
+		v.addIssue(constant.ErrorSeverity, keyNode, path, "unknown key %q, did you mean %q?", key, suggestion)
+		return
+	}
+	v.addIssue(constant.ErrorSeverity, keyNode, path, "unknown key %q", key)
+}
+
+func getClosestKey(key string, keys map[string]*schemaNode) string {
+	closestKey, closestDistance := "", maxSuggestionDistance+1
+	for _, candidate := range slices.Sorted(maps.Keys(keys)) {
+		if keys[candidate].kind == unusedKind {
+			continue
+		}
+		if distance := getEditDistance(key, candidate); distance < closestDistance {
+			closestKey, closestDistance = candidate, distance
+		}
+	}
+
+	return closestKey
+}
+
+func getEditDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// ==================== Ports ====================
+
+func (v *validator) validatePorts(root *yaml.Node) {
+	portStartNode := getNode(root, field.Application, entry(field.ApplicationPortStart))
+	portEndNode := getNode(root, field.Application, entry(field.ApplicationPortEnd))
+	portStart, hasPortStart := getInt(portStartNode)
+	portEnd, hasPortEnd := getInt(portEndNode)
+	hasPortRange := hasPortStart && hasPortEnd
+	if hasPortRange && portStart > portEnd {
+		v.addIssue(constant.ErrorSeverity, portEndNode, field.ApplicationPortEnd, "port range end %d is lower than port range start %d", portEnd, portStart)
+		hasPortRange = false
+	}
+
+	usedPorts := make(map[int]string)
+	forEachEntry(getNode(root, field.BackendModules), func(moduleName string, _ *yaml.Node, moduleNode *yaml.Node) {
+		if deployModule, ok := getBool(getNode(moduleNode, field.ModuleDeployModuleEntry)); ok && !deployModule {
+			return
+		}
+		portNode := getNode(moduleNode, field.ModulePortEntry)
+		port, ok := getInt(portNode)
+		if !ok {
+			return
+		}
+
+		path := joinPath(field.BackendModules, moduleName, field.ModulePortEntry)
+		if otherModuleName, exists := usedPorts[port]; exists {
+			v.addIssue(constant.ErrorSeverity, portNode, path, "port %d is already used by backend module %s", port, otherModuleName)
+			return
+		}
+		usedPorts[port] = moduleName
+		if hasPortRange && (port < portStart || port > portEnd) {
+			v.addIssue(constant.WarningSeverity, portNode, path, "port %d is outside the application port range %d-%d", port, portStart, portEnd)
+		}
+	})
+}
+
+// ==================== References ====================
+
+func (v *validator) validateReferences(root *yaml.Node) {
+	consortiums := getKeys(getNode(root, field.Consortiums))
+	tenants := getKeys(getNode(root, field.Tenants))
+	roles := getKeys(getNode(root, field.Roles))
+
+	forEachEntry(getNode(root, field.Tenants), func(tenantName string, _ *yaml.Node, tenantNode *yaml.Node) {
+		v.validateReference(tenantNode, joinPath(field.Tenants, tenantName), field.TenantsConsortiumEntry, constant.ConsortiumCategory, consortiums)
+	})
+	forEachEntry(getNode(root, field.Roles), func(roleName string, _ *yaml.Node, roleNode *yaml.Node) {
+		path := joinPath(field.Roles, roleName)
+		v.validateReference(roleNode, path, field.RolesTenantEntry, constant.TenantCategory, tenants)
+		v.validateReference(roleNode, path, field.RolesConsortiumEntry, constant.ConsortiumCategory, consortiums)
+	})
+	forEachEntry(getNode(root, field.Users), func(username string, _ *yaml.Node, userNode *yaml.Node) {
+		path := joinPath(field.Users, username)
+		v.validateReference(userNode, path, field.UsersTenantEntry, constant.TenantCategory, tenants)
+		v.validateReference(userNode, path, field.UsersConsortiumEntry, constant.ConsortiumCategory, consortiums)
+
+		rolesNode := resolveAlias(getNode(userNode, field.UsersRolesEntry))
+		if rolesNode == nil || rolesNode.Kind != yaml.SequenceNode {
+			return
+		}
+		for idx, roleNode := range rolesNode.Content {
+			if roleNode.Kind == yaml.ScalarNode && !slices.Contains(roles, roleNode.Value) {
+				v.addIssue(constant.ErrorSeverity, roleNode, fmt.Sprintf("%s[%d]", joinPath(path, field.UsersRolesEntry), idx), "unknown %s %q", constant.RoleCategory, roleNode.Value)
+			}
+		}
+	})
+}
+
+func (v *validator) validateReference(parentNode *yaml.Node, path string, key string, category string, names []string) {
+	node := resolveAlias(getNode(parentNode, key))
+	if node == nil || node.Kind != yaml.ScalarNode || node.Tag == nullTag {
+		return
+	}
+	if !slices.Contains(names, node.Value) {
+		v.addIssue(constant.ErrorSeverity, node, joinPath(path, key), "unknown %s %q", category, node.Value)
+	}
+}
+
+// ==================== Nodes ====================
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node != nil && node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	return node
+}
+
+func getNode(node *yaml.Node, keys ...string) *yaml.Node {
+	for _, key := range keys {
+		node = resolveAlias(node)
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+
+		var next *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				next = node.Content[i+1]
+				break
+			}
+		}
+		node = next
+	}
+
+	return resolveAlias(node)
+}
+
+func forEachEntry(node *yaml.Node, fn func(key string, keyNode *yaml.Node, valueNode *yaml.Node)) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fn(node.Content[i].Value, node.Content[i], resolveAlias(node.Content[i+1]))
+	}
+}
+
+func getKeys(node *yaml.Node) (keys []string) {
+	forEachEntry(node, func(key string, _ *yaml.Node, _ *yaml.Node) {
+		keys = append(keys, key)
+	})
+
+	return keys
+}
+
+func getInt(node *yaml.Node) (int, bool) {
+	if node == nil || node.Kind != yaml.ScalarNode || node.Tag != intTag {
+		return 0, false
+	}
+	value, err := strconv.Atoi(node.Value)
+
+	return value, err == nil
+}
+
+func getBool(node *yaml.Node) (bool, bool) {
+	if node == nil || node.Kind != yaml.ScalarNode || node.Tag != boolTag {
+		return false, false
+	}
+	value, err := strconv.ParseBool(node.Value)
+
+	return value, err == nil
+}
+
+func describeNode(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "a map"
+	case yaml.SequenceNode:
+		return "a list"
+	default:
+		return fmt.Sprintf("%s %q", strings.TrimPrefix(node.Tag, "!!"), node.Value)
+	}
+}
+
+func joinPath(parts ...string) string {
+	var nonEmpty []string
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, ".")
+}
@@ -20,6 +20,7 @@ type ConsortiumProcessor interface {
 	ConsortiumManager
 	ConsortiumTenantHandler
 	ConsortiumCentralOrderingManager
+	ConsortiumUserMigrator
 }
 
 // ConsortiumManager defines the interface for consortium management operations
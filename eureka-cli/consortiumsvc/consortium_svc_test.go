@@ -10,6 +10,7 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/consortiumsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/google/uuid"
@@ -1459,6 +1460,122 @@ func TestEnableCentralOrdering_BlankTenantName(t *testing.T) {
 	mockHTTP.AssertNotCalled(t, "PostReturnNoContent", mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestMigrateConsortiumUsers(t *testing.T) {
+	consortiumTenants := models.SortedConsortiumTenants{
+		&models.SortedConsortiumTenant{Name: "ecs", IsCentral: 1},
+		&models.SortedConsortiumTenant{Name: "university1", IsCentral: 0},
+		&models.SortedConsortiumTenant{Name: "university2", IsCentral: 0},
+	}
+	userTenants := func(tenantName string, usernames ...string) func(args mock.Arguments) {
+		return func(args mock.Arguments) {
+			response := args.Get(2).(*models.UserTenantsResponse)
+			for _, username := range usernames {
+				response.UserTenants = append(response.UserTenants, models.UserTenant{Username: username, TenantID: tenantName})
+			}
+		}
+	}
+
+	t.Run("TestMigrateConsortiumUsers_Create", func(t *testing.T) {
+		// Arrange
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		action := testhelpers.NewMockAction()
+		action.KeycloakAccessToken = "test-token"
+		svc := consortiumsvc.New(action, mockHTTP, &MockUserSvc{})
+
+		mockHTTP.On("GetRetryReturnStruct",
+			mock.MatchedBy(func(url string) bool {
+				return strings.HasSuffix(url, "/consortia/consortium-123/user-tenants?tenantId=university1&limit=10000")
+			}),
+			mock.MatchedBy(func(headers map[string]string) bool {
+				return headers[constant.OkapiTenantHeader] == "ecs" && headers[constant.OkapiTokenHeader] == "test-token"
+			}),
+			mock.AnythingOfType("*models.UserTenantsResponse")).
+			Run(userTenants("university1", "user1", "user2")).
+			Return(nil)
+		mockHTTP.On("GetRetryReturnStruct",
+			mock.MatchedBy(func(url string) bool { return strings.Contains(url, "tenantId=university2") }),
+			mock.Anything,
+			mock.AnythingOfType("*models.UserTenantsResponse")).
+			Run(userTenants("university2", "user3")).
+			Return(nil)
+		mockHTTP.On("PostReturnNoContent",
+			mock.MatchedBy(func(url string) bool {
+				return strings.HasSuffix(url, "/consortia/consortium-123/tenants/university1/identity-provider")
+			}),
+			mock.MatchedBy(func(payload []byte) bool {
+				var request models.IdentityProviderCreateRequest
+				return json.Unmarshal(payload, &request) == nil && request.CreateProvider && request.MigrateUsers
+			}),
+			mock.Anything).
+			Return(nil)
+		mockHTTP.On("PostReturnNoContent",
+			mock.MatchedBy(func(url string) bool { return strings.Contains(url, "/tenants/university2/") }),
+			mock.Anything,
+			mock.Anything).
+			Return(errors.New("internal server error"))
+
+		// Act
+		results := svc.MigrateConsortiumUsers("ecs", "consortium-123", consortiumTenants, false)
+
+		// Assert
+		assert.Equal(t, []models.ConsortiumUserMigration{
+			{Tenant: "university1", Users: helpers.IntPtr(2), Status: constant.UserMigrated},
+			{Tenant: "university2", Users: helpers.IntPtr(1), Status: constant.UserMigrationFailed, Error: "internal server error"},
+		}, results)
+		mockHTTP.AssertExpectations(t)
+		mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.MatchedBy(func(url string) bool { return strings.Contains(url, "tenantId=ecs") }), mock.Anything, mock.Anything)
+	})
+
+	t.Run("TestMigrateConsortiumUsers_Delete", func(t *testing.T) {
+		// Arrange
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		action := testhelpers.NewMockAction()
+		action.KeycloakAccessToken = "test-token"
+		svc := consortiumsvc.New(action, mockHTTP, &MockUserSvc{})
+
+		mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.AnythingOfType("*models.UserTenantsResponse")).Return(nil)
+		mockHTTP.On("Delete",
+			mock.MatchedBy(func(url string) bool {
+				return strings.HasSuffix(url, "/consortia/consortium-123/tenants/university1/identity-provider")
+			}),
+			mock.Anything).
+			Return(nil)
+
+		// Act
+		results := svc.MigrateConsortiumUsers("ecs", "consortium-123", consortiumTenants[:2], true)
+
+		// Assert
+		assert.Equal(t, []models.ConsortiumUserMigration{{Tenant: "university1", Users: helpers.IntPtr(0), Status: constant.UserDeleted}}, results)
+		mockHTTP.AssertExpectations(t)
+	})
+
+	t.Run("TestMigrateConsortiumUsers_UserTenantsError", func(t *testing.T) {
+		// Arrange
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		action := testhelpers.NewMockAction()
+		action.KeycloakAccessToken = "test-token"
+		svc := consortiumsvc.New(action, mockHTTP, &MockUserSvc{})
+
+		mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("forbidden"))
+		mockHTTP.On("PostReturnNoContent",
+			mock.MatchedBy(func(url string) bool {
+				return strings.HasSuffix(url, "/consortia/consortium-123/tenants/university1/identity-provider")
+			}),
+			mock.Anything,
+			mock.Anything).
+			Return(nil)
+
+		// Act
+		results := svc.MigrateConsortiumUsers("ecs", "consortium-123", consortiumTenants[:2], false)
+
+		// Assert
+		assert.Equal(t, []models.ConsortiumUserMigration{
+			{Tenant: "university1", Status: constant.UserMigrated},
+		}, results)
+		mockHTTP.AssertExpectations(t)
+	})
+}
+
 func TestConsortiumTenantString(t *testing.T) {
 	// Arrange & Act
 	central := models.SortedConsortiumTenant{
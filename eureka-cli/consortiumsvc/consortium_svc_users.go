@@ -0,0 +1,89 @@
+package consortiumsvc
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// ConsortiumUserMigrator defines the interface for consortium user migration operations
+type ConsortiumUserMigrator interface {
+	MigrateConsortiumUsers(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, deleteUsers bool) []models.ConsortiumUserMigration
+}
+
+// MigrateConsortiumUsers creates the identity provider of every member tenant in the central tenant realm and migrates the member
+// tenant users to it, or deletes both when deleteUsers is set, every member tenant is reported once and a failed member tenant
+// does not stop the migration of the other member tenants
+func (cs *ConsortiumSvc) MigrateConsortiumUsers(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, deleteUsers bool) []models.ConsortiumUserMigration {
+	var results []models.ConsortiumUserMigration
+	for _, consortiumTenant := range consortiumTenants {
+		if consortiumTenant.IsCentral == 1 {
+			continue
+		}
+		results = append(results, cs.migrateTenantUsers(centralTenant, consortiumID, consortiumTenant.Name, deleteUsers))
+	}
+
+	return results
+}
+
+// migrateTenantUsers migrates the users of a member tenant with a single identity provider call, the user count
+// is informational only so a failure to list the users does not prevent the migration
+func (cs *ConsortiumSvc) migrateTenantUsers(centralTenant string, consortiumID string, tenantName string, deleteUsers bool) models.ConsortiumUserMigration {
+	result := models.ConsortiumUserMigration{Tenant: tenantName, Status: constant.UserMigrated}
+	if deleteUsers {
+		result.Status = constant.UserDeleted
+	}
+
+	userTenants, err := cs.getUserTenants(centralTenant, consortiumID, tenantName)
+	if err != nil {
+		slog.Warn(cs.Action.Name, "text", "Consortium users could not be listed", "tenant", tenantName, "error", err)
+	} else {
+		result.Users = helpers.IntPtr(len(userTenants))
+	}
+
+	if err := cs.migrateIdentityProvider(centralTenant, consortiumID, tenantName, deleteUsers); err != nil {
+		slog.Warn(cs.Action.Name, "text", "Consortium user migration failed", "tenant", tenantName, "error", err)
+		result.Status, result.Error = constant.UserMigrationFailed, err.Error()
+	}
+
+	return result
+}
+
+func (cs *ConsortiumSvc) getUserTenants(centralTenant string, consortiumID string, tenantName string) ([]models.UserTenant, error) {
+	requestURL := cs.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/consortia/%s/user-tenants?tenantId=%s&limit=10000", consortiumID, tenantName))
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedResponse models.UserTenantsResponse
+	if err := cs.HTTPClient.GetRetryReturnStruct(requestURL, headers, &decodedResponse); err != nil {
+		return nil, err
+	}
+
+	return decodedResponse.UserTenants, nil
+}
+
+func (cs *ConsortiumSvc) migrateIdentityProvider(centralTenant string, consortiumID string, tenantName string, deleteUsers bool) error {
+	requestURL := cs.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/consortia/%s/tenants/%s/identity-provider", consortiumID, tenantName))
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(centralTenant, cs.Action.KeycloakAccessToken)
+	if err != nil {
+		return err
+	}
+	if deleteUsers {
+		slog.Info(cs.Action.Name, "text", "Deleting consortium users", "tenant", tenantName, "consortium", consortiumID)
+		return cs.HTTPClient.Delete(requestURL, headers)
+	}
+
+	payload, err := json.Marshal(models.IdentityProviderCreateRequest{CreateProvider: true, MigrateUsers: true})
+	if err != nil {
+		return err
+	}
+	slog.Info(cs.Action.Name, "text", "Migrating consortium users", "tenant", tenantName, "consortium", consortiumID)
+
+	return cs.HTTPClient.PostReturnNoContent(requestURL, payload, headers)
+}
@@ -5,8 +5,7 @@ import "time"
 const (
 	// Command wait durations
 	DeployApplicationPartitionWait    = 15 * time.Second
-	DeploySystemWait                  = 15 * time.Second
-	DeployAdditionalSystemWait        = 15 * time.Second
+	SystemReadinessWait               = 2 * time.Second
 	DeployManagementWait              = 5 * time.Second
 	DeployModulesWait                 = 5 * time.Second
 	ModuleReadinessWait               = 10 * time.Second
@@ -16,6 +15,9 @@ const (
 	AttachCapabilitySetsTimeoutWait   = 30 * time.Second
 	ConsortiumTenantStatusWait        = 10 * time.Second
 
+	// Readiness deadlines
+	SystemReadinessTimeout = 5 * time.Minute
+
 	// Readiness retries
 	ModuleReadinessMaxRetries     = 70
 	KongRouteReadinessMaxRetries  = 30
@@ -30,12 +32,19 @@ const (
 	ContextTimeoutDockerUndeploy     = 1 * time.Minute
 	ContextTimeoutVaultClient        = 30 * time.Second
 	ContextTimeoutVaultContainerLogs = 30 * time.Second
+	ContextTimeoutSystemProbe        = 15 * time.Second
 	ContextTimeoutAWSConfig          = 30 * time.Second
+	ContextTimeoutDatabaseSnapshot   = 30 * time.Minute
 
 	// HTTP client timeouts
 	HTTPClientPingTimeout = 15 * time.Second
 	HTTPClientTimeout     = 10 * time.Minute
 
+	// Kafka client timeouts
+	KafkaClientDialTimeout    = 10 * time.Second
+	KafkaClientRequestTimeout = 30 * time.Second
+	KafkaClientFetchMaxWait   = 500 * time.Millisecond
+
 	// Custom HTTP client transport settings
 	HTTPClientDialTimeout           = 30 * time.Second
 	HTTPClientKeepAlive             = 30 * time.Second
@@ -55,8 +64,10 @@ const (
 	HTTPClientPingResponseHeaderTimeout = 5 * time.Second
 
 	// Docker log properties
-	DockerLogHeaderSize = 8
-	DockerLogSizeOffset = 4
+	DockerLogHeaderSize   = 8
+	DockerLogSizeOffset   = 4
+	DockerLogStderrStream = 2
+	DockerLogFlushEvery   = 250 * time.Millisecond
 
 	// Retry HTTP client properties
 	RetryHTTPClientRetryMax     = 5
@@ -103,6 +114,9 @@ const (
 	SidecarMemory            = 450
 	SidecarSwap              = -1
 
+	// Module deployment concurrency
+	DefaultParallelism = 4
+
 	// Charset for key generation
 	Charset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 
@@ -126,12 +140,66 @@ const (
 	LogDir             = "logs"
 	LogTimestampFormat = "20060102-150405"
 
+	// Journals
+	JournalDir = "journal"
+
+	// Token cache
+	TokenCacheFile         = "token-cache.json"
+	TokenCacheExpiryMargin = 30 * time.Second
+	VaultRootTokenGrant    = "root"
+
+	// Lock files
+	LockFilePattern       = "eureka.%s.lock"
+	LockApplicationEntry  = "application"
+	LockSidecarImageEntry = "sidecar"
+	LockChangeAdded       = "added"
+	LockChangeRemoved     = "removed"
+	LockChangeUpdated     = "updated"
+	LockChangeRebuilt     = "rebuilt"
+	ShortDigestLength     = 19
+
+	// Port proxies
+	PortProxyDir              = "port-proxies"
+	PortProxyPidFileExtension = ".pid.json"
+	PortProxyStartTimeout     = 5 * time.Second
+	PortProxyDialTimeout      = 10 * time.Second
+	PortProxyPollInterval     = 100 * time.Millisecond
+
+	// Registry snapshots
+	RegistrySnapshotDir          = "registry-snapshots"
+	RegistrySnapshotManifestFile = "manifest.json"
+	RegistrySnapshotPlatformFile = "platform-descriptor.json"
+	RegistrySnapshotFarDir       = "applications"
+	RegistrySnapshotModulesDir   = "modules"
+
+	// Environment snapshots
+	EnvironmentSnapshotDir          = "snapshots"
+	EnvironmentSnapshotManifestFile = "manifest.json"
+	EnvironmentSnapshotDatabaseFile = "postgres.sql.gz"
+	EnvironmentSnapshotSecretsFile  = "vault-secrets.json"
+	EnvironmentSnapshotNamePattern  = `^[A-Za-z0-9][A-Za-z0-9._-]*$`
+
+	// Tenant data archives
+	TenantDataManifestFile  = "manifest.json"
+	TenantDataSchemasFile   = "schemas.sql"
+	TenantDataSchemaInfix   = "_mod_"
+	TenantDataSchemaPattern = `^[a-z0-9_]+$`
+	TenantNamePattern       = `^[a-z][a-z0-9_]*$`
+
+	// Dry run properties
+	DryRunPrefix      = "[DRY RUN]"
+	DryRunToken       = "dry-run-token"
+	DryRunContainerID = "dry-run"
+
 	// Module registries
 	FolioRegistry  = "folio"
 	EurekaRegistry = "eureka"
 
 	// Docker compose properties
-	DockerComposeWorkDir = "./misc"
+	DockerComposeWorkDir    = "./misc"
+	DockerComposeProject    = "eureka"
+	DockerComposeLabelKey   = "com.docker.compose.project"
+	DockerComposeServiceKey = "com.docker.compose.service"
 
 	// Container network properties
 	NetworkID         = "eureka"
@@ -157,7 +225,6 @@ const (
 	VaultRootTokenPattern = "init.sh: Root VAULT TOKEN is:"
 	ColonDelimitedPattern = ".*:"
 	ModuleIDPattern       = `^([a-z_-]+)([\d_.-]+)([-\w.]+)$`
-	NewLinePattern        = `[\r\n\s-]+`
 	ProtocolPattern       = `^[a-zA-Z]+://`
 
 	// System containers name
@@ -181,6 +248,10 @@ const (
 	KeycloakMasterRealm                    = "master"
 	KeycloakMasterRealmAccessTokenLifespan = 3600
 	KeycloakTenantRealmAccessTokenLifespan = 3600
+	KeycloakMaskedSecret                   = "**********"
+	KeycloakUsersPageSize                  = 100
+	KeycloakImportSkip                     = "SKIP"
+	KeycloakImportOverwrite                = "OVERWRITE"
 
 	// System container ports
 	KongPort        = "8000"
@@ -202,9 +273,18 @@ const (
 
 	// Kafka consumer group properties
 	ConsumerGroupSuffix = "mod-roles-keycloak-capability-group"
-	ErrNoActiveMembers  = "Consumer group 'folio-mod-roles-keycloak-capability-group' has no active members."
-	ErrRebalancing      = "Consumer group 'folio-mod-roles-keycloak-capability-group' is rebalancing."
-	ErrTimeoutException = "TimeoutException"
+
+	// Kafka client properties
+	KafkaClientID                      = "eureka-cli"
+	KafkaClientFetchMaxBytes           = 50 * 1024 * 1024
+	KafkaClientFetchPartitionMaxBytes  = 1024 * 1024
+	KafkaOffsetLatest                  = -1
+	KafkaOffsetEarliest                = -2
+	KafkaGroupStateEmpty               = "Empty"
+	KafkaGroupStateDead                = "Dead"
+	KafkaGroupStateStable              = "Stable"
+	KafkaGroupStatePreparingRebalance  = "PreparingRebalance"
+	KafkaGroupStateCompletingRebalance = "CompletingRebalance"
 )
 
 // ==================== Container Types ====================
@@ -243,6 +323,84 @@ const (
 	Password          = "password"
 )
 
+// ==================== Resource Categories ====================
+
+const (
+	SystemCategory      = "system"
+	ModuleCategory      = "module"
+	SidecarCategory     = "sidecar"
+	RouteCategory       = "route"
+	ApplicationCategory = "application"
+	TenantCategory      = "tenant"
+	EntitlementCategory = "entitlement"
+	RoleCategory        = "role"
+	UserCategory        = "user"
+	ConsortiumCategory  = "consortium"
+)
+
+// ==================== Apply Operations ====================
+
+const (
+	DeployOperation    = "deploy"
+	RedeployOperation  = "redeploy"
+	UpdateOperation    = "update"
+	CreateOperation    = "create"
+	EntitleOperation   = "entitle"
+	ReentitleOperation = "reentitle"
+)
+
+// ==================== Output Formats ====================
+
+const (
+	TableOutput = "table"
+	JSONOutput  = "json"
+)
+
+func GetOutputFormats() []string {
+	return []string{TableOutput, JSONOutput}
+}
+
+// ==================== Validation Severities ====================
+
+const (
+	ErrorSeverity   = "error"
+	WarningSeverity = "warning"
+)
+
+// ==================== Journal Statuses ====================
+
+type JournalStatus string
+
+const (
+	Started   JournalStatus = "started"
+	Succeeded JournalStatus = "succeeded"
+	Failed    JournalStatus = "failed"
+)
+
+// ==================== Consortium User Migration Statuses ====================
+
+const (
+	UserMigrated        = "migrated"
+	UserDeleted         = "deleted"
+	UserMigrationFailed = "failed"
+)
+
+// ==================== Log Levels ====================
+
+const (
+	TraceLevel = "TRACE"
+	DebugLevel = "DEBUG"
+	InfoLevel  = "INFO"
+	WarnLevel  = "WARN"
+	ErrorLevel = "ERROR"
+	FatalLevel = "FATAL"
+)
+
+// GetLogLevels returns the module log levels ordered by increasing severity
+func GetLogLevels() []string {
+	return []string{TraceLevel, DebugLevel, InfoLevel, WarnLevel, ErrorLevel, FatalLevel}
+}
+
 // ==================== Token Types ====================
 
 const (
@@ -274,7 +432,6 @@ func GetInitialRequiredContainers() []string {
 		DozzleContainer,
 		PostgreSQLContainer,
 		KafkaContainer,
-		KafkaToolsContainer,
 		VaultContainer,
 		KeycloakProxyContainer,
 		KeycloakContainer,
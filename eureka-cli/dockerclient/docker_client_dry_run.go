@@ -0,0 +1,133 @@
+package dockerclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/execsvc"
+)
+
+var apiVersionPrefix = regexp.MustCompile(`^/v[0-9.]+`)
+
+// DryRunDockerClient implements DockerClientRunner with a Docker client that reads the live state
+// of the Docker daemon but prints the planned container, image and network changes instead of applying them
+type DryRunDockerClient struct {
+	*DockerClient
+	Writer io.Writer
+}
+
+// NewDryRun creates a new DryRunDockerClient instance
+func NewDryRun(action *action.Action, execSvc execsvc.CommandRunner, writer io.Writer) *DryRunDockerClient {
+	return &DryRunDockerClient{DockerClient: New(action, execSvc), Writer: writer}
+}
+
+func (dc *DryRunDockerClient) Create() (*client.Client, error) {
+	baseClient, err := dc.DockerClient.Create()
+	if err != nil {
+		return nil, err
+	}
+	defer dc.DockerClient.Close(baseClient)
+
+	httpClient := baseClient.HTTPClient()
+	httpClient.Transport = &DryRunTransport{Next: httpClient.Transport, Writer: dc.Writer}
+
+	return client.NewClientWithOpts(client.FromEnv, client.WithHTTPClient(httpClient), client.WithVersion(baseClient.ClientVersion()))
+}
+
+// DryRunTransport forwards read-only Docker API requests to the daemon
+// and records every other request, answering it with an empty successful response
+type DryRunTransport struct {
+	Next   http.RoundTripper
+	Writer io.Writer
+}
+
+func (t *DryRunTransport) RoundTrip(httpRequest *http.Request) (*http.Response, error) {
+	if httpRequest.Method == http.MethodGet || httpRequest.Method == http.MethodHead {
+		return t.Next.RoundTrip(httpRequest)
+	}
+
+	path := apiVersionPrefix.ReplaceAllString(httpRequest.URL.Path, "")
+	switch {
+	case path == "/containers/create":
+		return t.recordContainerCreate(httpRequest)
+	case path == "/images/create":
+		query := httpRequest.URL.Query()
+		image := query.Get("fromImage")
+		if tag := query.Get("tag"); tag != "" {
+			image = fmt.Sprintf("%s:%s", image, tag)
+		}
+		_, _ = fmt.Fprintf(t.Writer, "%s PULL IMAGE %s\n", constant.DryRunPrefix, image)
+	default:
+		_, _ = fmt.Fprintf(t.Writer, "%s DOCKER %s %s\n", constant.DryRunPrefix, httpRequest.Method, path)
+	}
+
+	return newDryRunResponse(httpRequest, http.StatusNoContent, nil), nil
+}
+
+func (t *DryRunTransport) recordContainerCreate(httpRequest *http.Request) (*http.Response, error) {
+	var createRequest container.CreateRequest
+	if httpRequest.Body != nil {
+		if err := json.NewDecoder(httpRequest.Body).Decode(&createRequest); err != nil {
+			return nil, err
+		}
+	}
+
+	containerName := httpRequest.URL.Query().Get("name")
+	if containerName == "" {
+		containerName = constant.DryRunContainerID
+	}
+
+	_, _ = fmt.Fprintf(t.Writer, "%s CREATE CONTAINER %s\n", constant.DryRunPrefix, containerName)
+	if createRequest.Config != nil {
+		_, _ = fmt.Fprintf(t.Writer, "  image: %s\n", createRequest.Image)
+		for _, env := range createRequest.Env {
+			_, _ = fmt.Fprintf(t.Writer, "  env: %s\n", env)
+		}
+	}
+	if createRequest.HostConfig != nil {
+		for _, port := range slices.Sorted(maps.Keys(createRequest.HostConfig.PortBindings)) {
+			for _, binding := range createRequest.HostConfig.PortBindings[port] {
+				_, _ = fmt.Fprintf(t.Writer, "  port: %s->%s\n", binding.HostPort, port)
+			}
+		}
+		for _, bind := range createRequest.HostConfig.Binds {
+			_, _ = fmt.Fprintf(t.Writer, "  bind: %s\n", bind)
+		}
+	}
+
+	body, err := json.Marshal(container.CreateResponse{ID: containerName, Warnings: []string{}})
+	if err != nil {
+		return nil, err
+	}
+
+	return newDryRunResponse(httpRequest, http.StatusCreated, body), nil
+}
+
+func newDryRunResponse(httpRequest *http.Request, statusCode int, body []byte) *http.Response {
+	header := http.Header{}
+	if body != nil {
+		header.Set(constant.ContentTypeHeader, constant.ApplicationJSON)
+	}
+
+	return &http.Response{
+		StatusCode:    statusCode,
+		Status:        http.StatusText(statusCode),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       httpRequest,
+	}
+}
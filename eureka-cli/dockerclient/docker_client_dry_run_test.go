@@ -0,0 +1,113 @@
+package dockerclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(httpRequest *http.Request) (*http.Response, error) {
+	return f(httpRequest)
+}
+
+func TestNewDryRun(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	mockExec := new(testhelpers.MockCommandExecutor)
+	var buf bytes.Buffer
+
+	// Act
+	client := NewDryRun(action, mockExec, &buf)
+
+	// Assert
+	assert.NotNil(t, client)
+	assert.Equal(t, action, client.Action)
+	assert.Equal(t, mockExec, client.ExecSvc)
+	assert.Equal(t, &buf, client.Writer)
+}
+
+func TestDryRunTransport_ForwardsReads(t *testing.T) {
+	// Arrange
+	forwarded := false
+	var buf bytes.Buffer
+	transport := &DryRunTransport{Writer: &buf, Next: roundTripFunc(func(httpRequest *http.Request) (*http.Response, error) {
+		forwarded = true
+		return newDryRunResponse(httpRequest, http.StatusOK, []byte("[]")), nil
+	})}
+	httpRequest, _ := http.NewRequest(http.MethodGet, "http://docker/v1.47/containers/json", nil)
+
+	// Act
+	httpResponse, err := transport.RoundTrip(httpRequest)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, forwarded)
+	assert.Equal(t, http.StatusOK, httpResponse.StatusCode)
+	assert.Empty(t, buf.String())
+}
+
+func TestDryRunTransport_RecordsContainerCreate(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	transport := &DryRunTransport{Writer: &buf}
+	payload, _ := json.Marshal(container.CreateRequest{
+		Config: &container.Config{Image: "folioorg/mod-orders:13.1.0", Env: []string{"JAVA_OPTIONS=-Xmx256m"}},
+		HostConfig: &container.HostConfig{
+			PortBindings: nat.PortMap{"8081/tcp": []nat.PortBinding{{HostPort: "36002"}}},
+			Binds:        []string{"/tmp/data:/data"},
+		},
+	})
+	httpRequest, _ := http.NewRequest(http.MethodPost, "http://docker/v1.47/containers/create?name=eureka-combined-mod-orders", bytes.NewReader(payload))
+
+	// Act
+	httpResponse, err := transport.RoundTrip(httpRequest)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, httpResponse.StatusCode)
+	var createResponse container.CreateResponse
+	assert.NoError(t, json.NewDecoder(httpResponse.Body).Decode(&createResponse))
+	assert.Equal(t, "eureka-combined-mod-orders", createResponse.ID)
+	output := buf.String()
+	assert.Contains(t, output, constant.DryRunPrefix+" CREATE CONTAINER eureka-combined-mod-orders")
+	assert.Contains(t, output, "image: folioorg/mod-orders:13.1.0")
+	assert.Contains(t, output, "env: JAVA_OPTIONS=-Xmx256m")
+	assert.Contains(t, output, "port: 36002->8081/tcp")
+	assert.Contains(t, output, "bind: /tmp/data:/data")
+}
+
+func TestDryRunTransport_RecordsImagePullAndOtherRequests(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	transport := &DryRunTransport{Writer: &buf}
+	pullRequest, _ := http.NewRequest(http.MethodPost, "http://docker/v1.47/images/create?fromImage=folioorg/mod-orders&tag=13.1.0", nil)
+	removeRequest, _ := http.NewRequest(http.MethodDelete, "http://docker/v1.47/containers/abc", nil)
+
+	// Act
+	pullResponse, pullErr := transport.RoundTrip(pullRequest)
+	removeResponse, removeErr := transport.RoundTrip(removeRequest)
+
+	// Assert
+	assert.NoError(t, pullErr)
+	assert.NoError(t, removeErr)
+	assert.Equal(t, http.StatusNoContent, pullResponse.StatusCode)
+	assert.Equal(t, http.StatusNoContent, removeResponse.StatusCode)
+	body, _ := io.ReadAll(pullResponse.Body)
+	assert.Empty(t, body)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, []string{
+		constant.DryRunPrefix + " PULL IMAGE folioorg/mod-orders:13.1.0",
+		constant.DryRunPrefix + " DOCKER DELETE /containers/abc",
+	}, lines)
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 )
 
 // FlagReader interface allows us to accept flag structs without importing the flags package
@@ -64,6 +66,10 @@ func RequiredParameterMissing(param string) error {
 	return fmt.Errorf("%w: %s parameter required", ErrInvalidInput, param)
 }
 
+func InvalidJSONPath(expression, reason string) error {
+	return fmt.Errorf("%w: JSONPath expression %s: %s", ErrInvalidInput, expression, reason)
+}
+
 func AccessTokenBlank() error {
 	return ErrAccessTokenBlank
 }
@@ -161,12 +167,38 @@ func ECRInvalidTokenFormat() error {
 	return fmt.Errorf("%w: invalid ECR authorization token format", ErrUnauthorized)
 }
 
+// ==================== Config Errors ====================
+
+func ConfigParseFailed(filePath string, err error) error {
+	return fmt.Errorf("failed to parse config file %s: %w", filePath, err)
+}
+
+func ConfigInvalid(filePath string, errorCount int) error {
+	return fmt.Errorf("%w: config file %s has %d error(s)", ErrInvalidInput, filePath, errorCount)
+}
+
+func ProfileNotFound(profile string, profiles []string) error {
+	return fmt.Errorf("%w: profile %s not found, available profiles: %s", ErrNotFound, profile, strings.Join(profiles, ", "))
+}
+
+func ConfigExtendsCycle(profiles []string) error {
+	return fmt.Errorf("%w: profile extends cycle detected: %s", ErrInvalidInput, strings.Join(profiles, " -> "))
+}
+
 // ==================== Consortium Errors ====================
 
 func ConsortiumMissingCentralTenant(consortiumName string) error {
 	return fmt.Errorf("%w: consortium %s does not contain a central tenant", ErrConfigMissing, consortiumName)
 }
 
+func ConsortiumNotFound(consortiumName string) error {
+	return fmt.Errorf("%w: consortium %s", ErrNotFound, consortiumName)
+}
+
+func ConsortiumUserMigrationFailed(failed, total int) error {
+	return fmt.Errorf("%w: %d of %d consortium member tenant user migrations failed", ErrDeploymentFailed, failed, total)
+}
+
 // ==================== File Errors ====================
 
 func NotRegularFile(fileName string) error {
@@ -179,6 +211,38 @@ func CloneFailed(repoLabel string, err error) error {
 	return fmt.Errorf("failed to clone repository %s: %w", repoLabel, err)
 }
 
+// ==================== Journal Errors ====================
+
+func JournalReadFailed(filePath string, err error) error {
+	return fmt.Errorf("failed to read journal %s: %w", filePath, err)
+}
+
+func JournalWriteFailed(filePath string, err error) error {
+	return fmt.Errorf("failed to write journal %s: %w", filePath, err)
+}
+
+// ==================== Lock Errors ====================
+
+func LockNotFound(filePath string) error {
+	return fmt.Errorf("%w: lock file %s, deploy without --locked or run the updateLock command to create it", ErrNotFound, filePath)
+}
+
+func LockReadFailed(filePath string, err error) error {
+	return fmt.Errorf("failed to read lock file %s: %w", filePath, err)
+}
+
+func LockWriteFailed(filePath string, err error) error {
+	return fmt.Errorf("failed to write lock file %s: %w", filePath, err)
+}
+
+func LockApplicationMismatch(filePath string, lockApplicationID string, configApplicationID string) error {
+	return fmt.Errorf("%w: lock file %s was created for application %s but the config uses %s, run the updateLock command", ErrInvalidInput, filePath, lockApplicationID, configApplicationID)
+}
+
+func LockImageDigestNotFound(imageName string, err error) error {
+	return fmt.Errorf("%w: digest of image %s: %w", ErrNotFound, imageName, err)
+}
+
 // ==================== Kafka Errors ====================
 
 func KafkaNotReady(err error) error {
@@ -197,6 +261,112 @@ func ConsumerGroupPollTimeout(consumerGroup string, maxRetries int) error {
 	return fmt.Errorf("%w: consumer group %s polling exceeded maximum retries (%d)", ErrTimeout, consumerGroup, maxRetries)
 }
 
+func KafkaSharedTopicsPurgeRefused(topicTenant string) error {
+	return fmt.Errorf("%w: topics are shared by all tenants as %s, purging them removes the messages of every tenant, pass --force to purge them", ErrInvalidInput, topicTenant)
+}
+
+// KafkaError represents an error code returned by a Kafka broker for a protocol request
+type KafkaError struct {
+	API  string
+	Code int16
+}
+
+func (e *KafkaError) Error() string {
+	name, ok := kafkaErrorNames[e.Code]
+	if !ok {
+		name = "UNKNOWN_SERVER_ERROR"
+	}
+	return fmt.Sprintf("kafka %s request failed with error code %d (%s)", e.API, e.Code, name)
+}
+
+// Is allows errors.Is to match KafkaError instances with the same error code
+func (e *KafkaError) Is(target error) bool {
+	if t, ok := target.(*KafkaError); ok {
+		return e.Code == t.Code
+	}
+	return false
+}
+
+// Retriable reports whether the request may succeed once the broker, the partition leader or the group coordinator settles
+func (e *KafkaError) Retriable() bool {
+	switch e.Code {
+	case KafkaLeaderNotAvailable, KafkaNotLeaderOrFollower, KafkaRequestTimedOut,
+		KafkaCoordinatorLoadInProgress, KafkaCoordinatorNotAvailable, KafkaNotCoordinator, KafkaRebalanceInProgress:
+		return true
+	default:
+		return false
+	}
+}
+
+// Kafka protocol error codes handled by the CLI
+const (
+	KafkaOffsetOutOfRange          int16 = 1
+	KafkaCorruptMessage            int16 = 2
+	KafkaUnknownTopicOrPartition   int16 = 3
+	KafkaLeaderNotAvailable        int16 = 5
+	KafkaNotLeaderOrFollower       int16 = 6
+	KafkaRequestTimedOut           int16 = 7
+	KafkaMessageTooLarge           int16 = 10
+	KafkaCoordinatorLoadInProgress int16 = 14
+	KafkaCoordinatorNotAvailable   int16 = 15
+	KafkaNotCoordinator            int16 = 16
+	KafkaIllegalGeneration         int16 = 22
+	KafkaUnknownMemberID           int16 = 25
+	KafkaRebalanceInProgress       int16 = 27
+	KafkaTopicAuthorizationFailed  int16 = 29
+	KafkaGroupAuthorizationFailed  int16 = 30
+	KafkaUnsupportedVersion        int16 = 35
+	KafkaInvalidRequest            int16 = 42
+	KafkaNonEmptyGroup             int16 = 68
+	KafkaGroupIDNotFound           int16 = 69
+)
+
+var kafkaErrorNames = map[int16]string{
+	KafkaOffsetOutOfRange:          "OFFSET_OUT_OF_RANGE",
+	KafkaCorruptMessage:            "CORRUPT_MESSAGE",
+	KafkaUnknownTopicOrPartition:   "UNKNOWN_TOPIC_OR_PARTITION",
+	KafkaLeaderNotAvailable:        "LEADER_NOT_AVAILABLE",
+	KafkaNotLeaderOrFollower:       "NOT_LEADER_OR_FOLLOWER",
+	KafkaRequestTimedOut:           "REQUEST_TIMED_OUT",
+	KafkaMessageTooLarge:           "MESSAGE_TOO_LARGE",
+	KafkaCoordinatorLoadInProgress: "COORDINATOR_LOAD_IN_PROGRESS",
+	KafkaCoordinatorNotAvailable:   "COORDINATOR_NOT_AVAILABLE",
+	KafkaNotCoordinator:            "NOT_COORDINATOR",
+	KafkaIllegalGeneration:         "ILLEGAL_GENERATION",
+	KafkaUnknownMemberID:           "UNKNOWN_MEMBER_ID",
+	KafkaRebalanceInProgress:       "REBALANCE_IN_PROGRESS",
+	KafkaTopicAuthorizationFailed:  "TOPIC_AUTHORIZATION_FAILED",
+	KafkaGroupAuthorizationFailed:  "GROUP_AUTHORIZATION_FAILED",
+	KafkaUnsupportedVersion:        "UNSUPPORTED_VERSION",
+	KafkaInvalidRequest:            "INVALID_REQUEST",
+	KafkaNonEmptyGroup:             "NON_EMPTY_GROUP",
+	KafkaGroupIDNotFound:           "GROUP_ID_NOT_FOUND",
+}
+
+func KafkaRequestFailed(api string, code int16) error {
+	return &KafkaError{API: api, Code: code}
+}
+
+func KafkaTopicNotFound(topic string) error {
+	return fmt.Errorf("%w: kafka topic %s", ErrNotFound, topic)
+}
+
+func KafkaMessageNotJSON(fileName string) error {
+	return fmt.Errorf("%w: message file %s does not contain valid JSON", ErrInvalidInput, fileName)
+}
+
+func KafkaMessageHeaderInvalid(header string) error {
+	return fmt.Errorf("%w: message header %s is not in the name=value form", ErrInvalidInput, header)
+}
+
+func ConsumerGroupNotFound(consumerGroup string) error {
+	return fmt.Errorf("%w: consumer group %s", ErrNotFound, consumerGroup)
+}
+
+func ConsumerGroupActive(consumerGroup, state string, members int) error {
+	return fmt.Errorf("%w: consumer group %s is %s with %d active members, stop its consumers first", ErrInvalidInput, consumerGroup, state, members)
+}
+
 func ContainerCommandFailed(stderr string) error {
 	return fmt.Errorf("failed to execute container command, stderr: %s", stderr)
 }
@@ -219,6 +389,18 @@ func UserNotFound(username, tenantName string) error {
 	return fmt.Errorf("%w: user %s in tenant %s", ErrNotFound, username, tenantName)
 }
 
+func ConfigUserNotFound(username string) error {
+	return fmt.Errorf("%w: user %s in the users section of the config", ErrNotFound, username)
+}
+
+func AccessTokenMalformed(reason string) error {
+	return fmt.Errorf("%w: access token %s", ErrInvalidInput, reason)
+}
+
+func RealmMismatch(realmName, tenantName string) error {
+	return fmt.Errorf("%w: realm export of %s cannot be imported into tenant %s, the client and role names contain the realm name", ErrInvalidInput, realmName, tenantName)
+}
+
 // ==================== Kong Errors ====================
 
 func KongRoutesNotReady(expected int) error {
@@ -229,6 +411,14 @@ func KongAdminAPIFailed(statusCode int, status string) error {
 	return fmt.Errorf("kong admin API failed: %d %s", statusCode, status)
 }
 
+func KongRouteExpressionInvalid(expression string, position int, reason string) error {
+	return fmt.Errorf("%w: kong route expression %q at position %d: %s", ErrInvalidInput, expression, position, reason)
+}
+
+func KongRouteNotFound(method, path string) error {
+	return fmt.Errorf("%w: kong route matching %s %s", ErrNotFound, method, path)
+}
+
 // ==================== Application Errors ====================
 
 func ApplicationNotFound(applicationName string) error {
@@ -253,6 +443,14 @@ func SidecarDeployFailed(sidecarName string, err error) error {
 	return fmt.Errorf("%w: failed to deploy sidecar %s: %w", ErrDeploymentFailed, sidecarName, err)
 }
 
+func ModuleDependencyFailed(moduleName, dependencyName string) error {
+	return fmt.Errorf("%w: skipped module %s because its dependency %s failed", ErrDeploymentFailed, moduleName, dependencyName)
+}
+
+func SidecarPortNotFound(moduleName string) error {
+	return fmt.Errorf("%w: published server port of the sidecar of module %s", ErrNotFound, moduleName)
+}
+
 func SidecarVersionNotFound() error {
 	return fmt.Errorf("%w: sidecar version in registry", ErrNotFound)
 }
@@ -293,6 +491,53 @@ func ModulePathNotDirectory(modulePath string) error {
 	return fmt.Errorf("module path is not a directory: %s", modulePath)
 }
 
+// ==================== Log Errors ====================
+
+func LogContainersNotFound(moduleNames []string) error {
+	if len(moduleNames) == 0 {
+		return fmt.Errorf("%w: no deployed module containers", ErrNotFound)
+	}
+	return fmt.Errorf("%w: no deployed containers for modules %s", ErrNotFound, strings.Join(moduleNames, ", "))
+}
+
+func LogModulesNotSpecified() error {
+	return fmt.Errorf("%w: specify at least one module with --moduleName or use --all", ErrInvalidInput)
+}
+
+func UnsupportedLogLevel(level string, levels []string) error {
+	return fmt.Errorf("%w: unsupported log level %s, options: %s", ErrInvalidInput, level, strings.Join(levels, ", "))
+}
+
+func InvalidLogPattern(pattern string, err error) error {
+	return fmt.Errorf("%w: grep pattern %s: %w", ErrInvalidInput, pattern, err)
+}
+
+// ==================== Port Proxy Errors ====================
+
+func PortProxySidecarURLBlank() error {
+	return fmt.Errorf("%w: sidecar URL is required to create a port proxy", ErrInvalidInput)
+}
+
+func PortProxyNotFound(name string) error {
+	return fmt.Errorf("%w: port proxy %s", ErrNotFound, name)
+}
+
+func PortProxyAlreadyRunning(name string, pid int) error {
+	return fmt.Errorf("%w: port proxy %s is already running with pid %d, stop it with --restore", ErrInvalidInput, name, pid)
+}
+
+func PortProxyListenFailed(listenAddress string, err error) error {
+	return fmt.Errorf("failed to listen on %s, check if the hostname exists in /etc/hosts: %w", listenAddress, err)
+}
+
+func PortProxyStartFailed(name, logFile string, err error) error {
+	return fmt.Errorf("failed to start port proxy %s, see %s: %w", name, logFile, err)
+}
+
+func PortProxyStartTimeout(name, logFile string) error {
+	return fmt.Errorf("%w: waiting for port proxy %s to start, see %s", ErrTimeout, name, logFile)
+}
+
 // ==================== Tenant Errors ====================
 
 func TenantNotFound(tenantName string) error {
@@ -327,6 +572,109 @@ func FARFetchFailed(appID string, err error) error {
 	return fmt.Errorf("%w: failed to fetch application %s from FAR: %w", ErrNotFound, appID, err)
 }
 
+func RegistrySnapshotNotFound(snapshot string) error {
+	return fmt.Errorf("%w: registry snapshot %s", ErrNotFound, snapshot)
+}
+
+func RegistrySnapshotsNotFound(snapshotsDir string) error {
+	return fmt.Errorf("%w: no registry snapshot in %s, create one with the snapshotRegistry command", ErrNotFound, snapshotsDir)
+}
+
+func RegistrySnapshotEntryNotFound(entryPath string, err error) error {
+	return fmt.Errorf("%w: registry snapshot entry %s: %w", ErrNotFound, entryPath, err)
+}
+
+func RegistrySnapshotAlreadyExists(snapshot string) error {
+	return fmt.Errorf("%w: registry snapshot %s already exists, pass --overwrite to replace it", ErrInvalidInput, snapshot)
+}
+
+// ==================== Environment Snapshot Errors ====================
+
+func EnvironmentSnapshotNameInvalid(name string) error {
+	return fmt.Errorf("%w: snapshot name %q must start with a letter or digit and contain only letters, digits, dots, dashes and underscores", ErrInvalidInput, name)
+}
+
+func EnvironmentSnapshotNotFound(name string) error {
+	return fmt.Errorf("%w: environment snapshot %s", ErrNotFound, name)
+}
+
+func EnvironmentSnapshotAlreadyExists(name string) error {
+	return fmt.Errorf("%w: environment snapshot %s already exists, choose another name", ErrInvalidInput, name)
+}
+
+func EnvironmentSnapshotProfileMismatch(name, snapshotProfile, profile string) error {
+	return fmt.Errorf("%w: environment snapshot %s was saved for profile %s but the current profile is %s", ErrInvalidInput, name, snapshotProfile, profile)
+}
+
+func EnvironmentSnapshotCommandFailed(containerName string, command []string, exitCode int, output string) error {
+	return fmt.Errorf("%w: command %q in container %s exited with code %d: %s", ErrDeploymentFailed, strings.Join(command, " "), containerName, exitCode, output)
+}
+
+// ==================== Tenant Data Errors ====================
+
+func TenantNameInvalid(tenantName string) error {
+	return fmt.Errorf("%w: tenant name %q must start with a lowercase letter and contain only lowercase letters, digits and underscores", ErrInvalidInput, tenantName)
+}
+
+func TenantNotEntitled(tenantName string) error {
+	return fmt.Errorf("%w: entitlement of tenant %s", ErrNotFound, tenantName)
+}
+
+func TenantDataSchemasNotFound(tenantName, databaseName string) error {
+	return fmt.Errorf("%w: module schemas of tenant %s in database %s", ErrNotFound, tenantName, databaseName)
+}
+
+func TenantDataSchemaInvalid(schemaName, schemaPrefix string) error {
+	return fmt.Errorf("%w: schema %q of the tenant data archive must start with %s and contain only lowercase letters, digits and underscores", ErrInvalidInput, schemaName, schemaPrefix)
+}
+
+func TenantDataArchiveEntryNotFound(filePath, entryName string) error {
+	return fmt.Errorf("%w: entry %s in tenant data archive %s", ErrNotFound, entryName, filePath)
+}
+
+// ==================== System Readiness Errors ====================
+
+func SystemNotReady(timeout time.Duration, failures []string) error {
+	return fmt.Errorf("%w: system components did not become ready within %s: %s", ErrNotReady, timeout, strings.Join(failures, "; "))
+}
+
+func SystemContainerNotFound(serviceName string) error {
+	return fmt.Errorf("%w: system container for compose service %s", ErrNotFound, serviceName)
+}
+
+func SystemContainerNotRunning(containerName, status string, exitCode int) error {
+	return fmt.Errorf("container %s is %s with exit code %d", containerName, status, exitCode)
+}
+
+func SystemContainerUnhealthy(containerName, healthStatus, lastOutput string) error {
+	if lastOutput == "" {
+		return fmt.Errorf("container %s healthcheck is %s", containerName, healthStatus)
+	}
+	return fmt.Errorf("container %s healthcheck is %s: %s", containerName, healthStatus, lastOutput)
+}
+
+func SystemProbeFailed(containerName string, command []string, exitCode int, output string) error {
+	return fmt.Errorf("probe %s in container %s exited with code %d: %s", strings.Join(command, " "), containerName, exitCode, output)
+}
+
+func VaultSealed() error {
+	return fmt.Errorf("%w: vault is sealed", ErrNotReady)
+}
+
+func SystemEndpointNotReady(requestURL string, statusCode int) error {
+	return fmt.Errorf("%w: %s returned %d", ErrNotReady, requestURL, statusCode)
+}
+
+// ==================== Status Errors ====================
+
+func EnvironmentUnhealthy(unhealthy int) error {
+	return fmt.Errorf("%w: %d component(s) are unhealthy", ErrNotReady, unhealthy)
+}
+
+func UnsupportedOutputFormat(format string) error {
+	return fmt.Errorf("%w: unsupported output format %s", ErrInvalidInput, format)
+}
+
 // ==================== Flag Errors ====================
 
 func RegisterFlagCompletionFailed(err error) error {
@@ -337,6 +685,24 @@ func MarkFlagRequiredFailed(flag FlagReader, err error) error {
 	return fmt.Errorf("failed to mark %s flag as required: %w", flag.GetName(), err)
 }
 
+func OneFlagRequired(flags ...FlagReader) error {
+	names := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		names = append(names, "--"+flag.GetName())
+	}
+	return fmt.Errorf("%w: exactly one of the %s flags is required", ErrInvalidInput, strings.Join(names, ", "))
+}
+
+// ==================== Vault Errors ====================
+
+func VaultSecretNotFound(secretPath string) error {
+	return fmt.Errorf("%w: vault secret %s", ErrNotFound, secretPath)
+}
+
+func VaultSecretKeyNotFound(secretPath, key string) error {
+	return fmt.Errorf("%w: key %s in vault secret %s", ErrNotFound, key, secretPath)
+}
+
 // ==================== Version Errors ====================
 
 func VersionEmpty() error {
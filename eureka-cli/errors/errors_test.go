@@ -151,6 +151,18 @@ func TestRequiredParameterMissing(t *testing.T) {
 	})
 }
 
+func TestInvalidJSONPath(t *testing.T) {
+	t.Run("TestInvalidJSONPath_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.InvalidJSONPath("type", "the path must start with $")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Equal(t, "invalid input: JSONPath expression type: the path must start with $", result.Error())
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
 func TestAccessTokenBlank(t *testing.T) {
 	t.Run("TestAccessTokenBlank_Success", func(t *testing.T) {
 		// Act
@@ -481,6 +493,290 @@ func TestConsumerGroupPollTimeout(t *testing.T) {
 	})
 }
 
+func TestKafkaSharedTopicsPurgeRefused(t *testing.T) {
+	t.Run("TestKafkaSharedTopicsPurgeRefused_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.KafkaSharedTopicsPurgeRefused("ALL")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "topics are shared by all tenants as ALL")
+		assert.Contains(t, result.Error(), "--force")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestKafkaRequestFailed(t *testing.T) {
+	t.Run("TestKafkaRequestFailed_KnownCode", func(t *testing.T) {
+		// Act
+		result := apperrors.KafkaRequestFailed("Metadata", apperrors.KafkaUnknownTopicOrPartition)
+
+		// Assert
+		assert.Error(t, result)
+		assert.Equal(t, "kafka Metadata request failed with error code 3 (UNKNOWN_TOPIC_OR_PARTITION)", result.Error())
+		assert.True(t, errors.Is(result, &apperrors.KafkaError{Code: apperrors.KafkaUnknownTopicOrPartition}))
+		assert.False(t, errors.Is(result, &apperrors.KafkaError{Code: apperrors.KafkaLeaderNotAvailable}))
+	})
+
+	t.Run("TestKafkaRequestFailed_UnknownCode", func(t *testing.T) {
+		// Act
+		result := apperrors.KafkaRequestFailed("Fetch", -1)
+
+		// Assert
+		assert.Equal(t, "kafka Fetch request failed with error code -1 (UNKNOWN_SERVER_ERROR)", result.Error())
+	})
+}
+
+func TestKafkaErrorRetriable(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     int16
+		expected bool
+	}{
+		{"LeaderNotAvailable", apperrors.KafkaLeaderNotAvailable, true},
+		{"NotLeaderOrFollower", apperrors.KafkaNotLeaderOrFollower, true},
+		{"CoordinatorNotAvailable", apperrors.KafkaCoordinatorNotAvailable, true},
+		{"RebalanceInProgress", apperrors.KafkaRebalanceInProgress, true},
+		{"UnknownTopicOrPartition", apperrors.KafkaUnknownTopicOrPartition, false},
+		{"GroupAuthorizationFailed", apperrors.KafkaGroupAuthorizationFailed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run("TestKafkaErrorRetriable_"+tt.name, func(t *testing.T) {
+			// Arrange
+			var kafkaErr *apperrors.KafkaError
+			err := fmt.Errorf("wrapped: %w", apperrors.KafkaRequestFailed("OffsetFetch", tt.code))
+
+			// Act
+			ok := errors.As(err, &kafkaErr)
+
+			// Assert
+			assert.True(t, ok)
+			assert.Equal(t, tt.expected, kafkaErr.Retriable())
+		})
+	}
+}
+
+func TestConsortiumNotFound(t *testing.T) {
+	t.Run("TestConsortiumNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConsortiumNotFound("ecs")
+
+		// Assert
+		assert.Contains(t, result.Error(), "consortium ecs")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestConsortiumUserMigrationFailed(t *testing.T) {
+	t.Run("TestConsortiumUserMigrationFailed_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConsortiumUserMigrationFailed(2, 10)
+
+		// Assert
+		assert.Contains(t, result.Error(), "2 of 10 consortium member tenant user migrations failed")
+		assert.True(t, errors.Is(result, apperrors.ErrDeploymentFailed))
+	})
+}
+
+func TestKafkaTopicNotFound(t *testing.T) {
+	t.Run("TestKafkaTopicNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.KafkaTopicNotFound("folio.diku.inventory.instance")
+
+		// Assert
+		assert.Contains(t, result.Error(), "kafka topic folio.diku.inventory.instance")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestEnvironmentSnapshotNameInvalid(t *testing.T) {
+	t.Run("TestEnvironmentSnapshotNameInvalid_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.EnvironmentSnapshotNameInvalid("../before")
+
+		// Assert
+		assert.Contains(t, result.Error(), `snapshot name "../before"`)
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestEnvironmentSnapshotProfileMismatch(t *testing.T) {
+	t.Run("TestEnvironmentSnapshotProfileMismatch_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.EnvironmentSnapshotProfileMismatch("before-upgrade", "combined", "ecs")
+
+		// Assert
+		assert.Contains(t, result.Error(), "saved for profile combined but the current profile is ecs")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestEnvironmentSnapshotCommandFailed(t *testing.T) {
+	t.Run("TestEnvironmentSnapshotCommandFailed_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.EnvironmentSnapshotCommandFailed("postgres", []string{"pg_dumpall", "-U", "postgres"}, 1, "connection refused")
+
+		// Assert
+		assert.Contains(t, result.Error(), `command "pg_dumpall -U postgres" in container postgres exited with code 1: connection refused`)
+		assert.True(t, errors.Is(result, apperrors.ErrDeploymentFailed))
+	})
+}
+
+func TestKongRouteExpressionInvalid(t *testing.T) {
+	t.Run("TestKongRouteExpressionInvalid_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.KongRouteExpressionInvalid(`http.path ==`, 12, "expected a value")
+
+		// Assert
+		assert.Contains(t, result.Error(), `kong route expression "http.path ==" at position 12: expected a value`)
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestKongRouteNotFound(t *testing.T) {
+	t.Run("TestKongRouteNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.KongRouteNotFound("GET", "/orders/composite-orders/123")
+
+		// Assert
+		assert.Contains(t, result.Error(), "kong route matching GET /orders/composite-orders/123")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestRealmMismatch(t *testing.T) {
+	t.Run("TestRealmMismatch_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.RealmMismatch("diku", "fs09000000")
+
+		// Assert
+		assert.Contains(t, result.Error(), "realm export of diku cannot be imported into tenant fs09000000")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestTenantNameInvalid(t *testing.T) {
+	t.Run("TestTenantNameInvalid_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.TenantNameInvalid("Diku;")
+
+		// Assert
+		assert.Contains(t, result.Error(), `tenant name "Diku;" must start with a lowercase letter`)
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestTenantNotEntitled(t *testing.T) {
+	t.Run("TestTenantNotEntitled_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.TenantNotEntitled("diku")
+
+		// Assert
+		assert.Contains(t, result.Error(), "entitlement of tenant diku")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestTenantDataSchemasNotFound(t *testing.T) {
+	t.Run("TestTenantDataSchemasNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.TenantDataSchemasNotFound("diku", "folio")
+
+		// Assert
+		assert.Contains(t, result.Error(), "module schemas of tenant diku in database folio")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestTenantDataSchemaInvalid(t *testing.T) {
+	t.Run("TestTenantDataSchemaInvalid_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.TenantDataSchemaInvalid("public", "diku_mod_")
+
+		// Assert
+		assert.Contains(t, result.Error(), `schema "public" of the tenant data archive must start with diku_mod_`)
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestTenantDataArchiveEntryNotFound(t *testing.T) {
+	t.Run("TestTenantDataArchiveEntryNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.TenantDataArchiveEntryNotFound("diku.tar.gz", "manifest.json")
+
+		// Assert
+		assert.Contains(t, result.Error(), "entry manifest.json in tenant data archive diku.tar.gz")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestVaultSecretNotFound(t *testing.T) {
+	t.Run("TestVaultSecretNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.VaultSecretNotFound("folio/diku")
+
+		// Assert
+		assert.Contains(t, result.Error(), "vault secret folio/diku")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestVaultSecretKeyNotFound(t *testing.T) {
+	t.Run("TestVaultSecretKeyNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.VaultSecretKeyNotFound("folio/diku", "mod-users")
+
+		// Assert
+		assert.Contains(t, result.Error(), "key mod-users in vault secret folio/diku")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestKafkaMessageNotJSON(t *testing.T) {
+	t.Run("TestKafkaMessageNotJSON_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.KafkaMessageNotJSON("event.json")
+
+		// Assert
+		assert.Contains(t, result.Error(), "message file event.json does not contain valid JSON")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestKafkaMessageHeaderInvalid(t *testing.T) {
+	t.Run("TestKafkaMessageHeaderInvalid_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.KafkaMessageHeaderInvalid("X-Okapi-Tenant")
+
+		// Assert
+		assert.Contains(t, result.Error(), "message header X-Okapi-Tenant is not in the name=value form")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestConsumerGroupNotFound(t *testing.T) {
+	t.Run("TestConsumerGroupNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConsumerGroupNotFound("folio-mod-roles-keycloak-capability-group")
+
+		// Assert
+		assert.Contains(t, result.Error(), "consumer group folio-mod-roles-keycloak-capability-group")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
+func TestConsumerGroupActive(t *testing.T) {
+	t.Run("TestConsumerGroupActive_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConsumerGroupActive("test-group", "Stable", 2)
+
+		// Assert
+		assert.Contains(t, result.Error(), "consumer group test-group is Stable with 2 active members")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
 func TestContainerCommandFailed(t *testing.T) {
 	t.Run("TestContainerCommandFailed_Success", func(t *testing.T) {
 		// Arrange
@@ -558,6 +854,30 @@ func TestUserNotFound(t *testing.T) {
 	})
 }
 
+func TestAccessTokenMalformed(t *testing.T) {
+	t.Run("TestAccessTokenMalformed_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.AccessTokenMalformed("is not a JWT")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "access token is not a JWT")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
+func TestConfigUserNotFound(t *testing.T) {
+	t.Run("TestConfigUserNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.ConfigUserNotFound("diku_admin")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "user diku_admin in the users section of the config")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
 // ==================== Kong Errors Tests ====================
 
 func TestKongRoutesNotReady(t *testing.T) {
@@ -672,6 +992,18 @@ func TestSidecarDeployFailed(t *testing.T) {
 	})
 }
 
+func TestSidecarPortNotFound(t *testing.T) {
+	t.Run("TestSidecarPortNotFound_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.SidecarPortNotFound("mod-users")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "published server port of the sidecar of module mod-users")
+		assert.True(t, errors.Is(result, apperrors.ErrNotFound))
+	})
+}
+
 func TestSidecarVersionNotFound(t *testing.T) {
 	t.Run("TestSidecarVersionNotFound_Success", func(t *testing.T) {
 		// Act
@@ -901,6 +1233,19 @@ func TestLocalInstallFileNotFound(t *testing.T) {
 	})
 }
 
+func TestRegistrySnapshotAlreadyExists(t *testing.T) {
+	t.Run("TestRegistrySnapshotAlreadyExists_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.RegistrySnapshotAlreadyExists("R1-2025")
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "registry snapshot R1-2025 already exists")
+		assert.Contains(t, result.Error(), "--overwrite")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
 // ==================== Flag Error Messages Tests ====================
 
 func TestRegisterFlagCompletionFailed(t *testing.T) {
@@ -968,6 +1313,18 @@ func TestMarkFlagRequiredFailed(t *testing.T) {
 	})
 }
 
+func TestOneFlagRequired(t *testing.T) {
+	t.Run("TestOneFlagRequired_Success", func(t *testing.T) {
+		// Act
+		result := apperrors.OneFlagRequired(flagAdapter{name: "toEarliest"}, flagAdapter{name: "toLatest"})
+
+		// Assert
+		assert.Error(t, result)
+		assert.Contains(t, result.Error(), "exactly one of the --toEarliest, --toLatest flags is required")
+		assert.True(t, errors.Is(result, apperrors.ErrInvalidInput))
+	})
+}
+
 // ==================== Version Errors Tests ====================
 
 func TestVersionEmpty(t *testing.T) {
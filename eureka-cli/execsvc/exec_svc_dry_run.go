@@ -0,0 +1,46 @@
+package execsvc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+)
+
+// DryRunExecSvc implements CommandRunner by printing the planned commands instead of executing them
+type DryRunExecSvc struct {
+	Action *action.Action
+	Writer io.Writer
+}
+
+// NewDryRun creates a new DryRunExecSvc instance
+func NewDryRun(action *action.Action, writer io.Writer) *DryRunExecSvc {
+	return &DryRunExecSvc{Action: action, Writer: writer}
+}
+
+func (es *DryRunExecSvc) Exec(cmd *exec.Cmd) error {
+	es.record(cmd, "")
+	return nil
+}
+
+func (es *DryRunExecSvc) ExecFromDir(cmd *exec.Cmd, workDir string) error {
+	es.record(cmd, workDir)
+	return nil
+}
+
+func (es *DryRunExecSvc) ExecReturnOutput(cmd *exec.Cmd) (bytes.Buffer, bytes.Buffer, error) {
+	var stdout, stderr bytes.Buffer
+	es.record(cmd, "")
+	return stdout, stderr, nil
+}
+
+func (es *DryRunExecSvc) record(cmd *exec.Cmd, workDir string) {
+	_, _ = fmt.Fprintf(es.Writer, "%s EXEC %s\n", constant.DryRunPrefix, strings.Join(cmd.Args, " "))
+	if workDir != "" {
+		_, _ = fmt.Fprintf(es.Writer, "  dir: %s\n", workDir)
+	}
+}
@@ -0,0 +1,40 @@
+package execsvc_test
+
+import (
+	"bytes"
+	"os/exec"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/execsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRun_ExecFromDirRecordsCommand(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	svc := execsvc.NewDryRun(testhelpers.NewMockAction(), &buf)
+
+	// Act
+	err := svc.ExecFromDir(exec.Command("docker", "compose", "--project-name", "eureka", "up", "--detach"), "/home/user/.eureka/misc")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, constant.DryRunPrefix+" EXEC docker compose --project-name eureka up --detach\n  dir: /home/user/.eureka/misc\n", buf.String())
+}
+
+func TestDryRun_ExecReturnOutputIsEmpty(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	svc := execsvc.NewDryRun(testhelpers.NewMockAction(), &buf)
+
+	// Act
+	stdout, stderr, err := svc.ExecReturnOutput(exec.Command("docker", "exec", "-i", "kafka-tools", "bash"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Zero(t, stdout.Len())
+	assert.Zero(t, stderr.Len())
+	assert.Contains(t, buf.String(), "EXEC docker exec -i kafka-tools bash")
+}
@@ -3,6 +3,7 @@ package field
 const (
 	Profile                              = "profile"
 	ProfileName                          = "profile.name"
+	ProfileExtends                       = "profile.extends"
 	Application                          = "application"
 	ApplicationName                      = "application.name"
 	ApplicationVersion                   = "application.version"
@@ -18,6 +19,7 @@ const (
 	FarURL                               = "far.url"
 	Registry                             = "registry"
 	RegistryURL                          = "registry.url"
+	RegistrySnapshot                     = "registry.snapshot"
 	Namespaces                           = "namespaces"
 	NamespacesPlatformCompleteUI         = "namespaces.platform-complete-ui"
 	Env                                  = "environment"
@@ -40,6 +42,7 @@ const (
 	UsersLastNameEntry                   = "last-name"
 	UsersFirstNameEntry                  = "first-name"
 	UsersRolesEntry                      = "roles"
+	UsersPermissionsEntry                = "permissions" // Not read, kept in older configs
 	Roles                                = "roles"
 	RolesConsortiumEntry                 = "consortium"
 	RolesTenantEntry                     = "tenant"
@@ -71,6 +74,7 @@ const (
 	ModuleVolumesEntry                   = "volumes"
 	ModuleResourceEntry                  = "resources"
 	ModuleResourceCpuCountEntry          = "cpu-count"
+	ModuleResourceCpusEntry              = "cpus" // Not read, kept in older configs
 	ModuleResourceMemoryReservationEntry = "memory-reservation"
 	ModuleResourceMemoryEntry            = "memory"
 	ModuleResourceMemorySwapEntry        = "memory-swap"
@@ -12,6 +12,8 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -145,6 +147,88 @@ func GetHomeMiscDir() (string, error) {
 	return filepath.Join(homeDir, constant.DockerComposeWorkDir), nil
 }
 
+func GetHomeRegistrySnapshotsDir() (string, error) {
+	homeDir, err := GetHomeDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, constant.RegistrySnapshotDir), nil
+}
+
+func GetHomeEnvironmentSnapshotsDir() (string, error) {
+	homeDir, err := GetHomeDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, constant.EnvironmentSnapshotDir), nil
+}
+
+func GetHomeTokenCacheFilePath() (string, error) {
+	homeDir, err := GetHomeDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, constant.TokenCacheFile), nil
+}
+
+func GetHomePortProxiesDir() (string, error) {
+	homeDir, err := GetHomeDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, constant.PortProxyDir), nil
+}
+
+// FindRegistrySnapshotDir resolves a registry snapshot given by name (in the home snapshot directory)
+// or by path, the most recently created snapshot is returned when no snapshot is given
+func FindRegistrySnapshotDir(snapshot string) (string, error) {
+	if snapshot != "" && (filepath.IsAbs(snapshot) || strings.ContainsRune(snapshot, filepath.Separator)) {
+		if _, err := os.Stat(filepath.Join(snapshot, constant.RegistrySnapshotManifestFile)); err != nil {
+			return "", appErrors.RegistrySnapshotNotFound(snapshot)
+		}
+		return snapshot, nil
+	}
+
+	snapshotsDir, err := GetHomeRegistrySnapshotsDir()
+	if err != nil {
+		return "", err
+	}
+	if snapshot != "" {
+		snapshotDir := filepath.Join(snapshotsDir, snapshot)
+		if _, err := os.Stat(filepath.Join(snapshotDir, constant.RegistrySnapshotManifestFile)); err != nil {
+			return "", appErrors.RegistrySnapshotNotFound(snapshot)
+		}
+		return snapshotDir, nil
+	}
+
+	entries, err := os.ReadDir(snapshotsDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	var (
+		latestDir     string
+		latestModTime time.Time
+	)
+	for _, entry := range entries {
+		info, err := os.Stat(filepath.Join(snapshotsDir, entry.Name(), constant.RegistrySnapshotManifestFile))
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		if latestDir == "" || info.ModTime().After(latestModTime) {
+			latestDir, latestModTime = filepath.Join(snapshotsDir, entry.Name()), info.ModTime()
+		}
+	}
+	if latestDir == "" {
+		return "", appErrors.RegistrySnapshotsNotFound(snapshotsDir)
+	}
+
+	return latestDir, nil
+}
+
 func GetHomeDirPath() (string, error) {
 	userHome, err := os.UserHomeDir()
 	if err != nil {
@@ -162,3 +246,9 @@ func GetHomeDirPath() (string, error) {
 func CloseReader(reader io.ReadCloser) {
 	_ = reader.Close()
 }
+
+// IsTerminal reports whether the file is an interactive terminal, e.g. to decide if progress can be redrawn in place
+func IsTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
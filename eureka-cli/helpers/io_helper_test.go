@@ -5,7 +5,9 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/stretchr/testify/assert"
 )
@@ -401,6 +403,68 @@ func TestGetHomeMiscDir_Success(t *testing.T) {
 	assert.NotEmpty(t, result)
 }
 
+func TestFindRegistrySnapshotDir(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	snapshotsDir, err := helpers.GetHomeRegistrySnapshotsDir()
+	assert.NoError(t, err)
+	writeManifest := func(name string, modTime time.Time) string {
+		snapshotDir := filepath.Join(snapshotsDir, name)
+		manifestPath := filepath.Join(snapshotDir, constant.RegistrySnapshotManifestFile)
+		assert.NoError(t, os.MkdirAll(snapshotDir, 0755))
+		assert.NoError(t, os.WriteFile(manifestPath, []byte("{}"), 0644))
+		assert.NoError(t, os.Chtimes(manifestPath, modTime, modTime))
+		return snapshotDir
+	}
+
+	t.Run("TestFindRegistrySnapshotDir_NoSnapshots", func(t *testing.T) {
+		// Act
+		_, err := helpers.FindRegistrySnapshotDir("")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "snapshotRegistry")
+	})
+
+	oldDir := writeManifest("R1-2024", time.Now().Add(-time.Hour))
+	newDir := writeManifest("R1-2025", time.Now())
+	assert.NoError(t, os.MkdirAll(filepath.Join(snapshotsDir, "incomplete"), 0755))
+
+	t.Run("TestFindRegistrySnapshotDir_Latest", func(t *testing.T) {
+		// Act
+		result, err := helpers.FindRegistrySnapshotDir("")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, newDir, result)
+	})
+
+	t.Run("TestFindRegistrySnapshotDir_ByName", func(t *testing.T) {
+		// Act
+		result, err := helpers.FindRegistrySnapshotDir("R1-2024")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, oldDir, result)
+	})
+
+	t.Run("TestFindRegistrySnapshotDir_ByPath", func(t *testing.T) {
+		// Act
+		result, err := helpers.FindRegistrySnapshotDir(oldDir)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, oldDir, result)
+	})
+
+	t.Run("TestFindRegistrySnapshotDir_Incomplete", func(t *testing.T) {
+		// Act
+		_, err := helpers.FindRegistrySnapshotDir("incomplete")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
 func TestCloseFile_NoError(t *testing.T) {
 	// Arrange
 	tmpDir := t.TempDir()
@@ -572,3 +636,18 @@ func TestCopyMultipleFiles_EmptyEmbedFS(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestIsTerminal_RegularFile(t *testing.T) {
+	t.Run("TestIsTerminal_RegularFile", func(t *testing.T) {
+		// Arrange
+		file, err := os.Create(filepath.Join(t.TempDir(), "output.log"))
+		assert.NoError(t, err)
+		defer helpers.CloseFile(file)
+
+		// Act
+		result := helpers.IsTerminal(file)
+
+		// Assert
+		assert.False(t, result)
+	})
+}
@@ -0,0 +1,203 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+)
+
+// JSONPathFilter matches JSON documents against a JSONPath expression, optionally compared to a literal with == or !=,
+// e.g. $.type, $.new.title == 'Test' or $.items[*].status != "Open"
+type JSONPathFilter struct {
+	segments []jsonPathSegment
+	operator string
+	value    any
+}
+
+// jsonPathSegment selects an object member by name, an array element by index (negative from the end) or every child
+type jsonPathSegment struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ParseJSONPathFilter parses a filter made of a JSONPath expression supporting the root $, .name, ['name'], [index],
+// [*] and .* selectors, followed by an optional == or != comparison with a JSON literal or a single-quoted string
+func ParseJSONPathFilter(expression string) (*JSONPathFilter, error) {
+	segments, rest, err := parseJSONPath(strings.TrimSpace(expression))
+	if err != nil {
+		return nil, errors.InvalidJSONPath(expression, err.Error())
+	}
+	filter := &JSONPathFilter{segments: segments}
+	if rest = strings.TrimSpace(rest); rest == "" {
+		return filter, nil
+	}
+
+	for _, operator := range []string{"==", "!="} {
+		if after, found := strings.CutPrefix(rest, operator); found {
+			value, err := parseJSONPathLiteral(strings.TrimSpace(after))
+			if err != nil {
+				return nil, errors.InvalidJSONPath(expression, err.Error())
+			}
+			filter.operator, filter.value = operator, value
+			return filter, nil
+		}
+	}
+
+	return nil, errors.InvalidJSONPath(expression, fmt.Sprintf("unexpected %q after the path, expected == or !=", rest))
+}
+
+// Match reports whether the document has a value selected by the path that is not null or false when no comparison
+// is given, or that satisfies the comparison
+func (f *JSONPathFilter) Match(document any) bool {
+	for _, value := range selectJSONPath(document, f.segments) {
+		switch f.operator {
+		case "==":
+			if reflect.DeepEqual(value, f.value) {
+				return true
+			}
+		case "!=":
+			if !reflect.DeepEqual(value, f.value) {
+				return true
+			}
+		default:
+			if value != nil && value != false {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parseJSONPath parses the path at the start of the expression and returns the rest of the expression, which
+// starts at the first whitespace, = or ! outside of a bracket selector
+func parseJSONPath(expression string) ([]jsonPathSegment, string, error) {
+	if !strings.HasPrefix(expression, "$") {
+		return nil, "", errors.New("the path must start with $")
+	}
+
+	var segments []jsonPathSegment
+	rest := expression[1:]
+	for rest != "" && !strings.ContainsRune(" \t=!", rune(rest[0])) {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[ \t=!") + 1
+			if end == 0 {
+				end = len(rest)
+			}
+			name := rest[1:end]
+			if name == "" {
+				return nil, "", errors.New("empty member name")
+			}
+			segments = append(segments, jsonPathSegment{name: name, wildcard: name == "*"})
+			rest = rest[end:]
+		case '[':
+			end := getJSONPathBracketEnd(rest)
+			if end == -1 {
+				return nil, "", errors.New("unclosed bracket")
+			}
+			segment, err := parseJSONPathBracket(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return nil, "", err
+			}
+			segments = append(segments, segment)
+			rest = rest[end+1:]
+		default:
+			return nil, "", errors.Newf("unexpected character %q", rest[0])
+		}
+	}
+
+	return segments, rest, nil
+}
+
+// getJSONPathBracketEnd returns the index of the bracket closing the selector at the start of the path,
+// brackets inside a quoted member name do not close the selector
+func getJSONPathBracketEnd(path string) int {
+	var quote byte
+	for i := 1; i < len(path); i++ {
+		switch {
+		case quote != 0:
+			if path[i] == quote {
+				quote = 0
+			}
+		case path[i] == '\'' || path[i] == '"':
+			quote = path[i]
+		case path[i] == ']':
+			return i
+		}
+	}
+
+	return -1
+}
+
+func parseJSONPathBracket(selector string) (jsonPathSegment, error) {
+	if selector == "*" {
+		return jsonPathSegment{wildcard: true}, nil
+	}
+	if len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0] {
+		return jsonPathSegment{name: selector[1 : len(selector)-1]}, nil
+	}
+	index, err := strconv.Atoi(selector)
+	if err != nil {
+		return jsonPathSegment{}, errors.Newf("unsupported selector [%s]", selector)
+	}
+
+	return jsonPathSegment{index: index, isIndex: true}, nil
+}
+
+// parseJSONPathLiteral decodes a JSON literal, a single-quoted string or a bare word, which is treated as a string
+func parseJSONPathLiteral(literal string) (any, error) {
+	if literal == "" {
+		return nil, errors.New("missing comparison value")
+	}
+	if len(literal) >= 2 && literal[0] == '\'' && literal[len(literal)-1] == '\'' {
+		return literal[1 : len(literal)-1], nil
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(literal), &value); err != nil {
+		return literal, nil
+	}
+
+	return value, nil
+}
+
+func selectJSONPath(document any, segments []jsonPathSegment) []any {
+	values := []any{document}
+	for _, segment := range segments {
+		var selected []any
+		for _, value := range values {
+			switch node := value.(type) {
+			case map[string]any:
+				if segment.wildcard {
+					for _, child := range node {
+						selected = append(selected, child)
+					}
+				} else if child, ok := node[segment.name]; ok && !segment.isIndex {
+					selected = append(selected, child)
+				}
+			case []any:
+				if segment.wildcard {
+					selected = append(selected, node...)
+				} else if segment.isIndex {
+					index := segment.index
+					if index < 0 {
+						index += len(node)
+					}
+					if index >= 0 && index < len(node) {
+						selected = append(selected, node[index])
+					}
+				}
+			}
+		}
+		values = selected
+	}
+
+	return values
+}
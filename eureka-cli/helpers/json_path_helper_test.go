@@ -0,0 +1,93 @@
+package helpers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEvent = `{
+	"type": "UPDATE",
+	"tenant": "diku",
+	"new": {"id": "a1", "title": "Test", "count": 3, "suppressed": false, "tags": ["x", "y"]},
+	"items": [{"status": "Open"}, {"status": "Closed"}],
+	"a==b": "c!=d"
+}`
+
+func decodeTestEvent(t *testing.T) any {
+	var document any
+	require.NoError(t, json.Unmarshal([]byte(testEvent), &document))
+	return document
+}
+
+func TestParseJSONPathFilter_Match(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   bool
+	}{
+		{"Exists", "$.new.title", true},
+		{"Missing", "$.old", false},
+		{"False", "$.new.suppressed", false},
+		{"Root", "$", true},
+		{"EqualsSingleQuoted", "$.type == 'UPDATE'", true},
+		{"EqualsDoubleQuoted", `$.type == "CREATE"`, false},
+		{"EqualsBareWord", "$.tenant==diku", true},
+		{"EqualsNumber", "$.new.count == 3", true},
+		{"EqualsBool", "$.new.suppressed == false", true},
+		{"NotEquals", "$.type != 'UPDATE'", false},
+		{"BracketName", "$['new']['id'] == 'a1'", true},
+		{"Index", "$.new.tags[1] == 'y'", true},
+		{"NegativeIndex", "$.new.tags[-1] == 'y'", true},
+		{"IndexOutOfRange", "$.new.tags[5]", false},
+		{"ArrayWildcard", "$.items[*].status == 'Closed'", true},
+		{"MemberWildcard", "$.new.* == 'Test'", true},
+		{"BracketNameWithOperators", "$['a==b'] == 'c!=d'", true},
+		{"ValueWithOperator", "$.type != 'a==b'", true},
+		{"ValueWithBracket", "$.type == ']'", false},
+	}
+
+	for _, tt := range tests {
+		t.Run("TestParseJSONPathFilter_Match_"+tt.name, func(t *testing.T) {
+			// Arrange
+			document := decodeTestEvent(t)
+
+			// Act
+			filter, err := helpers.ParseJSONPathFilter(tt.expression)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, filter.Match(document))
+		})
+	}
+}
+
+func TestParseJSONPathFilter_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{"MissingRoot", "type == 'UPDATE'"},
+		{"EmptyMember", "$..type"},
+		{"UnclosedBracket", "$.items[0"},
+		{"UnsupportedSelector", "$.items[?(@.status)]"},
+		{"MissingValue", "$.type =="},
+		{"SingleEquals", "$.type = 'UPDATE'"},
+		{"MissingOperator", "$.type 'UPDATE'"},
+	}
+
+	for _, tt := range tests {
+		t.Run("TestParseJSONPathFilter_Invalid_"+tt.name, func(t *testing.T) {
+			// Act
+			filter, err := helpers.ParseJSONPathFilter(tt.expression)
+
+			// Assert
+			assert.ErrorIs(t, err, errors.ErrInvalidInput)
+			assert.Nil(t, filter)
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package helpers
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -30,6 +33,32 @@ func ConstructURL(url string, gatewayURL string) string {
 	return fmt.Sprintf("%s:%s", gatewayURL, url)
 }
 
+// ==================== Access Token ====================
+
+// GetAccessTokenExpiry returns the expiry of a JWT access token from the exp claim of its payload, the signature is not verified
+func GetAccessTokenExpiry(accessToken string) (time.Time, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.AccessTokenMalformed("is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, errors.AccessTokenMalformed("payload is not base64url encoded")
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, errors.AccessTokenMalformed("payload is not JSON")
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, errors.AccessTokenMalformed("has no exp claim")
+	}
+
+	return time.Unix(int64(claims.Exp), 0), nil
+}
+
 // ==================== Okapi Headers ====================
 
 func SecureOkapiApplicationJSONHeaders(accessToken string) (map[string]string, error) {
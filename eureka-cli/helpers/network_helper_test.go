@@ -1,8 +1,10 @@
 package helpers_test
 
 import (
+	"encoding/base64"
 	"errors"
 	"testing"
+	"time"
 
 	apperrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
@@ -69,6 +71,42 @@ func TestConstructURL_WithoutPrefix(t *testing.T) {
 	assert.Equal(t, "http://gateway.com:8080", result)
 }
 
+func TestGetAccessTokenExpiry_ValidToken(t *testing.T) {
+	// Arrange
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1767225600,"azp":"diku-application"}`))
+	accessToken := "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"
+
+	// Act
+	result, err := helpers.GetAccessTokenExpiry(accessToken)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1767225600, 0), result)
+}
+
+func TestGetAccessTokenExpiry_NotJWT(t *testing.T) {
+	// Act
+	result, err := helpers.GetAccessTokenExpiry("hvs.root-token")
+
+	// Assert
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, apperrors.ErrInvalidInput))
+	assert.True(t, result.IsZero())
+}
+
+func TestGetAccessTokenExpiry_NoExpClaim(t *testing.T) {
+	// Arrange
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"user"}`))
+
+	// Act
+	result, err := helpers.GetAccessTokenExpiry("header." + payload + ".signature")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "has no exp claim")
+	assert.True(t, result.IsZero())
+}
+
 func TestSecureOkapiApplicationJSONHeaders_ValidToken(t *testing.T) {
 	// Arrange
 	accessToken := "token123"
@@ -0,0 +1,25 @@
+package helpers
+
+import (
+	"errors"
+	"sync"
+)
+
+// RunParallel calls fn for every index with at most parallelism concurrent calls and joins all returned errors
+func RunParallel(count int, parallelism int, fn func(idx int) error) error {
+	var wg sync.WaitGroup
+	errs := make([]error, count)
+	semaphore := make(chan struct{}, max(1, parallelism))
+	for idx := range count {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			errs[idx] = fn(idx)
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
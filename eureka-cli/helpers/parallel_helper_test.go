@@ -0,0 +1,70 @@
+package helpers_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunParallel(t *testing.T) {
+	t.Run("TestRunParallel_AggregatesErrors", func(t *testing.T) {
+		// Arrange
+		var calls sync.Map
+		errA := errors.New("pull failed for a")
+		errC := errors.New("pull failed for c")
+
+		// Act
+		err := helpers.RunParallel(4, 2, func(idx int) error {
+			calls.Store(idx, true)
+			switch idx {
+			case 0:
+				return errA
+			case 2:
+				return errC
+			}
+			return nil
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, errA)
+		assert.ErrorIs(t, err, errC)
+		for idx := range 4 {
+			_, called := calls.Load(idx)
+			assert.True(t, called)
+		}
+	})
+
+	t.Run("TestRunParallel_BoundsConcurrency", func(t *testing.T) {
+		// Arrange
+		var running, peak atomic.Int32
+
+		// Act
+		err := helpers.RunParallel(8, 2, func(idx int) error {
+			current := running.Add(1)
+			for {
+				previous := peak.Load()
+				if current <= previous || peak.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			running.Add(-1)
+			return nil
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, peak.Load(), int32(2))
+	})
+
+	t.Run("TestRunParallel_NoItems", func(t *testing.T) {
+		// Act
+		err := helpers.RunParallel(0, 0, func(idx int) error { return errors.New("unexpected") })
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
@@ -1,7 +1,6 @@
 package helpers
 
 import (
-	"bytes"
 	"regexp"
 	"strconv"
 	"strings"
@@ -12,7 +11,6 @@ import (
 var (
 	colonDelimited = regexp.MustCompile(constant.ColonDelimitedPattern)
 	moduleId       = regexp.MustCompile(constant.ModuleIDPattern)
-	newLine        = regexp.MustCompile(constant.NewLinePattern)
 	protocol       = regexp.MustCompile(constant.ProtocolPattern)
 )
 
@@ -83,30 +81,3 @@ func MatchesModuleName(moduleID string, moduleName string) bool {
 	pattern := regexp.MustCompile("^" + regexp.QuoteMeta(moduleName) + `-\d`)
 	return pattern.MatchString(moduleID)
 }
-
-// ==================== Kafka ====================
-
-func GetKafkaConsumerLagFromLogLine(stdout bytes.Buffer) string {
-	if stdout.Len() == 0 {
-		return "0"
-	}
-
-	cleaned := newLine.ReplaceAllString(stdout.String(), " ")
-	cleaned = strings.TrimSpace(cleaned)
-	if cleaned == "" {
-		return "0"
-	}
-
-	parts := strings.Fields(cleaned)
-	totalLag := 0
-	for _, part := range parts {
-		if part == "-" {
-			continue
-		}
-		if lag, err := strconv.Atoi(part); err == nil {
-			totalLag += lag
-		}
-	}
-
-	return strconv.Itoa(totalLag)
-}
@@ -1,7 +1,6 @@
 package helpers_test
 
 import (
-	"bytes"
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
@@ -348,77 +347,6 @@ func TestGetModuleVersionFromID_NoVersion(t *testing.T) {
 	assert.Equal(t, "-users", result)
 }
 
-func TestGetKafkaConsumerLagFromLogLine_WithNewlines(t *testing.T) {
-	// Arrange
-	var stdout bytes.Buffer
-	stdout.WriteString("100\n50\n")
-
-	// Act
-	result := helpers.GetKafkaConsumerLagFromLogLine(stdout)
-
-	// Assert
-	assert.Equal(t, "150", result)
-}
-
-func TestGetKafkaConsumerLagFromLogLine_EmptyBuffer(t *testing.T) {
-	// Arrange
-	var stdout bytes.Buffer
-
-	// Act
-	result := helpers.GetKafkaConsumerLagFromLogLine(stdout)
-
-	// Assert
-	assert.Equal(t, "0", result)
-}
-
-func TestGetKafkaConsumerLagFromLogLine_WithDashes(t *testing.T) {
-	// Arrange - Dashes should be treated as 0
-	var stdout bytes.Buffer
-	stdout.WriteString("-\n0")
-
-	// Act
-	result := helpers.GetKafkaConsumerLagFromLogLine(stdout)
-
-	// Assert
-	assert.Equal(t, "0", result)
-}
-
-func TestGetKafkaConsumerLagFromLogLine_MixedValues(t *testing.T) {
-	// Arrange
-	var stdout bytes.Buffer
-	stdout.WriteString("-\n100\n-\n50\n25")
-
-	// Act
-	result := helpers.GetKafkaConsumerLagFromLogLine(stdout)
-
-	// Assert
-	assert.Equal(t, "175", result)
-}
-
-func TestGetKafkaConsumerLagFromLogLine_SingleValue(t *testing.T) {
-	// Arrange
-	var stdout bytes.Buffer
-	stdout.WriteString("42")
-
-	// Act
-	result := helpers.GetKafkaConsumerLagFromLogLine(stdout)
-
-	// Assert
-	assert.Equal(t, "42", result)
-}
-
-func TestGetKafkaConsumerLagFromLogLine_AllDashes(t *testing.T) {
-	// Arrange
-	var stdout bytes.Buffer
-	stdout.WriteString("-\n-\n-")
-
-	// Act
-	result := helpers.GetKafkaConsumerLagFromLogLine(stdout)
-
-	// Assert
-	assert.Equal(t, "0", result)
-}
-
 func TestMatchesModuleName_Matching(t *testing.T) {
 	// Arrange
 	moduleID := "mod-users-19.3.0"
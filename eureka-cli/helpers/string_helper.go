@@ -93,6 +93,15 @@ func GenerateRandomCode(length int) string {
 	return string(code)
 }
 
+// MaskSecret hides a secret value, an empty value stays empty to show that the secret is missing
+func MaskSecret(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return "********"
+}
+
 func SortedMapKeys(m map[string]any) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
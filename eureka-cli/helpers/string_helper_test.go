@@ -1296,3 +1296,25 @@ func TestGenerateRandomCode_ConsistentLength(t *testing.T) {
 		assert.Len(t, result, 3, "Every generated code should be exactly 3 characters")
 	}
 }
+
+func TestMaskSecret(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"Empty", "", ""},
+		{"Short", "a", "********"},
+		{"Long", "a-very-long-generated-password", "********"},
+	}
+
+	for _, tt := range tests {
+		t.Run("TestMaskSecret_"+tt.name, func(t *testing.T) {
+			// Act
+			result := helpers.MaskSecret(tt.value)
+
+			// Assert
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"io"
 	"log/slog"
+	"maps"
 	"net/http"
+	"strings"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
@@ -20,14 +22,21 @@ type HTTPClientRunner interface {
 	HTTPClientPostManager
 	HTTPClientPutManager
 	HTTPClientDeleteManager
+	HTTPClientCaller
+}
+
+// TokenRefresher defines the interface for replacing an access token rejected by the server
+type TokenRefresher interface {
+	RefreshAccessToken(staleToken string) (string, error)
 }
 
 // HTTPClient provides functionality for HTTP client operations with retry logic
 type HTTPClient struct {
-	Action       *action.Action
-	customClient *http.Client
-	retryClient  *retryablehttp.Client
-	pingClient   *retryablehttp.Client
+	Action         *action.Action
+	TokenRefresher TokenRefresher
+	customClient   *http.Client
+	retryClient    *retryablehttp.Client
+	pingClient     *retryablehttp.Client
 }
 
 // New creates a new HTTPClient instance
@@ -43,6 +52,88 @@ func New(action *action.Action, logger *slog.Logger) *HTTPClient {
 }
 
 func (hc *HTTPClient) doRequest(method, url string, payload []byte, headers map[string]string, useRetry bool) (*http.Response, error) {
+	httpResponse, err := hc.sendAuthorizedRequest(method, url, payload, headers, useRetry)
+	if err != nil {
+		return nil, err
+	}
+	if err := hc.validateResponse(method, url, httpResponse); err != nil {
+		CloseResponse(httpResponse)
+		return nil, err
+	}
+	if err := helpers.DumpResponse(method, url, httpResponse, false); err != nil {
+		CloseResponse(httpResponse)
+		return nil, err
+	}
+
+	return httpResponse, nil
+}
+
+// sendAuthorizedRequest sends the request and sends it once more with a refreshed access token when the token is rejected
+func (hc *HTTPClient) sendAuthorizedRequest(method, url string, payload []byte, headers map[string]string, useRetry bool) (*http.Response, error) {
+	httpResponse, err := hc.sendRequest(method, url, payload, headers, useRetry)
+	if err != nil || httpResponse.StatusCode != http.StatusUnauthorized {
+		return httpResponse, err
+	}
+
+	refreshedHeaders := hc.refreshAccessToken(url, headers)
+	if refreshedHeaders == nil {
+		return httpResponse, nil
+	}
+	CloseResponse(httpResponse)
+
+	return hc.sendRequest(method, url, payload, refreshedHeaders, useRetry)
+}
+
+func (hc *HTTPClient) sendRequest(method, url string, payload []byte, headers map[string]string, useRetry bool) (*http.Response, error) {
+	httpRequest, err := newRequest(method, url, payload, headers)
+	if err != nil {
+		return nil, err
+	}
+	if !useRetry {
+		return hc.customClient.Do(httpRequest)
+	}
+
+	retryReq, err := retryablehttp.FromRequest(httpRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return hc.retryClient.Do(retryReq)
+}
+
+// refreshAccessToken returns a copy of the headers carrying a new access token when the token of a rejected request
+// was served by the token cache, e.g. a cached token issued by a Keycloak that has since been redeployed
+func (hc *HTTPClient) refreshAccessToken(url string, headers map[string]string) map[string]string {
+	if hc.TokenRefresher == nil {
+		return nil
+	}
+
+	headerName, prefix := constant.OkapiTokenHeader, ""
+	staleToken := headers[headerName]
+	if staleToken == "" {
+		headerName, prefix = constant.AuthorizationHeader, "Bearer "
+		staleToken = strings.TrimPrefix(headers[headerName], prefix)
+	}
+	if staleToken == "" {
+		return nil
+	}
+
+	token, err := hc.TokenRefresher.RefreshAccessToken(staleToken)
+	if err != nil {
+		slog.Warn(hc.Action.Name, "text", "Failed to refresh rejected access token", "url", url, "error", err)
+		return nil
+	}
+	if token == "" || token == staleToken {
+		return nil
+	}
+	slog.Info(hc.Action.Name, "text", "Retrying request with a refreshed access token", "url", url)
+	refreshedHeaders := maps.Clone(headers)
+	refreshedHeaders[headerName] = prefix + token
+
+	return refreshedHeaders
+}
+
+func newRequest(method, url string, payload []byte, headers map[string]string) (*http.Request, error) {
 	if payload != nil {
 		helpers.DumpRequestJSON(payload)
 	}
@@ -62,32 +153,7 @@ func (hc *HTTPClient) doRequest(method, url string, payload []byte, headers map[
 		return nil, err
 	}
 
-	var httpResponse *http.Response
-	if useRetry {
-		retryReq, err := retryablehttp.FromRequest(httpRequest)
-		if err != nil {
-			return nil, err
-		}
-		httpResponse, err = hc.retryClient.Do(retryReq)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		httpResponse, err = hc.customClient.Do(httpRequest)
-		if err != nil {
-			return nil, err
-		}
-	}
-	if err := hc.validateResponse(method, url, httpResponse); err != nil {
-		CloseResponse(httpResponse)
-		return nil, err
-	}
-	if err := helpers.DumpResponse(method, url, httpResponse, false); err != nil {
-		CloseResponse(httpResponse)
-		return nil, err
-	}
-
-	return httpResponse, nil
+	return httpRequest, nil
 }
 
 func setRequestHeaders(httpRequest *http.Request, headers map[string]string) {
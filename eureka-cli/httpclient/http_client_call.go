@@ -0,0 +1,37 @@
+package httpclient
+
+import (
+	"io"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// HTTPClientCaller defines the interface for sending arbitrary HTTP requests
+type HTTPClientCaller interface {
+	Call(method, url string, payload []byte, headers map[string]string) (*models.HTTPCallResponse, error)
+}
+
+// Call sends a single request without retries and returns the response as is, error statuses included,
+// so that the caller can show the status and the body that the server sent back
+func (hc *HTTPClient) Call(method, url string, payload []byte, headers map[string]string) (*models.HTTPCallResponse, error) {
+	start := time.Now()
+	httpResponse, err := hc.sendAuthorizedRequest(method, url, payload, headers, false)
+	if err != nil {
+		return nil, err
+	}
+	defer CloseResponse(httpResponse)
+
+	body, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.HTTPCallResponse{
+		StatusCode: httpResponse.StatusCode,
+		Status:     httpResponse.Status,
+		Header:     httpResponse.Header,
+		Body:       body,
+		Duration:   time.Since(start),
+	}, nil
+}
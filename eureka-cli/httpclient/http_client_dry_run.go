@@ -0,0 +1,149 @@
+package httpclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// DryRunHTTPClient implements HTTPClientRunner by delegating read-only calls to the wrapped client
+// and printing the planned mutating calls (POST, PUT, DELETE) instead of sending them
+type DryRunHTTPClient struct {
+	Action     *action.Action
+	HTTPClient HTTPClientRunner
+	Writer     io.Writer
+}
+
+// NewDryRun creates a new DryRunHTTPClient instance
+func NewDryRun(action *action.Action, httpClient HTTPClientRunner, writer io.Writer) *DryRunHTTPClient {
+	return &DryRunHTTPClient{Action: action, HTTPClient: httpClient, Writer: writer}
+}
+
+// ==================== Ping ====================
+
+func (dc *DryRunHTTPClient) PingRetry(url string) error {
+	dc.record("PING", url, nil)
+	return nil
+}
+
+func (dc *DryRunHTTPClient) Ping(url string) (int, error) {
+	dc.record("PING", url, nil)
+	return http.StatusOK, nil
+}
+
+// ==================== Get ====================
+
+func (dc *DryRunHTTPClient) GetReturnStruct(url string, headers map[string]string, target any) error {
+	return dc.skipFailedRead(url, dc.HTTPClient.GetReturnStruct(url, headers, target))
+}
+
+func (dc *DryRunHTTPClient) GetRetryReturnStruct(url string, headers map[string]string, target any) error {
+	return dc.skipFailedRead(url, dc.HTTPClient.GetRetryReturnStruct(url, headers, target))
+}
+
+func (dc *DryRunHTTPClient) GetReturnRawBytes(url string, headers map[string]string) ([]byte, error) {
+	body, err := dc.HTTPClient.GetReturnRawBytes(url, headers)
+	return body, dc.skipFailedRead(url, err)
+}
+
+// ==================== Post ====================
+
+func (dc *DryRunHTTPClient) PostReturnNoContent(url string, payload []byte, headers map[string]string) error {
+	dc.record(http.MethodPost, url, payload)
+	return nil
+}
+
+func (dc *DryRunHTTPClient) PostRetryReturnNoContent(url string, payload []byte, headers map[string]string) error {
+	dc.record(http.MethodPost, url, payload)
+	return nil
+}
+
+func (dc *DryRunHTTPClient) PostReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
+	dc.record(http.MethodPost, url, payload)
+	return nil
+}
+
+// PostFormDataReturnStruct is only used for token grants which do not mutate the environment,
+// so the call is delegated and a placeholder token is returned if the identity provider is unreachable
+func (dc *DryRunHTTPClient) PostFormDataReturnStruct(url string, formValues url.Values, headers map[string]string, target any) error {
+	if err := dc.HTTPClient.PostFormDataReturnStruct(url, formValues, headers, target); err != nil {
+		slog.Warn(dc.Action.Name, "text", "Using placeholder access token in dry run", "url", url, "error", err)
+		return json.Unmarshal(fmt.Appendf(nil, `{"access_token":%q}`, constant.DryRunToken), target)
+	}
+
+	return nil
+}
+
+// ==================== Put ====================
+
+func (dc *DryRunHTTPClient) PutReturnNoContent(url string, payload []byte, headers map[string]string) error {
+	dc.record(http.MethodPut, url, payload)
+	return nil
+}
+
+func (dc *DryRunHTTPClient) PutReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
+	dc.record(http.MethodPut, url, payload)
+	return nil
+}
+
+// ==================== Delete ====================
+
+func (dc *DryRunHTTPClient) Delete(url string, headers map[string]string) error {
+	dc.record(http.MethodDelete, url, nil)
+	return nil
+}
+
+func (dc *DryRunHTTPClient) DeleteReturnStruct(url string, headers map[string]string, target any) error {
+	dc.record(http.MethodDelete, url, nil)
+	return nil
+}
+
+func (dc *DryRunHTTPClient) DeleteWithPayloadReturnStruct(url string, payload []byte, headers map[string]string, target any) error {
+	dc.record(http.MethodDelete, url, payload)
+	return nil
+}
+
+// ==================== Call ====================
+
+// Call delegates GET requests and prints the other requests, which may mutate the environment, without sending them
+func (dc *DryRunHTTPClient) Call(method, url string, payload []byte, headers map[string]string) (*models.HTTPCallResponse, error) {
+	if method == http.MethodGet {
+		response, err := dc.HTTPClient.Call(method, url, payload, headers)
+		return response, dc.skipFailedRead(url, err)
+	}
+	dc.record(method, url, payload)
+
+	return nil, nil
+}
+
+// ==================== Internal ====================
+
+func (dc *DryRunHTTPClient) skipFailedRead(url string, err error) error {
+	if err != nil {
+		slog.Warn(dc.Action.Name, "text", "Skipping failed read in dry run", "url", url, "error", err)
+	}
+
+	return nil
+}
+
+func (dc *DryRunHTTPClient) record(method string, url string, payload []byte) {
+	_, _ = fmt.Fprintf(dc.Writer, "%s %s %s\n", constant.DryRunPrefix, method, url)
+	if len(payload) == 0 {
+		return
+	}
+
+	var formatted bytes.Buffer
+	if err := json.Indent(&formatted, payload, "", "  "); err != nil {
+		_, _ = fmt.Fprintln(dc.Writer, string(payload))
+		return
+	}
+	_, _ = fmt.Fprintln(dc.Writer, formatted.String())
+}
@@ -0,0 +1,121 @@
+package httpclient_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDryRun_PostRecordsPayload(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	client := httpclient.NewDryRun(createTestAction(), mockHTTP, &buf)
+
+	// Act
+	err := client.PostReturnStruct("http://localhost:8000/applications", []byte(`{"id":"app-1.0.0"}`), nil, &TestResponse{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), constant.DryRunPrefix+" POST http://localhost:8000/applications")
+	assert.Contains(t, buf.String(), `"id": "app-1.0.0"`)
+	mockHTTP.AssertNotCalled(t, "PostReturnStruct", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDryRun_PutAndDeleteAreRecorded(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	client := httpclient.NewDryRun(createTestAction(), &testhelpers.MockHTTPClient{}, &buf)
+
+	// Act
+	errPut := client.PutReturnNoContent("http://localhost:8000/tenants/1", []byte("not-json"), nil)
+	errDelete := client.Delete("http://localhost:8000/tenants/1", nil)
+
+	// Assert
+	assert.NoError(t, errPut)
+	assert.NoError(t, errDelete)
+	assert.Contains(t, buf.String(), constant.DryRunPrefix+" PUT http://localhost:8000/tenants/1\nnot-json")
+	assert.Contains(t, buf.String(), constant.DryRunPrefix+" DELETE http://localhost:8000/tenants/1")
+}
+
+func TestDryRun_PingReportsReady(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	client := httpclient.NewDryRun(createTestAction(), &testhelpers.MockHTTPClient{}, &buf)
+
+	// Act
+	statusCode, err := client.Ping("http://localhost:36002/admin/health")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	assert.NoError(t, client.PingRetry("http://localhost:8001/status"))
+}
+
+func TestDryRun_GetDelegatesAndSkipsFailures(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	client := httpclient.NewDryRun(createTestAction(), mockHTTP, &buf)
+	mockHTTP.On("GetReturnStruct", "http://localhost:8000/tenants", map[string]string{}, mock.Anything).Return(errors.New("connection refused"))
+	mockHTTP.On("GetReturnRawBytes", "http://localhost:8000/modules", map[string]string{}).Return([]byte("[]"), nil)
+
+	// Act
+	errStruct := client.GetReturnStruct("http://localhost:8000/tenants", map[string]string{}, &TestResponse{})
+	body, errRaw := client.GetReturnRawBytes("http://localhost:8000/modules", map[string]string{})
+
+	// Assert
+	assert.NoError(t, errStruct)
+	assert.NoError(t, errRaw)
+	assert.Equal(t, []byte("[]"), body)
+	assert.Empty(t, buf.String())
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestDryRun_PostFormDataUsesPlaceholderToken(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	client := httpclient.NewDryRun(createTestAction(), mockHTTP, &buf)
+	mockHTTP.On("PostFormDataReturnStruct", "http://keycloak/token", url.Values{}, map[string]string{}, mock.Anything).Return(errors.New("connection refused"))
+	var tokenData map[string]any
+
+	// Act
+	err := client.PostFormDataReturnStruct("http://keycloak/token", url.Values{}, map[string]string{}, &tokenData)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, constant.DryRunToken, tokenData["access_token"])
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestDryRun_CallDelegatesGetAndRecordsOtherMethods(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	client := httpclient.NewDryRun(createTestAction(), mockHTTP, &buf)
+	expected := &models.HTTPCallResponse{StatusCode: http.StatusOK, Body: []byte("{}")}
+	mockHTTP.On("Call", http.MethodGet, "http://localhost:8000/users", []byte(nil), map[string]string{}).Return(expected, nil)
+
+	// Act
+	getResponse, errGet := client.Call(http.MethodGet, "http://localhost:8000/users", nil, map[string]string{})
+	postResponse, errPost := client.Call(http.MethodPost, "http://localhost:8000/users", []byte(`{"username":"test"}`), map[string]string{})
+
+	// Assert
+	assert.NoError(t, errGet)
+	assert.Equal(t, expected, getResponse)
+	assert.NoError(t, errPost)
+	assert.Nil(t, postResponse)
+	assert.Contains(t, buf.String(), constant.DryRunPrefix+" POST http://localhost:8000/users")
+	assert.Contains(t, buf.String(), `"username": "test"`)
+	mockHTTP.AssertExpectations(t)
+}
@@ -27,6 +27,35 @@ func createTestLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(io.Discard, nil))
 }
 
+// stubTokenRefresher replaces the stale token with the fresh token and counts the refreshes
+type stubTokenRefresher struct {
+	staleToken string
+	freshToken string
+	calls      int
+}
+
+func (r *stubTokenRefresher) RefreshAccessToken(staleToken string) (string, error) {
+	r.calls++
+	if staleToken != r.staleToken {
+		return "", nil
+	}
+	return r.freshToken, nil
+}
+
+// newTokenServer creates a test server that rejects every request without the given access token
+func newTokenServer(t *testing.T, validToken string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Okapi-Token") != validToken && r.Header.Get("Authorization") != "Bearer "+validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
 // GET Tests
 
 func TestGetReturnStruct_Success(t *testing.T) {
@@ -468,6 +497,106 @@ func TestDeleteWithPayloadReturnStruct_Success(t *testing.T) {
 	assert.Equal(t, "deleted", response["status"])
 }
 
+// Call Tests
+
+func TestCall_Success(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "diku", r.Header.Get("X-Okapi-Tenant"))
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"id":1}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	client := httpclient.New(createTestAction(), createTestLogger())
+	headers := map[string]string{"X-Okapi-Tenant": "diku"}
+
+	// Act
+	response, err := client.Call(http.MethodPost, server.URL, []byte(`{"id":1}`), headers)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, "201 Created", response.Status)
+	assert.Equal(t, "application/json", response.Header.Get("Content-Type"))
+	assert.Equal(t, `{"id":1}`, string(response.Body))
+	assert.Positive(t, response.Duration)
+}
+
+func TestCall_ErrorStatusIsReturned(t *testing.T) {
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("Access Denied"))
+	}))
+	defer server.Close()
+
+	client := httpclient.New(createTestAction(), createTestLogger())
+
+	// Act
+	response, err := client.Call(http.MethodGet, server.URL, nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	assert.Equal(t, "Access Denied", string(response.Body))
+}
+
+// Token Refresh Tests
+
+func TestTokenRefresh_RetriesWithRefreshedOkapiToken(t *testing.T) {
+	// Arrange
+	server := newTokenServer(t, "fresh-token")
+	client := httpclient.New(createTestAction(), createTestLogger())
+	refresher := &stubTokenRefresher{staleToken: "stale-token", freshToken: "fresh-token"}
+	client.TokenRefresher = refresher
+	headers := map[string]string{"X-Okapi-Token": "stale-token"}
+
+	// Act
+	var result TestResponse
+	err := client.GetReturnStruct(server.URL, headers, &result)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.ID)
+	assert.Equal(t, 1, refresher.calls)
+	assert.Equal(t, "stale-token", headers["X-Okapi-Token"])
+}
+
+func TestTokenRefresh_RetriesWithRefreshedBearerToken(t *testing.T) {
+	// Arrange
+	server := newTokenServer(t, "fresh-token")
+	client := httpclient.New(createTestAction(), createTestLogger())
+	client.TokenRefresher = &stubTokenRefresher{staleToken: "stale-token", freshToken: "fresh-token"}
+
+	// Act
+	response, err := client.Call(http.MethodGet, server.URL, nil, map[string]string{"Authorization": "Bearer stale-token"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestTokenRefresh_UnknownTokenIsNotRetried(t *testing.T) {
+	// Arrange
+	server := newTokenServer(t, "fresh-token")
+	client := httpclient.New(createTestAction(), createTestLogger())
+	refresher := &stubTokenRefresher{staleToken: "stale-token", freshToken: "fresh-token"}
+	client.TokenRefresher = refresher
+
+	// Act
+	var result TestResponse
+	err := client.GetReturnStruct(server.URL, map[string]string{"X-Okapi-Token": "other-token"}, &result)
+
+	// Assert
+	assert.ErrorContains(t, err, "request failed with status 401")
+	assert.Equal(t, 1, refresher.calls)
+}
+
 // Ping Tests
 
 func TestPingRetry_Success(t *testing.T) {
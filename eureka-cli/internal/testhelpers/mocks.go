@@ -2,12 +2,15 @@ package testhelpers
 
 import (
 	"bytes"
+	"context"
 	"net/url"
 	"os/exec"
+	"time"
 
 	"github.com/docker/docker/client"
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/hashicorp/vault-client-go"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -99,6 +102,14 @@ func (m *MockHTTPClient) DeleteWithPayloadReturnStruct(url string, payload []byt
 	return args.Error(0)
 }
 
+func (m *MockHTTPClient) Call(method, url string, payload []byte, headers map[string]string) (*models.HTTPCallResponse, error) {
+	args := m.Called(method, url, payload, headers)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.HTTPCallResponse), args.Error(1)
+}
+
 // NewMockAction creates a minimal Action instance for testing
 func NewMockAction() *action.Action {
 	params := &action.Param{}
@@ -152,6 +163,22 @@ func (m *MockRegistrySvc) GetAuthorizationToken() (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockRegistrySvc) GetLockedModules(lock *models.Lock) *models.ProxyModulesByRegistry {
+	args := m.Called(lock)
+	if args.Get(0) == nil {
+		return nil
+	}
+	return args.Get(0).(*models.ProxyModulesByRegistry)
+}
+
+func (m *MockRegistrySvc) SnapshotRegistry(snapshotName string) (*models.RegistrySnapshot, error) {
+	args := m.Called(snapshotName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.RegistrySnapshot), args.Error(1)
+}
+
 func (m *MockRegistrySvc) GetModules(verbose bool) (*models.ProxyModulesByRegistry, error) {
 	args := m.Called(verbose)
 	if args.Get(0) == nil {
@@ -222,6 +249,132 @@ func (m *MockDockerClient) ForcePullImage(image string) (string, error) {
 	return args.String(0), args.Error(1)
 }
 
+// MockKafkaClient is a mock implementation of kafkaclient.KafkaClientRunner
+type MockKafkaClient struct {
+	mock.Mock
+}
+
+func (m *MockKafkaClient) GetAPIVersions() ([]models.KafkaAPIVersion, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]models.KafkaAPIVersion), args.Error(1)
+}
+
+func (m *MockKafkaClient) GetMetadata(topicNames []string) (*models.KafkaMetadata, error) {
+	args := m.Called(topicNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.KafkaMetadata), args.Error(1)
+}
+
+func (m *MockKafkaClient) ListConsumerGroups() ([]string, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockKafkaClient) DescribeConsumerGroup(groupID string) (*models.KafkaConsumerGroup, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.KafkaConsumerGroup), args.Error(1)
+}
+
+func (m *MockKafkaClient) FetchCommittedOffsets(groupID string) (map[models.KafkaTopicPartition]int64, error) {
+	args := m.Called(groupID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[models.KafkaTopicPartition]int64), args.Error(1)
+}
+
+func (m *MockKafkaClient) CommitOffsets(groupID string, offsets map[models.KafkaTopicPartition]int64) error {
+	args := m.Called(groupID, offsets)
+	return args.Error(0)
+}
+
+func (m *MockKafkaClient) ListOffsets(topicPartitions []models.KafkaTopicPartition, timestamp int64) (map[models.KafkaTopicPartition]int64, error) {
+	args := m.Called(topicPartitions, timestamp)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[models.KafkaTopicPartition]int64), args.Error(1)
+}
+
+func (m *MockKafkaClient) DeleteRecords(offsets map[models.KafkaTopicPartition]int64) (map[models.KafkaTopicPartition]int64, error) {
+	args := m.Called(offsets)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[models.KafkaTopicPartition]int64), args.Error(1)
+}
+
+func (m *MockKafkaClient) FetchRecords(offsets map[models.KafkaTopicPartition]int64, maxWait time.Duration) ([]models.KafkaRecord, map[models.KafkaTopicPartition]int64, error) {
+	args := m.Called(offsets, maxWait)
+	var records []models.KafkaRecord
+	if args.Get(0) != nil {
+		records = args.Get(0).([]models.KafkaRecord)
+	}
+	var nextOffsets map[models.KafkaTopicPartition]int64
+	if args.Get(1) != nil {
+		nextOffsets = args.Get(1).(map[models.KafkaTopicPartition]int64)
+	}
+	return records, nextOffsets, args.Error(2)
+}
+
+func (m *MockKafkaClient) ProduceRecord(record models.KafkaRecord) (*models.KafkaRecord, error) {
+	args := m.Called(record)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.KafkaRecord), args.Error(1)
+}
+
+// MockVaultClient is a mock implementation of vaultclient.VaultClientRunner
+type MockVaultClient struct {
+	mock.Mock
+}
+
+func (m *MockVaultClient) Create() (*vault.Client, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*vault.Client), args.Error(1)
+}
+
+func (m *MockVaultClient) GetSecretKey(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) (map[string]any, error) {
+	args := m.Called(ctx, client, vaultRootToken, secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m *MockVaultClient) ListSecrets(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) ([]string, error) {
+	args := m.Called(ctx, client, vaultRootToken, secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockVaultClient) PutSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string, data map[string]any) error {
+	args := m.Called(ctx, client, vaultRootToken, secretPath, data)
+	return args.Error(0)
+}
+
+func (m *MockVaultClient) DeleteSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) error {
+	args := m.Called(ctx, client, vaultRootToken, secretPath)
+	return args.Error(0)
+}
+
 // MockTenantSvc is a mock implementation of tenantsvc.TenantProcessor
 type MockTenantSvc struct {
 	mock.Mock
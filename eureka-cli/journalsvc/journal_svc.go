@@ -0,0 +1,163 @@
+package journalsvc
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// JournalProcessor defines the interface for deployment journal operations
+type JournalProcessor interface {
+	JournalManager
+	JournalReader
+}
+
+// JournalManager defines the interface for starting and recording journal entries
+type JournalManager interface {
+	Begin(resume bool) error
+	Record(step string, partition string, status constant.JournalStatus, err error) error
+	SetCurrentStep(step string)
+}
+
+// JournalReader defines the interface for reading journal entries
+type JournalReader interface {
+	IsEnabled() bool
+	IsSucceeded(step string, partition string) bool
+	GetCurrentStep() string
+}
+
+// JournalSvc provides functionality for persisting the progress of a deployment
+// so that an interrupted run can be resumed from the last failed step
+type JournalSvc struct {
+	Action      *action.Action
+	journal     *models.Journal
+	currentStep string
+	mu          sync.Mutex
+}
+
+// New creates a new JournalSvc instance
+func New(action *action.Action) *JournalSvc {
+	return &JournalSvc{Action: action}
+}
+
+func (js *JournalSvc) Begin(resume bool) error {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+
+	journal := &models.Journal{Profile: js.Action.ConfigProfileName}
+	if resume {
+		filePath, err := js.getJournalFilePath()
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stat(filePath); err == nil {
+			if err := helpers.ReadJSONFromFile(filePath, journal); err != nil {
+				return errors.JournalReadFailed(filePath, err)
+			}
+			slog.Info(js.Action.Name, "text", "Resuming from journal", "path", filePath, "entries", len(journal.Entries))
+		} else {
+			slog.Info(js.Action.Name, "text", "No journal found, starting from the beginning", "path", filePath)
+		}
+	}
+	js.journal = journal
+	js.currentStep = ""
+
+	return js.save()
+}
+
+func (js *JournalSvc) Record(step string, partition string, status constant.JournalStatus, err error) error {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	if js.journal == nil {
+		return nil
+	}
+
+	entry := models.JournalEntry{
+		Step:      step,
+		Partition: partition,
+		Status:    string(status),
+		Timestamp: time.Now(),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	replaced := false
+	for i, existing := range js.journal.Entries {
+		if existing.Step == step && existing.Partition == partition {
+			js.journal.Entries[i] = entry
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		js.journal.Entries = append(js.journal.Entries, entry)
+	}
+
+	return js.save()
+}
+
+func (js *JournalSvc) SetCurrentStep(step string) {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	js.currentStep = step
+}
+
+func (js *JournalSvc) IsEnabled() bool {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	return js.journal != nil
+}
+
+func (js *JournalSvc) IsSucceeded(step string, partition string) bool {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	if js.journal == nil {
+		return false
+	}
+	for _, entry := range js.journal.Entries {
+		if entry.Step == step && entry.Partition == partition {
+			return entry.Status == string(constant.Succeeded)
+		}
+	}
+
+	return false
+}
+
+func (js *JournalSvc) GetCurrentStep() string {
+	js.mu.Lock()
+	defer js.mu.Unlock()
+	return js.currentStep
+}
+
+func (js *JournalSvc) save() error {
+	filePath, err := js.getJournalFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return errors.JournalWriteFailed(filePath, err)
+	}
+	if err := helpers.WriteJSONToFile(filePath, js.journal); err != nil {
+		return errors.JournalWriteFailed(filePath, err)
+	}
+
+	return nil
+}
+
+func (js *JournalSvc) getJournalFilePath() (string, error) {
+	homeDir, err := helpers.GetHomeDirPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, constant.JournalDir, js.Action.ConfigProfileName+".json"), nil
+}
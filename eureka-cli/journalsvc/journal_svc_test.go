@@ -0,0 +1,180 @@
+package journalsvc_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestJournalSvc(t *testing.T) *journalsvc.JournalSvc {
+	t.Setenv("HOME", t.TempDir())
+	return journalsvc.New(&action.Action{Name: "test-action", ConfigProfileName: "combined"})
+}
+
+func readJournal(t *testing.T) models.Journal {
+	homeDir, err := helpers.GetHomeDirPath()
+	assert.NoError(t, err)
+
+	var journal models.Journal
+	err = helpers.ReadJSONFromFile(filepath.Join(homeDir, constant.JournalDir, "combined.json"), &journal)
+	assert.NoError(t, err)
+
+	return journal
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	act := &action.Action{Name: "test-action"}
+
+	// Act
+	result := journalsvc.New(act)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, act, result.Action)
+	assert.False(t, result.IsEnabled())
+}
+
+func TestRecord_NotStarted(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+
+	// Act
+	err := svc.Record(action.DeploySystem, "", constant.Succeeded, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, svc.IsSucceeded(action.DeploySystem, ""))
+}
+
+func TestBegin_FreshRunResetsJournal(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+	assert.NoError(t, svc.Begin(false))
+	assert.NoError(t, svc.Record(action.DeploySystem, "", constant.Succeeded, nil))
+
+	// Act
+	err := svc.Begin(false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, svc.IsEnabled())
+	assert.False(t, svc.IsSucceeded(action.DeploySystem, ""))
+	assert.Empty(t, readJournal(t).Entries)
+}
+
+func TestBegin_ResetsCurrentStep(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+	assert.NoError(t, svc.Begin(false))
+	svc.SetCurrentStep(action.AttachCapabilitySets)
+
+	// Act
+	err := svc.Begin(true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, svc.GetCurrentStep())
+}
+
+func TestBegin_ResumeLoadsJournal(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+	assert.NoError(t, svc.Begin(false))
+	assert.NoError(t, svc.Record(action.DeploySystem, "", constant.Succeeded, nil))
+	assert.NoError(t, svc.Record(action.CreateRoles, "nop/default/diku", constant.Failed, errors.New("boom")))
+
+	resumed := journalsvc.New(&action.Action{Name: "test-action", ConfigProfileName: "combined"})
+
+	// Act
+	err := resumed.Begin(true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, resumed.IsSucceeded(action.DeploySystem, ""))
+	assert.False(t, resumed.IsSucceeded(action.CreateRoles, "nop/default/diku"))
+}
+
+func TestBegin_ResumeWithoutJournal(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+
+	// Act
+	err := svc.Begin(true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, svc.IsEnabled())
+	assert.Empty(t, readJournal(t).Entries)
+}
+
+func TestBegin_ResumeInvalidJournal(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+	homeDir, err := helpers.GetHomeDirPath()
+	assert.NoError(t, err)
+	journalDir := filepath.Join(homeDir, constant.JournalDir)
+	assert.NoError(t, os.MkdirAll(journalDir, 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(journalDir, "combined.json"), []byte("{invalid"), 0644))
+
+	// Act
+	err = svc.Begin(true)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read journal")
+}
+
+func TestRecord_ReplacesExistingEntry(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+	assert.NoError(t, svc.Begin(false))
+	assert.NoError(t, svc.Record(action.CreateUsers, "nop/default", constant.Started, nil))
+
+	// Act
+	err := svc.Record(action.CreateUsers, "nop/default", constant.Succeeded, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, svc.IsSucceeded(action.CreateUsers, "nop/default"))
+	journal := readJournal(t)
+	assert.Equal(t, "combined", journal.Profile)
+	assert.Len(t, journal.Entries, 1)
+	assert.Equal(t, string(constant.Succeeded), journal.Entries[0].Status)
+	assert.False(t, journal.Entries[0].Timestamp.IsZero())
+}
+
+func TestRecord_FailedStoresError(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+	assert.NoError(t, svc.Begin(false))
+
+	// Act
+	err := svc.Record(action.DeployModules, "", constant.Failed, errors.New("module not ready"))
+
+	// Assert
+	assert.NoError(t, err)
+	journal := readJournal(t)
+	assert.Len(t, journal.Entries, 1)
+	assert.Equal(t, "module not ready", journal.Entries[0].Error)
+	assert.False(t, svc.IsSucceeded(action.DeployModules, ""))
+}
+
+func TestSetCurrentStep(t *testing.T) {
+	// Arrange
+	svc := newTestJournalSvc(t)
+
+	// Act
+	svc.SetCurrentStep(action.AttachCapabilitySets)
+
+	// Assert
+	assert.Equal(t, action.AttachCapabilitySets, svc.GetCurrentStep())
+}
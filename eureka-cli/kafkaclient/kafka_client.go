@@ -0,0 +1,101 @@
+package kafkaclient
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// Kafka protocol API names used in errors
+const (
+	produceAPI        = "Produce"
+	fetchAPI          = "Fetch"
+	listOffsetsAPI    = "ListOffsets"
+	metadataAPI       = "Metadata"
+	offsetCommitAPI   = "OffsetCommit"
+	offsetFetchAPI    = "OffsetFetch"
+	describeGroupsAPI = "DescribeGroups"
+	listGroupsAPI     = "ListGroups"
+	apiVersionsAPI    = "ApiVersions"
+	deleteRecordsAPI  = "DeleteRecords"
+)
+
+// KafkaClientRunner defines the interface for Kafka client operations
+type KafkaClientRunner interface {
+	KafkaClientBrokerManager
+	KafkaClientGroupManager
+	KafkaClientOffsetManager
+	KafkaClientRecordManager
+}
+
+// KafkaClient provides functionality for talking to the Kafka brokers with the franz-go client,
+// every operation creates its own client bootstrapped from the configured broker and closes it when done
+type KafkaClient struct {
+	Action         *action.Action
+	DialTimeout    time.Duration
+	RequestTimeout time.Duration
+}
+
+// New creates a new KafkaClient instance
+func New(action *action.Action) *KafkaClient {
+	return &KafkaClient{Action: action}
+}
+
+// GetBootstrapAddress returns the broker address from the KAFKA_HOST and KAFKA_PORT variables of the environment block,
+// falling back to the address of the kafka system container
+func (kc *KafkaClient) GetBootstrapAddress() string {
+	host := action.GetConfigEnv("KAFKA_HOST", kc.Action.ConfigGlobalEnv)
+	port := action.GetConfigEnv("KAFKA_PORT", kc.Action.ConfigGlobalEnv)
+	if host == "" || port == "" {
+		return constant.KafkaTCP
+	}
+
+	return net.JoinHostPort(host, port)
+}
+
+// newClient creates a client for the bootstrap broker and a context bounded by the request timeout,
+// the caller closes the client and cancels the context when the operation is done
+func (kc *KafkaClient) newClient(opts ...kgo.Opt) (*kgo.Client, context.Context, context.CancelFunc, error) {
+	opts = append([]kgo.Opt{
+		kgo.SeedBrokers(kc.GetBootstrapAddress()),
+		kgo.ClientID(constant.KafkaClientID),
+		kgo.DialTimeout(helpers.DefaultDuration(kc.DialTimeout, constant.KafkaClientDialTimeout)),
+	}, opts...)
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), helpers.DefaultDuration(kc.RequestTimeout, constant.KafkaClientRequestTimeout))
+
+	return client, ctx, cancel, nil
+}
+
+// newAdminClient creates an admin client for the bootstrap broker, see newClient
+func (kc *KafkaClient) newAdminClient() (*kadm.Client, context.Context, context.CancelFunc, error) {
+	client, ctx, cancel, err := kc.newClient()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return kadm.NewClient(client), ctx, cancel, nil
+}
+
+// toKafkaError converts an error code returned by a broker into a KafkaError so that callers can match
+// on the code, any other error is returned unchanged
+func toKafkaError(api string, err error) error {
+	var kerrErr *kerr.Error
+	if errors.As(err, &kerrErr) {
+		return appErrors.KafkaRequestFailed(api, kerrErr.Code)
+	}
+
+	return err
+}
@@ -0,0 +1,92 @@
+package kafkaclient
+
+import (
+	"context"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// KafkaClientBrokerManager defines the interface for Kafka broker and cluster metadata operations
+type KafkaClientBrokerManager interface {
+	GetAPIVersions() ([]models.KafkaAPIVersion, error)
+	GetMetadata(topicNames []string) (*models.KafkaMetadata, error)
+}
+
+// GetAPIVersions returns the protocol API versions supported by the bootstrap broker,
+// a successful response means the broker accepts and handles requests
+func (kc *KafkaClient) GetAPIVersions() ([]models.KafkaAPIVersion, error) {
+	client, ctx, cancel, err := kc.newClient()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	defer cancel()
+
+	resp, err := kmsg.NewPtrApiVersionsRequest().RequestWith(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if err := kerr.ErrorForCode(resp.ErrorCode); err != nil {
+		return nil, toKafkaError(apiVersionsAPI, err)
+	}
+
+	versions := make([]models.KafkaAPIVersion, 0, len(resp.ApiKeys))
+	for _, apiKey := range resp.ApiKeys {
+		versions = append(versions, models.KafkaAPIVersion{APIKey: apiKey.ApiKey, MinVersion: apiKey.MinVersion, MaxVersion: apiKey.MaxVersion})
+	}
+
+	return versions, nil
+}
+
+// GetMetadata returns the brokers and the given topics of the cluster, all topics are returned when topicNames is nil
+// and only the brokers when it is empty, topics that cannot be described, e.g. while their leader is being elected,
+// are left out of a listing of all topics
+func (kc *KafkaClient) GetMetadata(topicNames []string) (*models.KafkaMetadata, error) {
+	admin, ctx, cancel, err := kc.newAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close()
+	defer cancel()
+
+	metadata, err := getMetadata(ctx, admin, topicNames)
+	if err != nil {
+		return nil, err
+	}
+
+	return toMetadata(metadata, topicNames)
+}
+
+func getMetadata(ctx context.Context, admin *kadm.Client, topicNames []string) (kadm.Metadata, error) {
+	if topicNames != nil && len(topicNames) == 0 {
+		return admin.BrokerMetadata(ctx)
+	}
+
+	return admin.Metadata(ctx, topicNames...)
+}
+
+func toMetadata(metadata kadm.Metadata, topicNames []string) (*models.KafkaMetadata, error) {
+	result := &models.KafkaMetadata{ControllerID: metadata.Controller}
+	for _, broker := range metadata.Brokers {
+		result.Brokers = append(result.Brokers, models.KafkaBroker{NodeID: broker.NodeID, Host: broker.Host, Port: broker.Port})
+	}
+	for _, topic := range metadata.Topics.Sorted() {
+		if topic.Err != nil {
+			if topicNames != nil {
+				return nil, toKafkaError(metadataAPI, topic.Err)
+			}
+			continue
+		}
+
+		kafkaTopic := models.KafkaTopic{Name: topic.Topic, Internal: topic.IsInternal}
+		for _, partition := range topic.Partitions.Sorted() {
+			kafkaTopic.Partitions = append(kafkaTopic.Partitions, models.KafkaPartition{Partition: partition.Partition, Leader: partition.Leader})
+		}
+		result.Topics = append(result.Topics, kafkaTopic)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,144 @@
+package kafkaclient
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+)
+
+// KafkaClientGroupManager defines the interface for Kafka consumer group operations
+type KafkaClientGroupManager interface {
+	ListConsumerGroups() ([]string, error)
+	DescribeConsumerGroup(groupID string) (*models.KafkaConsumerGroup, error)
+	FetchCommittedOffsets(groupID string) (map[models.KafkaTopicPartition]int64, error)
+	CommitOffsets(groupID string, offsets map[models.KafkaTopicPartition]int64) error
+}
+
+// ListConsumerGroups returns the sorted ids of the consumer groups coordinated by any broker of the cluster
+func (kc *KafkaClient) ListConsumerGroups() ([]string, error) {
+	admin, ctx, cancel, err := kc.newAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close()
+	defer cancel()
+
+	groups, err := admin.ListGroups(ctx)
+	if err != nil {
+		return nil, toKafkaError(listGroupsAPI, err)
+	}
+
+	var groupIDs []string
+	for _, group := range groups {
+		if group.ProtocolType == "consumer" {
+			groupIDs = append(groupIDs, group.Group)
+		}
+	}
+	sort.Strings(groupIDs)
+
+	return groupIDs, nil
+}
+
+// DescribeConsumerGroup returns the state and the members of a consumer group as seen by its coordinator,
+// a group that does not exist is reported in the Dead state
+func (kc *KafkaClient) DescribeConsumerGroup(groupID string) (*models.KafkaConsumerGroup, error) {
+	admin, ctx, cancel, err := kc.newAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close()
+	defer cancel()
+
+	groups, err := admin.DescribeGroups(ctx, groupID)
+	if err != nil {
+		return nil, toKafkaError(describeGroupsAPI, err)
+	}
+	described, ok := groups[groupID]
+	if !ok || errors.Is(described.Err, kerr.GroupIDNotFound) {
+		return &models.KafkaConsumerGroup{GroupID: groupID, State: constant.KafkaGroupStateDead}, nil
+	}
+	if described.Err != nil {
+		return nil, toKafkaError(describeGroupsAPI, described.Err)
+	}
+
+	group := &models.KafkaConsumerGroup{
+		GroupID:      described.Group,
+		State:        described.State,
+		ProtocolType: described.ProtocolType,
+		Protocol:     described.Protocol,
+	}
+	for _, member := range described.Members {
+		groupMember := models.KafkaConsumerGroupMember{MemberID: member.MemberID, ClientID: member.ClientID, ClientHost: member.ClientHost}
+		if assignment, ok := member.Assigned.AsConsumer(); ok {
+			for _, topic := range assignment.Topics {
+				for _, partition := range topic.Partitions {
+					groupMember.Assignment = append(groupMember.Assignment, models.KafkaTopicPartition{Topic: topic.Topic, Partition: partition})
+				}
+			}
+		}
+		group.Members = append(group.Members, groupMember)
+	}
+
+	return group, nil
+}
+
+// FetchCommittedOffsets returns the offsets committed by a consumer group for all partitions it has consumed from,
+// a group that does not exist has no committed offsets
+func (kc *KafkaClient) FetchCommittedOffsets(groupID string) (map[models.KafkaTopicPartition]int64, error) {
+	admin, ctx, cancel, err := kc.newAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close()
+	defer cancel()
+
+	responses, err := admin.FetchOffsets(ctx, groupID)
+	if errors.Is(err, kerr.GroupIDNotFound) {
+		return map[models.KafkaTopicPartition]int64{}, nil
+	}
+	if err != nil {
+		return nil, toKafkaError(offsetFetchAPI, err)
+	}
+	if err := responses.Error(); err != nil {
+		return nil, toKafkaError(offsetFetchAPI, err)
+	}
+
+	offsets := make(map[models.KafkaTopicPartition]int64)
+	responses.Each(func(response kadm.OffsetResponse) {
+		if response.At >= 0 {
+			offsets[models.KafkaTopicPartition{Topic: response.Topic, Partition: response.Partition}] = response.At
+		}
+	})
+
+	return offsets, nil
+}
+
+// CommitOffsets commits the offsets of the partitions on behalf of a consumer group without joining it, the
+// coordinator only accepts the commit while the group has no active members
+func (kc *KafkaClient) CommitOffsets(groupID string, offsets map[models.KafkaTopicPartition]int64) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	admin, ctx, cancel, err := kc.newAdminClient()
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	defer cancel()
+
+	commit := make(kadm.Offsets)
+	for topicPartition, offset := range offsets {
+		commit.AddOffset(topicPartition.Topic, topicPartition.Partition, offset, -1)
+	}
+	responses, err := admin.CommitOffsets(ctx, groupID, commit)
+	if err != nil {
+		return toKafkaError(offsetCommitAPI, err)
+	}
+
+	return toKafkaError(offsetCommitAPI, responses.Error())
+}
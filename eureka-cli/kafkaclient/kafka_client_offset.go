@@ -0,0 +1,100 @@
+package kafkaclient
+
+import (
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/twmb/franz-go/pkg/kadm"
+)
+
+// KafkaClientOffsetManager defines the interface for Kafka partition offset operations
+type KafkaClientOffsetManager interface {
+	ListOffsets(topicPartitions []models.KafkaTopicPartition, timestamp int64) (map[models.KafkaTopicPartition]int64, error)
+	DeleteRecords(offsets map[models.KafkaTopicPartition]int64) (map[models.KafkaTopicPartition]int64, error)
+}
+
+// ListOffsets returns the offsets of the partitions at the given timestamp,
+// use constant.KafkaOffsetLatest for the log end offsets and constant.KafkaOffsetEarliest for the log start offsets
+func (kc *KafkaClient) ListOffsets(topicPartitions []models.KafkaTopicPartition, timestamp int64) (map[models.KafkaTopicPartition]int64, error) {
+	offsets := make(map[models.KafkaTopicPartition]int64)
+	if len(topicPartitions) == 0 {
+		return offsets, nil
+	}
+
+	admin, ctx, cancel, err := kc.newAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close()
+	defer cancel()
+
+	var topicNames []string
+	for _, topicPartition := range topicPartitions {
+		if !slices.Contains(topicNames, topicPartition.Topic) {
+			topicNames = append(topicNames, topicPartition.Topic)
+		}
+	}
+
+	var listed kadm.ListedOffsets
+	switch timestamp {
+	case constant.KafkaOffsetLatest:
+		listed, err = admin.ListEndOffsets(ctx, topicNames...)
+	case constant.KafkaOffsetEarliest:
+		listed, err = admin.ListStartOffsets(ctx, topicNames...)
+	default:
+		listed, err = admin.ListOffsetsAfterMilli(ctx, timestamp, topicNames...)
+	}
+	if err != nil {
+		return nil, toKafkaError(listOffsetsAPI, err)
+	}
+
+	for _, topicPartition := range topicPartitions {
+		offset, ok := listed.Lookup(topicPartition.Topic, topicPartition.Partition)
+		if !ok {
+			return nil, errors.KafkaRequestFailed(listOffsetsAPI, errors.KafkaUnknownTopicOrPartition)
+		}
+		if offset.Err != nil {
+			return nil, toKafkaError(listOffsetsAPI, offset.Err)
+		}
+		offsets[topicPartition] = offset.Offset
+	}
+
+	return offsets, nil
+}
+
+// DeleteRecords deletes the records of the partitions before the given offsets,
+// use constant.KafkaOffsetLatest to delete all records, the new log start offsets are returned
+func (kc *KafkaClient) DeleteRecords(offsets map[models.KafkaTopicPartition]int64) (map[models.KafkaTopicPartition]int64, error) {
+	lowWatermarks := make(map[models.KafkaTopicPartition]int64)
+	if len(offsets) == 0 {
+		return lowWatermarks, nil
+	}
+
+	admin, ctx, cancel, err := kc.newAdminClient()
+	if err != nil {
+		return nil, err
+	}
+	defer admin.Close()
+	defer cancel()
+
+	deletions := make(kadm.Offsets)
+	for topicPartition, offset := range offsets {
+		deletions.AddOffset(topicPartition.Topic, topicPartition.Partition, offset, -1)
+	}
+	responses, err := admin.DeleteRecords(ctx, deletions)
+	if err != nil {
+		return nil, toKafkaError(deleteRecordsAPI, err)
+	}
+	for _, partitions := range responses {
+		for _, response := range partitions {
+			if response.Err != nil {
+				return nil, toKafkaError(deleteRecordsAPI, response.Err)
+			}
+			lowWatermarks[models.KafkaTopicPartition{Topic: response.Topic, Partition: response.Partition}] = response.LowWatermark
+		}
+	}
+
+	return lowWatermarks, nil
+}
@@ -0,0 +1,197 @@
+package kafkaclient
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+// KafkaClientRecordManager defines the interface for reading and writing Kafka messages
+type KafkaClientRecordManager interface {
+	FetchRecords(offsets map[models.KafkaTopicPartition]int64, maxWait time.Duration) ([]models.KafkaRecord, map[models.KafkaTopicPartition]int64, error)
+	ProduceRecord(record models.KafkaRecord) (*models.KafkaRecord, error)
+}
+
+// FetchRecords reads the records of the partitions starting at the given offsets by asking the leader of every partition,
+// the broker waits up to maxWait for new records, the offsets to continue from are returned together with the records
+func (kc *KafkaClient) FetchRecords(offsets map[models.KafkaTopicPartition]int64, maxWait time.Duration) ([]models.KafkaRecord, map[models.KafkaTopicPartition]int64, error) {
+	nextOffsets := make(map[models.KafkaTopicPartition]int64, len(offsets))
+	for topicPartition, offset := range offsets {
+		nextOffsets[topicPartition] = offset
+	}
+	if len(offsets) == 0 {
+		return nil, nextOffsets, nil
+	}
+
+	client, ctx, cancel, err := kc.newClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer client.Close()
+	defer cancel()
+
+	requests, topicNames, err := getFetchRequests(ctx, kadm.NewClient(client), offsets, maxWait)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var records []models.KafkaRecord
+	for leader, request := range requests {
+		resp, err := request.RequestWith(ctx, client.Broker(int(leader)))
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := kerr.ErrorForCode(resp.ErrorCode); err != nil {
+			return nil, nil, toKafkaError(fetchAPI, err)
+		}
+
+		for i := range resp.Topics {
+			topic := &resp.Topics[i]
+			topicName := topic.Topic
+			if topicName == "" {
+				topicName = topicNames[topic.TopicID]
+			}
+			for j := range topic.Partitions {
+				topicPartition := models.KafkaTopicPartition{Topic: topicName, Partition: topic.Partitions[j].Partition}
+				partition, nextOffset := kgo.ProcessFetchPartition(kgo.ProcessFetchPartitionOpts{
+					Offset:    nextOffsets[topicPartition],
+					Topic:     topicName,
+					Partition: topicPartition.Partition,
+				}, &topic.Partitions[j], kgo.DefaultDecompressor(), nil)
+				if partition.Err != nil {
+					return nil, nil, toKafkaError(fetchAPI, partition.Err)
+				}
+				for _, record := range partition.Records {
+					records = append(records, toRecord(record))
+				}
+				nextOffsets[topicPartition] = nextOffset
+			}
+		}
+	}
+
+	return records, nextOffsets, nil
+}
+
+// getFetchRequests returns a fetch request for the leader of every partition by node id,
+// and the topic names by topic id for brokers answering with topic ids only
+func getFetchRequests(ctx context.Context, admin *kadm.Client, offsets map[models.KafkaTopicPartition]int64, maxWait time.Duration) (map[int32]*kmsg.FetchRequest, map[[16]byte]string, error) {
+	var topicNames []string
+	for topicPartition := range offsets {
+		if !slices.Contains(topicNames, topicPartition.Topic) {
+			topicNames = append(topicNames, topicPartition.Topic)
+		}
+	}
+	metadata, err := admin.Metadata(ctx, topicNames...)
+	if err != nil {
+		return nil, nil, toKafkaError(metadataAPI, err)
+	}
+
+	requests := make(map[int32]*kmsg.FetchRequest)
+	requestTopics := make(map[int32]map[string]int)
+	topicNamesByID := make(map[[16]byte]string)
+	for topicPartition, offset := range offsets {
+		topic, ok := metadata.Topics[topicPartition.Topic]
+		if !ok {
+			return nil, nil, errors.KafkaRequestFailed(metadataAPI, errors.KafkaUnknownTopicOrPartition)
+		}
+		if topic.Err != nil {
+			return nil, nil, toKafkaError(metadataAPI, topic.Err)
+		}
+		partition, ok := topic.Partitions[topicPartition.Partition]
+		if !ok {
+			return nil, nil, errors.KafkaRequestFailed(metadataAPI, errors.KafkaUnknownTopicOrPartition)
+		}
+		if partition.Leader < 0 {
+			return nil, nil, errors.KafkaRequestFailed(metadataAPI, errors.KafkaLeaderNotAvailable)
+		}
+		topicNamesByID[topic.ID] = topic.Topic
+
+		request, ok := requests[partition.Leader]
+		if !ok {
+			request = kmsg.NewPtrFetchRequest()
+			request.ReplicaID = -1
+			request.MaxWaitMillis = int32(maxWait.Milliseconds())
+			request.MinBytes = 1
+			request.MaxBytes = constant.KafkaClientFetchMaxBytes
+			request.SessionEpoch = -1
+			requests[partition.Leader] = request
+			requestTopics[partition.Leader] = make(map[string]int)
+		}
+		index, ok := requestTopics[partition.Leader][topic.Topic]
+		if !ok {
+			requestTopic := kmsg.NewFetchRequestTopic()
+			requestTopic.Topic = topic.Topic
+			requestTopic.TopicID = topic.ID
+			request.Topics = append(request.Topics, requestTopic)
+			index = len(request.Topics) - 1
+			requestTopics[partition.Leader][topic.Topic] = index
+		}
+		requestPartition := kmsg.NewFetchRequestTopicPartition()
+		requestPartition.Partition = topicPartition.Partition
+		requestPartition.FetchOffset = offset
+		requestPartition.PartitionMaxBytes = constant.KafkaClientFetchPartitionMaxBytes
+		request.Topics[index].Partitions = append(request.Topics[index].Partitions, requestPartition)
+	}
+
+	return requests, topicNamesByID, nil
+}
+
+// ProduceRecord appends a record to a topic and returns it with its partition and offset, the partition is chosen from
+// the hash of the key like the Java producer does when the record has a negative partition, or by the client without a key
+func (kc *KafkaClient) ProduceRecord(record models.KafkaRecord) (*models.KafkaRecord, error) {
+	var opts []kgo.Opt
+	if record.Partition >= 0 {
+		opts = append(opts, kgo.RecordPartitioner(kgo.ManualPartitioner()))
+	}
+	client, ctx, cancel, err := kc.newClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	defer cancel()
+
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now()
+	}
+	produced := &kgo.Record{
+		Topic:     record.Topic,
+		Partition: record.Partition,
+		Key:       record.Key,
+		Value:     record.Value,
+		Timestamp: record.Timestamp,
+	}
+	for _, header := range record.Headers {
+		produced.Headers = append(produced.Headers, kgo.RecordHeader{Key: header.Key, Value: header.Value})
+	}
+	if err := client.ProduceSync(ctx, produced).FirstErr(); err != nil {
+		return nil, toKafkaError(produceAPI, err)
+	}
+	record.Partition = produced.Partition
+	record.Offset = produced.Offset
+
+	return &record, nil
+}
+
+func toRecord(record *kgo.Record) models.KafkaRecord {
+	kafkaRecord := models.KafkaRecord{
+		Topic:     record.Topic,
+		Partition: record.Partition,
+		Offset:    record.Offset,
+		Timestamp: record.Timestamp,
+		Key:       record.Key,
+		Value:     record.Value,
+	}
+	for _, header := range record.Headers {
+		kafkaRecord.Headers = append(kafkaRecord.Headers, models.KafkaRecordHeader{Key: header.Key, Value: header.Value})
+	}
+
+	return kafkaRecord
+}
@@ -0,0 +1,431 @@
+package kafkaclient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+const testTopic = "folio.diku.inventory.instance"
+
+// newTestCluster starts an in-memory single broker cluster with the topics and returns a client bootstrapped from it
+func newTestCluster(t *testing.T, partitions int32, topics ...string) (*kfake.Cluster, *KafkaClient) {
+	t.Helper()
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions, topics...))
+	require.NoError(t, err)
+	t.Cleanup(cluster.Close)
+
+	host, port, err := net.SplitHostPort(cluster.ListenAddrs()[0])
+	require.NoError(t, err)
+	client := New(&action.Action{ConfigGlobalEnv: map[string]string{"kafka_host": host, "kafka_port": port}})
+	client.RequestTimeout = 5 * time.Second
+
+	return cluster, client
+}
+
+// produceTestRecords appends records with the given values to a partition of the topic
+func produceTestRecords(t *testing.T, client *KafkaClient, partition int32, values ...string) {
+	t.Helper()
+	for _, value := range values {
+		_, err := client.ProduceRecord(models.KafkaRecord{Topic: testTopic, Partition: partition, Value: []byte(value)})
+		require.NoError(t, err)
+	}
+}
+
+// joinTestGroup starts a consumer of the topic in the group and waits until the group is stable
+func joinTestGroup(t *testing.T, client *KafkaClient, groupID string) {
+	t.Helper()
+	consumer, err := kgo.NewClient(
+		kgo.SeedBrokers(client.GetBootstrapAddress()),
+		kgo.ClientID("consumer-1"),
+		kgo.ConsumerGroup(groupID),
+		kgo.ConsumeTopics(testTopic),
+	)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for ctx.Err() == nil {
+			consumer.PollFetches(ctx)
+		}
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		consumer.Close()
+	})
+
+	require.Eventually(t, func() bool {
+		group, err := client.DescribeConsumerGroup(groupID)
+		return err == nil && group.State == "Stable" && len(group.Members) == 1 && len(group.Members[0].Assignment) > 0
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func TestGetBootstrapAddress(t *testing.T) {
+	t.Run("TestGetBootstrapAddress_FromEnvironment", func(t *testing.T) {
+		// Arrange
+		client := New(&action.Action{ConfigGlobalEnv: map[string]string{"kafka_host": "localhost", "kafka_port": "29092"}})
+
+		// Act
+		address := client.GetBootstrapAddress()
+
+		// Assert
+		assert.Equal(t, "localhost:29092", address)
+	})
+
+	t.Run("TestGetBootstrapAddress_Default", func(t *testing.T) {
+		// Arrange
+		client := New(&action.Action{})
+
+		// Act
+		address := client.GetBootstrapAddress()
+
+		// Assert
+		assert.Equal(t, constant.KafkaTCP, address)
+	})
+}
+
+func TestGetAPIVersions(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 1)
+
+	// Act
+	versions, err := client.GetAPIVersions()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, versions, models.KafkaAPIVersion{APIKey: 18, MinVersion: 0, MaxVersion: 4})
+}
+
+func TestGetAPIVersions_ConnectionRefused(t *testing.T) {
+	// Arrange
+	cluster, client := newTestCluster(t, 1)
+	client.RequestTimeout = 500 * time.Millisecond
+	cluster.Close()
+
+	// Act
+	_, err := client.GetAPIVersions()
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestGetMetadata(t *testing.T) {
+	t.Run("TestGetMetadata_AllTopics", func(t *testing.T) {
+		// Arrange
+		cluster, client := newTestCluster(t, 2, testTopic)
+		leader := cluster.LeaderFor(testTopic, 0)
+
+		// Act
+		metadata, err := client.GetMetadata(nil)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, metadata.Brokers, 1)
+		assert.Equal(t, leader, metadata.Brokers[0].NodeID)
+		require.Len(t, metadata.Topics, 1)
+		assert.Equal(t, testTopic, metadata.Topics[0].Name)
+		assert.Equal(t, []models.KafkaPartition{{Partition: 0, Leader: leader}, {Partition: 1, Leader: leader}}, metadata.Topics[0].Partitions)
+	})
+
+	t.Run("TestGetMetadata_BrokersOnly", func(t *testing.T) {
+		// Arrange
+		_, client := newTestCluster(t, 2, testTopic)
+
+		// Act
+		metadata, err := client.GetMetadata([]string{})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Len(t, metadata.Brokers, 1)
+		assert.Empty(t, metadata.Topics)
+	})
+
+	t.Run("TestGetMetadata_UnknownTopic", func(t *testing.T) {
+		// Arrange
+		_, client := newTestCluster(t, 2, testTopic)
+
+		// Act
+		_, err := client.GetMetadata([]string{"folio.diku.missing"})
+
+		// Assert
+		assert.ErrorIs(t, err, &errors.KafkaError{Code: errors.KafkaUnknownTopicOrPartition})
+	})
+}
+
+func TestListConsumerGroups(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 2, testTopic)
+	joinTestGroup(t, client, "folio-group")
+
+	// Act
+	groupIDs, err := client.ListConsumerGroups()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"folio-group"}, groupIDs)
+}
+
+func TestDescribeConsumerGroup(t *testing.T) {
+	t.Run("TestDescribeConsumerGroup_Stable", func(t *testing.T) {
+		// Arrange
+		_, client := newTestCluster(t, 2, testTopic)
+		joinTestGroup(t, client, "folio-group")
+
+		// Act
+		group, err := client.DescribeConsumerGroup("folio-group")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "folio-group", group.GroupID)
+		assert.Equal(t, "consumer", group.ProtocolType)
+		require.Len(t, group.Members, 1)
+		assert.Equal(t, "consumer-1", group.Members[0].ClientID)
+		assert.ElementsMatch(t, []models.KafkaTopicPartition{{Topic: testTopic, Partition: 0}, {Topic: testTopic, Partition: 1}}, group.Members[0].Assignment)
+	})
+
+	t.Run("TestDescribeConsumerGroup_NotFound", func(t *testing.T) {
+		// Arrange
+		_, client := newTestCluster(t, 2, testTopic)
+
+		// Act
+		group, err := client.DescribeConsumerGroup("folio-missing")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, constant.KafkaGroupStateDead, group.State)
+		assert.Empty(t, group.Members)
+	})
+}
+
+func TestCommitOffsets(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 2, testTopic)
+	produceTestRecords(t, client, 1, "a", "b", "c")
+	topicPartition := models.KafkaTopicPartition{Topic: testTopic, Partition: 1}
+
+	// Act
+	err := client.CommitOffsets("folio-group", map[models.KafkaTopicPartition]int64{topicPartition: 2})
+
+	// Assert
+	require.NoError(t, err)
+	offsets, err := client.FetchCommittedOffsets("folio-group")
+	require.NoError(t, err)
+	assert.Equal(t, map[models.KafkaTopicPartition]int64{topicPartition: 2}, offsets)
+}
+
+func TestCommitOffsets_ActiveGroup(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 2, testTopic)
+	joinTestGroup(t, client, "folio-group")
+
+	// Act
+	err := client.CommitOffsets("folio-group", map[models.KafkaTopicPartition]int64{{Topic: testTopic}: 0})
+
+	// Assert
+	assert.ErrorIs(t, err, &errors.KafkaError{Code: errors.KafkaUnknownMemberID})
+}
+
+func TestFetchCommittedOffsets_NoOffsets(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 2, testTopic)
+
+	// Act
+	offsets, err := client.FetchCommittedOffsets("folio-group")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, offsets)
+}
+
+func TestListOffsets(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 2, testTopic)
+	produceTestRecords(t, client, 1, "a", "b", "c")
+	topicPartitions := []models.KafkaTopicPartition{{Topic: testTopic, Partition: 0}, {Topic: testTopic, Partition: 1}}
+
+	t.Run("TestListOffsets_Latest", func(t *testing.T) {
+		// Act
+		offsets, err := client.ListOffsets(topicPartitions, constant.KafkaOffsetLatest)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, map[models.KafkaTopicPartition]int64{topicPartitions[0]: 0, topicPartitions[1]: 3}, offsets)
+	})
+
+	t.Run("TestListOffsets_Earliest", func(t *testing.T) {
+		// Act
+		offsets, err := client.ListOffsets(topicPartitions, constant.KafkaOffsetEarliest)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, map[models.KafkaTopicPartition]int64{topicPartitions[0]: 0, topicPartitions[1]: 0}, offsets)
+	})
+}
+
+func TestListOffsets_UnknownPartition(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 1, testTopic)
+
+	// Act
+	_, err := client.ListOffsets([]models.KafkaTopicPartition{{Topic: testTopic, Partition: 5}}, constant.KafkaOffsetLatest)
+
+	// Assert
+	assert.ErrorIs(t, err, &errors.KafkaError{Code: errors.KafkaUnknownTopicOrPartition})
+}
+
+func TestListOffsets_NoPartitions(t *testing.T) {
+	// Arrange
+	client := New(&action.Action{})
+
+	// Act
+	offsets, err := client.ListOffsets(nil, constant.KafkaOffsetLatest)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, offsets)
+}
+
+func TestDeleteRecords(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 1, testTopic)
+	produceTestRecords(t, client, 0, "a", "b", "c")
+	topicPartition := models.KafkaTopicPartition{Topic: testTopic, Partition: 0}
+
+	// Act
+	lowWatermarks, err := client.DeleteRecords(map[models.KafkaTopicPartition]int64{topicPartition: constant.KafkaOffsetLatest})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[models.KafkaTopicPartition]int64{topicPartition: 3}, lowWatermarks)
+	offsets, err := client.ListOffsets([]models.KafkaTopicPartition{topicPartition}, constant.KafkaOffsetEarliest)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), offsets[topicPartition])
+}
+
+func TestDeleteRecords_NoPartitions(t *testing.T) {
+	// Arrange
+	client := New(&action.Action{})
+
+	// Act
+	lowWatermarks, err := client.DeleteRecords(nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, lowWatermarks)
+}
+
+func TestFetchRecords(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 2, testTopic)
+	_, err := client.ProduceRecord(models.KafkaRecord{
+		Topic:     testTopic,
+		Partition: 1,
+		Timestamp: time.UnixMilli(1000),
+		Key:       []byte("a1"),
+		Value:     []byte(`{}`),
+		Headers:   []models.KafkaRecordHeader{{Key: "x-okapi-tenant", Value: []byte("diku")}},
+	})
+	require.NoError(t, err)
+	produceTestRecords(t, client, 1, "b", "c")
+	offsets := map[models.KafkaTopicPartition]int64{{Topic: testTopic, Partition: 0}: 0, {Topic: testTopic, Partition: 1}: 0}
+
+	// Act
+	records, nextOffsets, err := client.FetchRecords(offsets, 100*time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, models.KafkaRecord{
+		Topic:     testTopic,
+		Partition: 1,
+		Offset:    0,
+		Timestamp: time.UnixMilli(1000),
+		Key:       []byte("a1"),
+		Value:     []byte(`{}`),
+		Headers:   []models.KafkaRecordHeader{{Key: "x-okapi-tenant", Value: []byte("diku")}},
+	}, records[0])
+	assert.Equal(t, []byte("c"), records[2].Value)
+	assert.Equal(t, map[models.KafkaTopicPartition]int64{{Topic: testTopic, Partition: 0}: 0, {Topic: testTopic, Partition: 1}: 3}, nextOffsets)
+}
+
+func TestFetchRecords_FromOffset(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 1, testTopic)
+	produceTestRecords(t, client, 0, "a", "b", "c")
+	topicPartition := models.KafkaTopicPartition{Topic: testTopic, Partition: 0}
+
+	// Act
+	records, nextOffsets, err := client.FetchRecords(map[models.KafkaTopicPartition]int64{topicPartition: 2}, 100*time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, int64(2), records[0].Offset)
+	assert.Equal(t, map[models.KafkaTopicPartition]int64{topicPartition: 3}, nextOffsets)
+}
+
+func TestFetchRecords_OffsetOutOfRange(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 1, testTopic)
+
+	// Act
+	_, _, err := client.FetchRecords(map[models.KafkaTopicPartition]int64{{Topic: testTopic, Partition: 0}: 99}, 100*time.Millisecond)
+
+	// Assert
+	assert.ErrorIs(t, err, errors.KafkaRequestFailed(fetchAPI, errors.KafkaOffsetOutOfRange))
+}
+
+func TestProduceRecord(t *testing.T) {
+	t.Run("TestProduceRecord_KeyPartition", func(t *testing.T) {
+		// Arrange
+		_, client := newTestCluster(t, 4, testTopic)
+		record := models.KafkaRecord{Topic: testTopic, Partition: -1, Key: []byte("abc"), Value: []byte(`{"type":"CREATE"}`)}
+
+		// Act
+		result, err := client.ProduceRecord(record)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), result.Partition, "keys are partitioned like the Java producer does")
+		assert.Equal(t, int64(0), result.Offset)
+		assert.False(t, result.Timestamp.IsZero())
+	})
+
+	t.Run("TestProduceRecord_ExplicitPartition", func(t *testing.T) {
+		// Arrange
+		_, client := newTestCluster(t, 4, testTopic)
+		produceTestRecords(t, client, 2, "a")
+
+		// Act
+		result, err := client.ProduceRecord(models.KafkaRecord{Topic: testTopic, Partition: 2, Key: []byte("abc"), Value: []byte("b")})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), result.Partition)
+		assert.Equal(t, int64(1), result.Offset)
+	})
+}
+
+func TestProduceRecord_TopicNotFound(t *testing.T) {
+	// Arrange
+	_, client := newTestCluster(t, 1, testTopic)
+
+	// Act
+	result, err := client.ProduceRecord(models.KafkaRecord{Topic: "folio.diku.missing", Partition: -1})
+
+	// Assert
+	assert.ErrorIs(t, err, &errors.KafkaError{Code: errors.KafkaUnknownTopicOrPartition})
+	assert.Nil(t, result)
+}
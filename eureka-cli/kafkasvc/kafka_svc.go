@@ -1,22 +1,26 @@
 package kafkasvc
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
-	"os/exec"
-	"strconv"
+	"net"
 	"strings"
 	"time"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
-	"github.com/folio-org/eureka-setup/eureka-cli/errors"
-	"github.com/folio-org/eureka-setup/eureka-cli/execsvc"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/kafkaclient"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
 
 // KafkaProcessor defines the interface for Kafka service operations
 type KafkaProcessor interface {
+	KafkaTopicManager
+	KafkaConsumerGroupManager
+	KafkaMessageManager
 	CheckBrokerReadiness() error
 	PollConsumerGroup(tenantName string) error
 }
@@ -24,7 +28,7 @@ type KafkaProcessor interface {
 // KafkaSvc provides functionality for Kafka operations including health checks and consumer lag monitoring
 type KafkaSvc struct {
 	Action           *action.Action
-	ExecSvc          execsvc.CommandRunner
+	KafkaClient      kafkaclient.KafkaClientRunner
 	RebalanceRetries int
 	PollMaxRetries   int
 	RebalanceWait    time.Duration
@@ -33,21 +37,20 @@ type KafkaSvc struct {
 }
 
 // New creates a new KafkaSvc instance
-func New(action *action.Action, execSvc execsvc.CommandRunner) *KafkaSvc {
+func New(action *action.Action, kafkaClient kafkaclient.KafkaClientRunner) *KafkaSvc {
 	return &KafkaSvc{
-		Action:  action,
-		ExecSvc: execSvc,
+		Action:      action,
+		KafkaClient: kafkaClient,
 	}
 }
 
 func (ks *KafkaSvc) CheckBrokerReadiness() error {
-	kafkaCmd := fmt.Sprintf("timeout 30s kafka-broker-api-versions.sh --bootstrap-server %s", constant.KafkaTCP)
-	stdout, stderr, err := ks.ExecSvc.ExecReturnOutput(exec.Command("docker", "exec", "-i", "kafka-tools", "bash", "-c", kafkaCmd))
-	if err != nil || stderr.Len() > 0 {
-		return errors.KafkaNotReady(err)
+	versions, err := ks.KafkaClient.GetAPIVersions()
+	if err != nil {
+		return appErrors.KafkaNotReady(err)
 	}
-	if stdout.Len() == 0 {
-		return errors.KafkaBrokerAPIFailed()
+	if len(versions) == 0 {
+		return appErrors.KafkaBrokerAPIFailed()
 	}
 	slog.Info(ks.Action.Name, "text", "Broker is ready and accessible")
 
@@ -55,12 +58,17 @@ func (ks *KafkaSvc) CheckBrokerReadiness() error {
 }
 
 func (ks *KafkaSvc) PollConsumerGroup(tenantName string) error {
+	if ks.Action.IsDryRun() {
+		slog.Info(ks.Action.Name, "text", "Skipping consumer group polling in dry run mode", "tenant", tenantName)
+		return nil
+	}
+
 	slog.Info(ks.Action.Name, "text", "Preparing broker readiness check")
 	if err := ks.CheckBrokerReadiness(); err != nil {
 		slog.Warn(ks.Action.Name, "text", "Broker is not fully ready", "error", err)
 	}
 
-	consumerGroup := fmt.Sprintf("%s-%s", ks.Action.ConfigEnvFolio, constant.ConsumerGroupSuffix)
+	consumerGroup := ks.getConsumerGroup("")
 	slog.Info(ks.Action.Name, "text", "Polling consumer group", "consumerGroup", consumerGroup, "tenant", tenantName)
 
 	var lag int
@@ -74,7 +82,7 @@ func (ks *KafkaSvc) PollConsumerGroup(tenantName string) error {
 		if err != nil {
 			rebalanceRetryCount++
 			if rebalanceRetryCount >= rebalanceMaxRetries {
-				return errors.ConsumerGroupRebalanceTimeout(consumerGroup, err)
+				return appErrors.ConsumerGroupRebalanceTimeout(consumerGroup, err)
 			}
 
 			slog.Warn(ks.Action.Name, "text", "Waiting for consumer group to rebalance", "count", rebalanceRetryCount, "max", rebalanceMaxRetries)
@@ -92,39 +100,90 @@ func (ks *KafkaSvc) PollConsumerGroup(tenantName string) error {
 		time.Sleep(pollWait)
 	}
 
-	return errors.ConsumerGroupPollTimeout(consumerGroup, pollMaxRetries)
+	return appErrors.ConsumerGroupPollTimeout(consumerGroup, pollMaxRetries)
 }
 
+// getConsumerGroupLag sums the lag of the consumer group over the partitions of the tenant topics, the previous lag
+// is kept while the group has no active members, is rebalancing or the broker is temporarily unavailable
 func (ks *KafkaSvc) getConsumerGroupLag(tenant string, consumerGroup string, initialLag int) (lag int, err error) {
 	rebalanceWait := helpers.DefaultDuration(ks.RebalanceWait, constant.AttachCapabilitySetsRebalanceWait)
-	timeoutWait := helpers.DefaultDuration(ks.TimeoutWait, constant.AttachCapabilitySetsTimeoutWait)
 
-	kafkaCmd := fmt.Sprintf("timeout 30s kafka-consumer-groups.sh --bootstrap-server %s --describe --group %s | grep %s | awk '{print $6}'", constant.KafkaTCP, consumerGroup, tenant)
-	stdout, stderr, err := ks.ExecSvc.ExecReturnOutput(exec.Command("docker", "exec", "-i", "kafka-tools", "bash", "-c", kafkaCmd))
+	group, err := ks.KafkaClient.DescribeConsumerGroup(consumerGroup)
 	if err != nil {
-		return initialLag, err
+		return ks.handleConsumerGroupError(initialLag, err)
+	}
+	switch group.State {
+	case constant.KafkaGroupStateDead:
+		return initialLag, appErrors.ConsumerGroupNotFound(consumerGroup)
+	case constant.KafkaGroupStateEmpty, constant.KafkaGroupStatePreparingRebalance, constant.KafkaGroupStateCompletingRebalance:
+		slog.Debug(ks.Action.Name, "text", "Consumer group has no active members or is rebalancing", "consumerGroup", consumerGroup, "state", group.State)
+		time.Sleep(rebalanceWait)
+		return initialLag, nil
 	}
 
-	if stderr.Len() > 0 {
-		stderrText := stderr.String()
-		if strings.Contains(stderrText, constant.ErrNoActiveMembers) ||
-			strings.Contains(stderrText, constant.ErrRebalancing) {
-			time.Sleep(rebalanceWait)
-			return initialLag, nil
-		}
-		if strings.Contains(stderrText, constant.ErrTimeoutException) {
-			time.Sleep(timeoutWait)
-			return initialLag, nil
+	committedOffsets, err := ks.KafkaClient.FetchCommittedOffsets(consumerGroup)
+	if err != nil {
+		return ks.handleConsumerGroupError(initialLag, err)
+	}
+	var tenantPartitions []models.KafkaTopicPartition
+	for topicPartition := range committedOffsets {
+		if IsTenantTopic(topicPartition.Topic, tenant) {
+			tenantPartitions = append(tenantPartitions, topicPartition)
 		}
-
-		return initialLag, errors.ContainerCommandFailed(stderrText)
 	}
 
-	lag, err = strconv.Atoi(helpers.GetKafkaConsumerLagFromLogLine(stdout))
+	endOffsets, err := ks.KafkaClient.ListOffsets(tenantPartitions, constant.KafkaOffsetLatest)
 	if err != nil {
-		slog.Error(ks.Action.Name, "error", err)
-		return initialLag, nil
+		return ks.handleConsumerGroupError(initialLag, err)
+	}
+	for _, topicPartition := range tenantPartitions {
+		if partitionLag := endOffsets[topicPartition] - committedOffsets[topicPartition]; partitionLag > 0 {
+			lag += int(partitionLag)
+		}
 	}
 
 	return lag, nil
 }
+
+// handleConsumerGroupError keeps the previous lag when the broker timed out or the group coordinator
+// or the partition leaders are moving, any other error is returned
+func (ks *KafkaSvc) handleConsumerGroupError(initialLag int, err error) (int, error) {
+	var (
+		netErr   net.Error
+		kafkaErr *appErrors.KafkaError
+	)
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		time.Sleep(helpers.DefaultDuration(ks.TimeoutWait, constant.AttachCapabilitySetsTimeoutWait))
+		return initialLag, nil
+	}
+	if errors.As(err, &kafkaErr) && kafkaErr.Retriable() {
+		time.Sleep(helpers.DefaultDuration(ks.RebalanceWait, constant.AttachCapabilitySetsRebalanceWait))
+		return initialLag, nil
+	}
+
+	return initialLag, err
+}
+
+// getConsumerGroup returns the given consumer group or the capability set consumer group of the environment
+func (ks *KafkaSvc) getConsumerGroup(groupID string) string {
+	if groupID != "" {
+		return groupID
+	}
+
+	return fmt.Sprintf("%s-%s", ks.Action.ConfigEnvFolio, constant.ConsumerGroupSuffix)
+}
+
+// GetTopicPrefix returns the <ENV>. prefix of the topics of the environment or the <ENV>.<tenant>. prefix of the tenant
+// topics, which are shared by all tenants when the management modules use topic sharing
+func (ks *KafkaSvc) GetTopicPrefix(tenantName string) string {
+	if tenantName == "" {
+		return fmt.Sprintf("%s.", ks.Action.ConfigEnvFolio)
+	}
+
+	return fmt.Sprintf("%s.%s.", ks.Action.ConfigEnvFolio, ks.Action.GetKafkaTopicConfigTenant(tenantName))
+}
+
+// IsTenantTopic reports whether a topic named <ENV>.<tenant>.<topic> belongs to the tenant
+func IsTenantTopic(topic string, tenant string) bool {
+	return strings.Contains(topic, fmt.Sprintf(".%s.", tenant))
+}
@@ -0,0 +1,218 @@
+package kafkasvc
+
+import (
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// KafkaConsumerGroupManager defines the interface for Kafka consumer group operations
+type KafkaConsumerGroupManager interface {
+	ListConsumerGroups() ([]string, error)
+	DescribeConsumerGroup(groupID, tenantName string) (*models.KafkaConsumerGroupDescription, error)
+	ResetOffsets(groupID, tenantName string, timestamp int64) ([]models.KafkaOffsetReset, error)
+	RestoreOffsets(groupID string, offsets map[models.KafkaTopicPartition]int64) ([]models.KafkaOffsetReset, error)
+}
+
+// ListConsumerGroups returns the sorted ids of all consumer groups of the cluster
+func (ks *KafkaSvc) ListConsumerGroups() ([]string, error) {
+	return ks.KafkaClient.ListConsumerGroups()
+}
+
+// DescribeConsumerGroup returns the members of a consumer group with its offsets and lag for the partitions it has consumed
+// or been assigned, optionally limited to the tenant topics, an empty group id selects the capability set consumer group
+func (ks *KafkaSvc) DescribeConsumerGroup(groupID, tenantName string) (*models.KafkaConsumerGroupDescription, error) {
+	groupID = ks.getConsumerGroup(groupID)
+	group, err := ks.KafkaClient.DescribeConsumerGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.State == constant.KafkaGroupStateDead {
+		return nil, appErrors.ConsumerGroupNotFound(groupID)
+	}
+
+	committedOffsets, err := ks.KafkaClient.FetchCommittedOffsets(groupID)
+	if err != nil {
+		return nil, err
+	}
+	clientIDs := make(map[models.KafkaTopicPartition]string)
+	for _, member := range group.Members {
+		for _, topicPartition := range member.Assignment {
+			clientIDs[topicPartition] = member.ClientID
+		}
+	}
+
+	var topicPartitions []models.KafkaTopicPartition
+	for topicPartition := range committedOffsets {
+		topicPartitions = append(topicPartitions, topicPartition)
+	}
+	for topicPartition := range clientIDs {
+		if _, ok := committedOffsets[topicPartition]; !ok {
+			topicPartitions = append(topicPartitions, topicPartition)
+		}
+	}
+	topicPartitions = ks.filterTopicPartitions(topicPartitions, tenantName)
+
+	endOffsets, err := ks.KafkaClient.ListOffsets(topicPartitions, constant.KafkaOffsetLatest)
+	if err != nil {
+		return nil, err
+	}
+
+	description := &models.KafkaConsumerGroupDescription{KafkaConsumerGroup: *group, Offsets: []models.KafkaPartitionOffset{}}
+	for _, topicPartition := range topicPartitions {
+		offset := models.KafkaPartitionOffset{
+			Topic:           topicPartition.Topic,
+			Partition:       topicPartition.Partition,
+			CommittedOffset: -1,
+			EndOffset:       endOffsets[topicPartition],
+			Lag:             -1,
+			ClientID:        clientIDs[topicPartition],
+		}
+		if committedOffset, ok := committedOffsets[topicPartition]; ok {
+			offset.CommittedOffset = committedOffset
+			offset.Lag = max(offset.EndOffset-committedOffset, 0)
+			description.Lag += offset.Lag
+		}
+		description.Offsets = append(description.Offsets, offset)
+	}
+
+	return description, nil
+}
+
+// ResetOffsets moves the committed offsets of a consumer group to the offsets at the given timestamp, optionally limited to
+// the tenant topics, use constant.KafkaOffsetEarliest to reprocess all retained messages and constant.KafkaOffsetLatest to skip them,
+// the group must have no active members
+func (ks *KafkaSvc) ResetOffsets(groupID, tenantName string, timestamp int64) ([]models.KafkaOffsetReset, error) {
+	groupID = ks.getConsumerGroup(groupID)
+	group, err := ks.KafkaClient.DescribeConsumerGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+	switch group.State {
+	case constant.KafkaGroupStateDead:
+		return nil, appErrors.ConsumerGroupNotFound(groupID)
+	case constant.KafkaGroupStateEmpty:
+	default:
+		return nil, appErrors.ConsumerGroupActive(groupID, group.State, len(group.Members))
+	}
+
+	committedOffsets, err := ks.KafkaClient.FetchCommittedOffsets(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var topicPartitions []models.KafkaTopicPartition
+	for topicPartition := range committedOffsets {
+		topicPartitions = append(topicPartitions, topicPartition)
+	}
+	topicPartitions = ks.filterTopicPartitions(topicPartitions, tenantName)
+
+	newOffsets, err := ks.KafkaClient.ListOffsets(topicPartitions, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	resets := make([]models.KafkaOffsetReset, 0, len(topicPartitions))
+	for _, topicPartition := range topicPartitions {
+		resets = append(resets, models.KafkaOffsetReset{
+			Topic:          topicPartition.Topic,
+			Partition:      topicPartition.Partition,
+			PreviousOffset: committedOffsets[topicPartition],
+			NewOffset:      newOffsets[topicPartition],
+		})
+	}
+	if ks.Action.IsDryRun() {
+		slog.Info(ks.Action.Name, "text", "Skipping offset reset in dry run mode", "consumerGroup", groupID, "partitions", len(resets))
+		return resets, nil
+	}
+
+	if err := ks.KafkaClient.CommitOffsets(groupID, newOffsets); err != nil {
+		return nil, err
+	}
+	slog.Info(ks.Action.Name, "text", "Reset consumer group offsets", "consumerGroup", groupID, "partitions", len(resets))
+
+	return resets, nil
+}
+
+// RestoreOffsets commits previously saved offsets of a consumer group, moving every offset into the range of the messages
+// still retained by its partition, constant.KafkaOffsetLatest moves the offset to the end of the partition,
+// the group must have no active members but may have expired since the offsets were saved
+func (ks *KafkaSvc) RestoreOffsets(groupID string, offsets map[models.KafkaTopicPartition]int64) ([]models.KafkaOffsetReset, error) {
+	group, err := ks.KafkaClient.DescribeConsumerGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group.State != constant.KafkaGroupStateEmpty && group.State != constant.KafkaGroupStateDead {
+		return nil, appErrors.ConsumerGroupActive(groupID, group.State, len(group.Members))
+	}
+
+	committedOffsets, err := ks.KafkaClient.FetchCommittedOffsets(groupID)
+	if err != nil {
+		return nil, err
+	}
+	topicPartitions := make([]models.KafkaTopicPartition, 0, len(offsets))
+	for topicPartition := range offsets {
+		topicPartitions = append(topicPartitions, topicPartition)
+	}
+	topicPartitions = ks.filterTopicPartitions(topicPartitions, "")
+
+	earliestOffsets, err := ks.KafkaClient.ListOffsets(topicPartitions, constant.KafkaOffsetEarliest)
+	if err != nil {
+		return nil, err
+	}
+	latestOffsets, err := ks.KafkaClient.ListOffsets(topicPartitions, constant.KafkaOffsetLatest)
+	if err != nil {
+		return nil, err
+	}
+	newOffsets := make(map[models.KafkaTopicPartition]int64, len(topicPartitions))
+	resets := make([]models.KafkaOffsetReset, 0, len(topicPartitions))
+	for _, topicPartition := range topicPartitions {
+		if offsets[topicPartition] == constant.KafkaOffsetLatest {
+			newOffsets[topicPartition] = latestOffsets[topicPartition]
+		} else {
+			newOffsets[topicPartition] = min(max(offsets[topicPartition], earliestOffsets[topicPartition]), latestOffsets[topicPartition])
+		}
+		previousOffset, ok := committedOffsets[topicPartition]
+		if !ok {
+			previousOffset = -1
+		}
+		resets = append(resets, models.KafkaOffsetReset{
+			Topic:          topicPartition.Topic,
+			Partition:      topicPartition.Partition,
+			PreviousOffset: previousOffset,
+			NewOffset:      newOffsets[topicPartition],
+		})
+	}
+	if ks.Action.IsDryRun() {
+		slog.Info(ks.Action.Name, "text", "Skipping offset restore in dry run mode", "consumerGroup", groupID, "partitions", len(resets))
+		return resets, nil
+	}
+
+	if err := ks.KafkaClient.CommitOffsets(groupID, newOffsets); err != nil {
+		return nil, err
+	}
+	slog.Info(ks.Action.Name, "text", "Restored consumer group offsets", "consumerGroup", groupID, "partitions", len(resets))
+
+	return resets, nil
+}
+
+// filterTopicPartitions keeps the partitions of the tenant topics when a tenant is given and sorts them by topic and partition
+func (ks *KafkaSvc) filterTopicPartitions(topicPartitions []models.KafkaTopicPartition, tenantName string) []models.KafkaTopicPartition {
+	filtered := make([]models.KafkaTopicPartition, 0, len(topicPartitions))
+	prefix := ks.GetTopicPrefix(tenantName)
+	for _, topicPartition := range topicPartitions {
+		if tenantName == "" || strings.HasPrefix(topicPartition.Topic, prefix) {
+			filtered = append(filtered, topicPartition)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		if filtered[i].Topic != filtered[j].Topic {
+			return filtered[i].Topic < filtered[j].Topic
+		}
+		return filtered[i].Partition < filtered[j].Partition
+	})
+
+	return filtered
+}
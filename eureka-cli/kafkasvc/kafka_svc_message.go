@@ -0,0 +1,162 @@
+package kafkasvc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// KafkaMessageManager defines the interface for reading and writing Kafka messages
+type KafkaMessageManager interface {
+	GetTopicName(topic, tenantName string) string
+	TailTopic(ctx context.Context, topicName string, fromBeginning bool, handler func(record models.KafkaRecord)) error
+	PublishMessage(record models.KafkaRecord) (*models.KafkaRecord, error)
+}
+
+// GetTopicName returns the full <ENV>.<tenant>.<topic> name of a tenant topic, the topic is returned as is
+// when no tenant is given or it already carries the environment prefix
+func (ks *KafkaSvc) GetTopicName(topic, tenantName string) string {
+	if tenantName == "" || strings.HasPrefix(topic, ks.GetTopicPrefix("")) {
+		return topic
+	}
+
+	return ks.GetTopicPrefix(tenantName) + topic
+}
+
+// TailTopic passes the messages of all partitions of a topic to the handler until the context is cancelled, starting
+// with the retained messages when fromBeginning is set or with the new messages otherwise
+func (ks *KafkaSvc) TailTopic(ctx context.Context, topicName string, fromBeginning bool, handler func(record models.KafkaRecord)) error {
+	metadata, err := ks.KafkaClient.GetMetadata([]string{topicName})
+	if err != nil {
+		return ks.handleTopicError(topicName, err)
+	}
+	if len(metadata.Topics) == 0 || len(metadata.Topics[0].Partitions) == 0 {
+		return appErrors.KafkaTopicNotFound(topicName)
+	}
+
+	var topicPartitions []models.KafkaTopicPartition
+	for _, partition := range metadata.Topics[0].Partitions {
+		topicPartitions = append(topicPartitions, models.KafkaTopicPartition{Topic: topicName, Partition: partition.Partition})
+	}
+	startTimestamp := int64(constant.KafkaOffsetLatest)
+	if fromBeginning {
+		startTimestamp = constant.KafkaOffsetEarliest
+	}
+	offsets, err := ks.KafkaClient.ListOffsets(topicPartitions, startTimestamp)
+	if err != nil {
+		return err
+	}
+	slog.Info(ks.Action.Name, "text", "Tailing topic", "topic", topicName, "partitions", len(topicPartitions), "fromBeginning", fromBeginning)
+
+	for ctx.Err() == nil {
+		records, nextOffsets, err := ks.KafkaClient.FetchRecords(offsets, constant.KafkaClientFetchMaxWait)
+		if err != nil {
+			if offsets, err = ks.handleFetchError(offsets, err); err != nil {
+				return err
+			}
+			continue
+		}
+
+		offsets = nextOffsets
+		sort.SliceStable(records, func(i, j int) bool {
+			if !records[i].Timestamp.Equal(records[j].Timestamp) {
+				return records[i].Timestamp.Before(records[j].Timestamp)
+			}
+			if records[i].Partition != records[j].Partition {
+				return records[i].Partition < records[j].Partition
+			}
+			return records[i].Offset < records[j].Offset
+		})
+		for _, record := range records {
+			if ctx.Err() != nil {
+				break
+			}
+			handler(record)
+		}
+	}
+
+	return nil
+}
+
+// PublishMessage appends a message to a topic and returns it with the partition and offset assigned by the broker
+func (ks *KafkaSvc) PublishMessage(record models.KafkaRecord) (*models.KafkaRecord, error) {
+	if ks.Action.IsDryRun() {
+		slog.Info(ks.Action.Name, "text", "Skipping publishing of message in dry run mode", "topic", record.Topic, "bytes", len(record.Value))
+		return &record, nil
+	}
+
+	published, err := ks.KafkaClient.ProduceRecord(record)
+	if err != nil {
+		return nil, ks.handleTopicError(record.Topic, err)
+	}
+	slog.Info(ks.Action.Name, "text", "Published message", "topic", published.Topic, "partition", published.Partition, "offset", published.Offset)
+
+	return published, nil
+}
+
+// handleFetchError moves the offsets that fell out of the retained range back into it and waits when the broker timed out
+// or the partition leaders are moving, the offsets to continue from are returned or any other error
+func (ks *KafkaSvc) handleFetchError(offsets map[models.KafkaTopicPartition]int64, err error) (map[models.KafkaTopicPartition]int64, error) {
+	var (
+		netErr   net.Error
+		kafkaErr *appErrors.KafkaError
+	)
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		time.Sleep(helpers.DefaultDuration(ks.TimeoutWait, constant.AttachCapabilitySetsTimeoutWait))
+		return offsets, nil
+	}
+	if !errors.As(err, &kafkaErr) {
+		return offsets, err
+	}
+	if kafkaErr.Retriable() {
+		time.Sleep(helpers.DefaultDuration(ks.RebalanceWait, constant.AttachCapabilitySetsRebalanceWait))
+		return offsets, nil
+	}
+	if kafkaErr.Code != appErrors.KafkaOffsetOutOfRange {
+		return offsets, err
+	}
+
+	return ks.clampOffsets(offsets)
+}
+
+// clampOffsets moves every offset into the range between the log start and the log end offset of its partition
+func (ks *KafkaSvc) clampOffsets(offsets map[models.KafkaTopicPartition]int64) (map[models.KafkaTopicPartition]int64, error) {
+	topicPartitions := make([]models.KafkaTopicPartition, 0, len(offsets))
+	for topicPartition := range offsets {
+		topicPartitions = append(topicPartitions, topicPartition)
+	}
+	earliestOffsets, err := ks.KafkaClient.ListOffsets(topicPartitions, constant.KafkaOffsetEarliest)
+	if err != nil {
+		return offsets, err
+	}
+	latestOffsets, err := ks.KafkaClient.ListOffsets(topicPartitions, constant.KafkaOffsetLatest)
+	if err != nil {
+		return offsets, err
+	}
+
+	clamped := make(map[models.KafkaTopicPartition]int64, len(offsets))
+	for topicPartition, offset := range offsets {
+		clamped[topicPartition] = min(max(offset, earliestOffsets[topicPartition]), latestOffsets[topicPartition])
+	}
+	slog.Warn(ks.Action.Name, "text", "Offsets are out of the retained range, continuing from the nearest retained messages")
+
+	return clamped, nil
+}
+
+// handleTopicError reports an unknown topic as not found, any other error is returned as is
+func (ks *KafkaSvc) handleTopicError(topicName string, err error) error {
+	if errors.Is(err, &appErrors.KafkaError{Code: appErrors.KafkaUnknownTopicOrPartition}) {
+		return appErrors.KafkaTopicNotFound(topicName)
+	}
+
+	return err
+}
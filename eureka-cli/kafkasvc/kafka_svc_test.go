@@ -1,325 +1,888 @@
 package kafkasvc
 
 import (
-	"bytes"
+	"context"
 	"fmt"
-	"os/exec"
+	"os"
 	"testing"
 	"time"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+const (
+	testTenant        = "diku"
+	testConsumerGroup = "test-env-consumer-group"
+)
+
+var (
+	tenantPartition = models.KafkaTopicPartition{Topic: "folio.diku.mgr-tenant-entitlements.capability", Partition: 0}
+	otherPartition  = models.KafkaTopicPartition{Topic: "folio.other.mgr-tenant-entitlements.capability", Partition: 0}
+)
+
+func newTestSvc() (*KafkaSvc, *testhelpers.MockKafkaClient) {
+	action := testhelpers.NewMockAction()
+	action.ConfigEnvFolio = "test-env"
+	mockClient := new(testhelpers.MockKafkaClient)
+	svc := New(action, mockClient)
+	svc.PollMaxRetries = 3
+	svc.PollWait = 1 * time.Millisecond
+	svc.RebalanceRetries = 2
+	svc.RebalanceWait = 1 * time.Millisecond
+	svc.TimeoutWait = 1 * time.Millisecond
+
+	return svc, mockClient
+}
+
 func TestNew(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
+	mockClient := new(testhelpers.MockKafkaClient)
 
 	// Act
-	svc := New(action, mockExec)
+	svc := New(action, mockClient)
 
 	// Assert
 	assert.NotNil(t, svc)
 	assert.Equal(t, action, svc.Action)
-	assert.Equal(t, mockExec, svc.ExecSvc)
+	assert.Equal(t, mockClient, svc.KafkaClient)
 }
 
 func TestCheckBrokerReadiness_Success(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
-
-	stdout := bytes.NewBufferString("broker version info")
-	stderr := bytes.NewBuffer(nil)
-
-	mockExec.On("ExecReturnOutput", mock.MatchedBy(func(cmd *exec.Cmd) bool {
-		return len(cmd.Args) >= 4 &&
-			cmd.Args[0] == "docker" &&
-			cmd.Args[1] == "exec" &&
-			cmd.Args[2] == "-i" &&
-			cmd.Args[3] == "kafka-tools"
-	})).Return(*stdout, *stderr, nil).Once()
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetAPIVersions").Return([]models.KafkaAPIVersion{{APIKey: 18, MinVersion: 0, MaxVersion: 4}}, nil).Once()
 
 	// Act
 	err := svc.CheckBrokerReadiness()
 
 	// Assert
 	assert.NoError(t, err)
-	mockExec.AssertExpectations(t)
+	mockClient.AssertExpectations(t)
 }
 
-func TestCheckBrokerReadiness_CommandError(t *testing.T) {
+func TestCheckBrokerReadiness_ConnectionError(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
+	svc, mockClient := newTestSvc()
+	connErr := fmt.Errorf("connection refused")
+	mockClient.On("GetAPIVersions").Return(nil, connErr).Once()
 
-	stdout := bytes.NewBuffer(nil)
-	stderr := bytes.NewBuffer(nil)
-	cmdErr := fmt.Errorf("command execution failed")
+	// Act
+	err := svc.CheckBrokerReadiness()
 
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*stdout, *stderr, cmdErr).Once()
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, errors.KafkaNotReady(connErr), err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestCheckBrokerReadiness_NoAPIVersions(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetAPIVersions").Return([]models.KafkaAPIVersion{}, nil).Once()
 
 	// Act
 	err := svc.CheckBrokerReadiness()
 
 	// Assert
 	assert.Error(t, err)
-	assert.Equal(t, errors.KafkaNotReady(cmdErr), err)
-	mockExec.AssertExpectations(t)
+	assert.Equal(t, errors.KafkaBrokerAPIFailed(), err)
+	mockClient.AssertExpectations(t)
 }
 
-func TestCheckBrokerReadiness_StderrPresent(t *testing.T) {
+func TestPollConsumerGroup_ZeroLag(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetAPIVersions").Return([]models.KafkaAPIVersion{{APIKey: 18}}, nil).Once()
+	mockClient.On("DescribeConsumerGroup", "test-env-"+constant.ConsumerGroupSuffix).
+		Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", "test-env-"+constant.ConsumerGroupSuffix).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 10}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{tenantPartition}, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 10}, nil).Once()
+
+	// Act
+	err := svc.PollConsumerGroup(testTenant)
 
-	stdout := bytes.NewBuffer(nil)
-	stderr := bytes.NewBufferString("some error")
+	// Assert
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
 
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*stdout, *stderr, nil).Once()
+func TestPollConsumerGroup_LagDecreases(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetAPIVersions").Return([]models.KafkaAPIVersion{{APIKey: 18}}, nil).Once()
+	mockClient.On("DescribeConsumerGroup", mock.Anything).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable}, nil).Twice()
+	mockClient.On("FetchCommittedOffsets", mock.Anything).Return(map[models.KafkaTopicPartition]int64{tenantPartition: 5}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", mock.Anything).Return(map[models.KafkaTopicPartition]int64{tenantPartition: 10}, nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, mock.Anything).Return(map[models.KafkaTopicPartition]int64{tenantPartition: 10}, nil).Twice()
 
 	// Act
-	err := svc.CheckBrokerReadiness()
+	err := svc.PollConsumerGroup(testTenant)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, errors.KafkaNotReady(nil), err)
-	mockExec.AssertExpectations(t)
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
 }
 
-func TestCheckBrokerReadiness_EmptyStdout(t *testing.T) {
+func TestPollConsumerGroup_Timeout(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetAPIVersions").Return([]models.KafkaAPIVersion{{APIKey: 18}}, nil).Once()
+	mockClient.On("DescribeConsumerGroup", mock.Anything).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable}, nil)
+	mockClient.On("FetchCommittedOffsets", mock.Anything).Return(map[models.KafkaTopicPartition]int64{tenantPartition: 5}, nil)
+	mockClient.On("ListOffsets", mock.Anything, mock.Anything).Return(map[models.KafkaTopicPartition]int64{tenantPartition: 10}, nil)
 
-	stdout := bytes.NewBuffer(nil)
-	stderr := bytes.NewBuffer(nil)
+	// Act
+	err := svc.PollConsumerGroup(testTenant)
 
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*stdout, *stderr, nil).Once()
+	// Assert
+	assert.Equal(t, errors.ConsumerGroupPollTimeout("test-env-"+constant.ConsumerGroupSuffix, 3), err)
+	mockClient.AssertNumberOfCalls(t, "DescribeConsumerGroup", 3)
+}
+
+func TestPollConsumerGroup_RebalanceError(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetAPIVersions").Return([]models.KafkaAPIVersion{{APIKey: 18}}, nil).Once()
+	mockClient.On("DescribeConsumerGroup", mock.Anything).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateDead}, nil)
 
 	// Act
-	err := svc.CheckBrokerReadiness()
+	err := svc.PollConsumerGroup(testTenant)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, errors.KafkaBrokerAPIFailed(), err)
-	mockExec.AssertExpectations(t)
+	consumerGroup := "test-env-" + constant.ConsumerGroupSuffix
+	assert.Equal(t, errors.ConsumerGroupRebalanceTimeout(consumerGroup, errors.ConsumerGroupNotFound(consumerGroup)), err)
+	mockClient.AssertNumberOfCalls(t, "DescribeConsumerGroup", 2)
 }
 
-func TestPollConsumerGroup_ZeroLag(t *testing.T) {
+func TestPollConsumerGroup_DryRun(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	action.ConfigEnvFolio = "test-env"
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
-	svc.PollMaxRetries = 3
-	svc.PollWait = 1 * time.Millisecond
-	svc.RebalanceRetries = 2
-	svc.RebalanceWait = 1 * time.Millisecond
+	svc, mockClient := newTestSvc()
+	svc.Action.Param.DryRun = true
 
-	tenantName := "diku"
+	// Act
+	err := svc.PollConsumerGroup(testTenant)
 
-	// Mock CheckBrokerReadiness call - broker ready
-	stdout := bytes.NewBufferString("broker ready")
-	stderr := bytes.NewBuffer(nil)
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*stdout, *stderr, nil).Once()
+	// Assert
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
 
-	// Mock getConsumerGroupLag returning 0
-	lagStdout := bytes.NewBufferString("0\n")
-	lagStderr := bytes.NewBuffer(nil)
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
+func TestGetConsumerGroupLag_Success(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	secondPartition := models.KafkaTopicPartition{Topic: tenantPartition.Topic, Partition: 1}
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 8, secondPartition: 100, otherPartition: 0}, nil).Once()
+	mockClient.On("ListOffsets", mock.MatchedBy(func(topicPartitions []models.KafkaTopicPartition) bool {
+		return assert.ElementsMatch(t, []models.KafkaTopicPartition{tenantPartition, secondPartition}, topicPartitions)
+	}), int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 50, secondPartition: 100}, nil).Once()
 
 	// Act
-	err := svc.PollConsumerGroup(tenantName)
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 0)
 
 	// Assert
 	assert.NoError(t, err)
-	mockExec.AssertExpectations(t)
+	assert.Equal(t, 42, lag)
+	mockClient.AssertExpectations(t)
 }
 
-func TestPollConsumerGroup_LagDecreases(t *testing.T) {
-	t.Skip("Skipping complex mock scenario - basic flow covered in TestPollConsumerGroup_ZeroLag")
+func TestGetConsumerGroupLag_DescribeError(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	describeErr := fmt.Errorf("connection refused")
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(nil, describeErr).Once()
+
+	// Act
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 10)
+
+	// Assert
+	assert.Equal(t, describeErr, err)
+	assert.Equal(t, 10, lag)
+	mockClient.AssertExpectations(t)
 }
 
-func TestPollConsumerGroup_Timeout(t *testing.T) {
-	t.Skip("Skipping complex mock scenario - timeout logic covered by unit tests")
+func TestGetConsumerGroupLag_NoActiveMembers(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateEmpty}, nil).Once()
+
+	// Act
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 15)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 15, lag)
+	mockClient.AssertExpectations(t)
 }
 
-func TestPollConsumerGroup_RebalanceError(t *testing.T) {
-	t.Skip("Skipping complex mock scenario - rebalance logic covered by unit tests")
+func TestGetConsumerGroupLag_Rebalancing(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).
+		Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStatePreparingRebalance}, nil).Once()
+
+	// Act
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 20)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 20, lag)
+	mockClient.AssertExpectations(t)
 }
 
-func TestGetConsumerGroupLag_Success(t *testing.T) {
+func TestGetConsumerGroupLag_CoordinatorNotAvailable(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).
+		Return(nil, errors.KafkaRequestFailed("FindCoordinator", errors.KafkaCoordinatorNotAvailable)).Once()
 
-	tenantName := "diku"
-	consumerGroup := "test-env-consumer-group"
-	initialLag := 0
+	// Act
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 25)
 
-	lagStdout := bytes.NewBufferString("42\n")
-	lagStderr := bytes.NewBuffer(nil)
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 25, lag)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetConsumerGroupLag_BrokerTimeout(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).Return(nil, fmt.Errorf("read: %w", os.ErrDeadlineExceeded)).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 30)
 
 	// Assert
 	assert.NoError(t, err)
-	assert.Equal(t, 42, lag)
-	mockExec.AssertExpectations(t)
+	assert.Equal(t, 30, lag)
+	mockClient.AssertExpectations(t)
 }
 
-func TestGetConsumerGroupLag_CommandError(t *testing.T) {
+func TestGetConsumerGroupLag_GroupNotFound(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateDead}, nil).Once()
 
-	tenantName := "diku"
-	consumerGroup := "test-env-consumer-group"
-	initialLag := 10
-	cmdErr := fmt.Errorf("command failed")
+	// Act
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 5)
 
-	lagStdout := bytes.NewBuffer(nil)
-	lagStderr := bytes.NewBuffer(nil)
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, cmdErr).Once()
+	// Assert
+	assert.Equal(t, errors.ConsumerGroupNotFound(testConsumerGroup), err)
+	assert.Equal(t, 5, lag)
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetConsumerGroupLag_NoTenantTopics(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).Return(map[models.KafkaTopicPartition]int64{otherPartition: 3}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition(nil), int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	lag, err := svc.getConsumerGroupLag(testTenant, testConsumerGroup, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, lag)
+	mockClient.AssertExpectations(t)
+}
+
+func TestIsTenantTopic(t *testing.T) {
+	t.Run("TestIsTenantTopic_TenantTopic", func(t *testing.T) {
+		assert.True(t, IsTenantTopic("folio.diku.inventory.instance", "diku"))
+	})
+
+	t.Run("TestIsTenantTopic_OtherTenant", func(t *testing.T) {
+		assert.False(t, IsTenantTopic("folio.diku2.inventory.instance", "diku"))
+	})
+
+	t.Run("TestIsTenantTopic_TenantInTopicName", func(t *testing.T) {
+		assert.False(t, IsTenantTopic("folio.other.diku-events", "diku"))
+	})
+}
+
+func TestGetTopicPrefix(t *testing.T) {
+	t.Run("TestGetTopicPrefix_Environment", func(t *testing.T) {
+		// Arrange
+		svc, _ := newTestSvc()
+
+		// Act & Assert
+		assert.Equal(t, "test-env.", svc.GetTopicPrefix(""))
+	})
+
+	t.Run("TestGetTopicPrefix_Tenant", func(t *testing.T) {
+		// Arrange
+		svc, _ := newTestSvc()
+
+		// Act & Assert
+		assert.Equal(t, "test-env.diku.", svc.GetTopicPrefix(testTenant))
+	})
+
+	t.Run("TestGetTopicPrefix_TopicSharing", func(t *testing.T) {
+		// Arrange
+		svc, _ := newTestSvc()
+		svc.Action.ConfigManagementTopicSharing = true
+		svc.Action.ConfigTopicSharingTenant = "ALL"
+
+		// Act & Assert
+		assert.Equal(t, "test-env.ALL.", svc.GetTopicPrefix(testTenant))
+	})
+}
+
+// newTestMetadata returns cluster metadata with two partitions for every topic
+func newTestMetadata(topicNames ...string) *models.KafkaMetadata {
+	metadata := &models.KafkaMetadata{Brokers: []models.KafkaBroker{{NodeID: 1, Host: "kafka.eureka", Port: 9092}}}
+	for _, topicName := range topicNames {
+		metadata.Topics = append(metadata.Topics, models.KafkaTopic{
+			Name:       topicName,
+			Internal:   topicName == "__consumer_offsets",
+			Partitions: []models.KafkaPartition{{Partition: 0, Leader: 1}, {Partition: 1, Leader: 1}},
+		})
+	}
+
+	return metadata
+}
+
+func TestListTopics_Tenant(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	instanceTopic := "test-env.diku.inventory.instance"
+	capabilityTopic := "test-env.diku.mgr-tenant-entitlements.capability"
+	mockClient.On("GetMetadata", []string(nil)).
+		Return(newTestMetadata(instanceTopic, "test-env.other.inventory.instance", capabilityTopic, "__consumer_offsets"), nil).Once()
+	mockClient.On("ListOffsets", mock.MatchedBy(func(topicPartitions []models.KafkaTopicPartition) bool {
+		return len(topicPartitions) == 4
+	}), int64(constant.KafkaOffsetEarliest)).
+		Return(map[models.KafkaTopicPartition]int64{{Topic: instanceTopic, Partition: 0}: 5}, nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{{Topic: instanceTopic, Partition: 0}: 10, {Topic: instanceTopic, Partition: 1}: 3}, nil).Once()
+
+	// Act
+	topics, err := svc.ListTopics(testTenant)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.KafkaTopicSummary{
+		{Name: instanceTopic, Tenant: testTenant, Partitions: 2, Messages: 8},
+		{Name: capabilityTopic, Tenant: testTenant, Partitions: 2, Messages: 0},
+	}, topics)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListTopics_Environment(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetMetadata", []string(nil)).
+		Return(newTestMetadata("test-env.entitlement", "test-env.diku.inventory.instance", "other-env.diku.inventory.instance"), nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, mock.Anything).Return(map[models.KafkaTopicPartition]int64{}, nil).Twice()
+
+	// Act
+	topics, err := svc.ListTopics("")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.KafkaTopicSummary{
+		{Name: "test-env.diku.inventory.instance", Tenant: testTenant, Partitions: 2},
+		{Name: "test-env.entitlement", Partitions: 2},
+	}, topics)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListTopics_MetadataError(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("GetMetadata", []string(nil)).Return(nil, fmt.Errorf("connection refused")).Once()
+
+	// Act
+	topics, err := svc.ListTopics(testTenant)
 
 	// Assert
 	assert.Error(t, err)
-	assert.Equal(t, initialLag, lag)
-	assert.Equal(t, cmdErr, err)
-	mockExec.AssertExpectations(t)
+	assert.Nil(t, topics)
+	mockClient.AssertExpectations(t)
 }
 
-func TestGetConsumerGroupLag_NoActiveMembers(t *testing.T) {
+func TestPurgeTopics_Success(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
-	svc.RebalanceWait = 1 * time.Millisecond
+	svc, mockClient := newTestSvc()
+	instanceTopic := "test-env.diku.inventory.instance"
+	mockClient.On("GetMetadata", []string(nil)).
+		Return(newTestMetadata(instanceTopic, "test-env.diku.inventory.holdings-record"), nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, int64(constant.KafkaOffsetEarliest)).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{{Topic: instanceTopic, Partition: 1}: 7}, nil).Once()
+	mockClient.On("DeleteRecords", map[models.KafkaTopicPartition]int64{
+		{Topic: instanceTopic, Partition: 0}: constant.KafkaOffsetLatest,
+		{Topic: instanceTopic, Partition: 1}: constant.KafkaOffsetLatest,
+	}).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
+
+	// Act
+	err := svc.PurgeTopics(testTenant)
+
+	// Assert
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestPurgeTopics_TopicSharingWithoutForce(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	svc.Action.ConfigManagementTopicSharing = true
+	svc.Action.ConfigTopicSharingTenant = "ALL"
 
-	tenantName := "diku"
-	consumerGroup := "test-env-consumer-group"
-	initialLag := 10
+	// Act
+	err := svc.PurgeTopics(testTenant)
 
-	lagStdout := bytes.NewBuffer(nil)
-	lagStderr := bytes.NewBufferString("Consumer group 'folio-mod-roles-keycloak-capability-group' has no active members.")
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrInvalidInput)
+	assert.ErrorContains(t, err, "--force")
+	mockClient.AssertExpectations(t)
+}
+
+func TestPurgeTopics_TopicSharing(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	svc.Action.Param.Force = true
+	svc.Action.ConfigManagementTopicSharing = true
+	svc.Action.ConfigTopicSharingTenant = "ALL"
+	sharedTopic := "test-env.ALL.inventory.instance"
+	mockClient.On("GetMetadata", []string(nil)).Return(newTestMetadata(sharedTopic, "test-env.diku.inventory.instance"), nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, int64(constant.KafkaOffsetEarliest)).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{{Topic: sharedTopic, Partition: 0}: 1}, nil).Once()
+	mockClient.On("DeleteRecords", map[models.KafkaTopicPartition]int64{
+		{Topic: sharedTopic, Partition: 0}: constant.KafkaOffsetLatest,
+		{Topic: sharedTopic, Partition: 1}: constant.KafkaOffsetLatest,
+	}).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	err := svc.PurgeTopics(testTenant)
 
 	// Assert
 	assert.NoError(t, err)
-	assert.Equal(t, initialLag, lag)
-	mockExec.AssertExpectations(t)
+	mockClient.AssertExpectations(t)
 }
 
-func TestGetConsumerGroupLag_Rebalancing(t *testing.T) {
+func TestPurgeTopics_DryRun(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
-	svc.RebalanceWait = 1 * time.Millisecond
+	svc, mockClient := newTestSvc()
+	svc.Action.Param.DryRun = true
+	instanceTopic := "test-env.diku.inventory.instance"
+	mockClient.On("GetMetadata", []string(nil)).Return(newTestMetadata(instanceTopic), nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, int64(constant.KafkaOffsetEarliest)).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
+	mockClient.On("ListOffsets", mock.Anything, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{{Topic: instanceTopic, Partition: 0}: 3}, nil).Once()
+	mockClient.On("DeleteRecords", map[models.KafkaTopicPartition]int64{}).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
 
-	tenantName := "diku"
-	consumerGroup := "test-env-consumer-group"
-	initialLag := 10
+	// Act
+	err := svc.PurgeTopics(testTenant)
 
-	lagStdout := bytes.NewBuffer(nil)
-	lagStderr := bytes.NewBufferString("Consumer group 'folio-mod-roles-keycloak-capability-group' is rebalancing.")
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
+	// Assert
+	assert.NoError(t, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestPurgeTopics_BlankTenant(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	err := svc.PurgeTopics("")
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrTenantNameBlank)
+	mockClient.AssertExpectations(t)
+}
+
+func TestDescribeConsumerGroup_Success(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	assignedPartition := models.KafkaTopicPartition{Topic: "test-env.diku.inventory.instance", Partition: 0}
+	committedPartition := models.KafkaTopicPartition{Topic: "test-env.diku.inventory.instance", Partition: 1}
+	otherTenantPartition := models.KafkaTopicPartition{Topic: "test-env.other.inventory.instance", Partition: 0}
+	group := &models.KafkaConsumerGroup{
+		GroupID: testConsumerGroup,
+		State:   constant.KafkaGroupStateStable,
+		Members: []models.KafkaConsumerGroupMember{{MemberID: "member-1", ClientID: "mod-inventory", Assignment: []models.KafkaTopicPartition{assignedPartition}}},
+	}
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(group, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).
+		Return(map[models.KafkaTopicPartition]int64{committedPartition: 4, otherTenantPartition: 1}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{assignedPartition, committedPartition}, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{assignedPartition: 2, committedPartition: 10}, nil).Once()
+
+	// Act
+	description, err := svc.DescribeConsumerGroup(testConsumerGroup, testTenant)
 
 	// Assert
 	assert.NoError(t, err)
-	assert.Equal(t, initialLag, lag)
-	mockExec.AssertExpectations(t)
+	assert.Equal(t, *group, description.KafkaConsumerGroup)
+	assert.Equal(t, int64(6), description.Lag)
+	assert.Equal(t, []models.KafkaPartitionOffset{
+		{Topic: assignedPartition.Topic, Partition: 0, CommittedOffset: -1, EndOffset: 2, Lag: -1, ClientID: "mod-inventory"},
+		{Topic: committedPartition.Topic, Partition: 1, CommittedOffset: 4, EndOffset: 10, Lag: 6},
+	}, description.Offsets)
+	mockClient.AssertExpectations(t)
 }
 
-func TestGetConsumerGroupLag_TimeoutException(t *testing.T) {
+func TestDescribeConsumerGroup_DefaultGroup(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
-	svc.TimeoutWait = 1 * time.Millisecond
+	svc, mockClient := newTestSvc()
+	consumerGroup := "test-env-" + constant.ConsumerGroupSuffix
+	mockClient.On("DescribeConsumerGroup", consumerGroup).Return(&models.KafkaConsumerGroup{GroupID: consumerGroup, State: constant.KafkaGroupStateEmpty}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", consumerGroup).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{}, int64(constant.KafkaOffsetLatest)).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
 
-	tenantName := "diku"
-	consumerGroup := "test-env-consumer-group"
-	initialLag := 10
+	// Act
+	description, err := svc.DescribeConsumerGroup("", "")
 
-	lagStdout := bytes.NewBuffer(nil)
-	lagStderr := bytes.NewBufferString("TimeoutException occurred")
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, consumerGroup, description.GroupID)
+	assert.Empty(t, description.Offsets)
+	mockClient.AssertExpectations(t)
+}
+
+func TestDescribeConsumerGroup_NotFound(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateDead}, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	description, err := svc.DescribeConsumerGroup(testConsumerGroup, "")
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+	assert.Nil(t, description)
+	mockClient.AssertExpectations(t)
+}
+
+func TestResetOffsets_ToEarliest(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	dikuPartition := models.KafkaTopicPartition{Topic: "test-env.diku.inventory.instance", Partition: 0}
+	otherTenantPartition := models.KafkaTopicPartition{Topic: "test-env.other.inventory.instance", Partition: 0}
+	newOffsets := map[models.KafkaTopicPartition]int64{dikuPartition: 2}
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateEmpty}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).
+		Return(map[models.KafkaTopicPartition]int64{dikuPartition: 12, otherTenantPartition: 3}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{dikuPartition}, int64(constant.KafkaOffsetEarliest)).Return(newOffsets, nil).Once()
+	mockClient.On("CommitOffsets", testConsumerGroup, newOffsets).Return(nil).Once()
+
+	// Act
+	resets, err := svc.ResetOffsets(testConsumerGroup, testTenant, constant.KafkaOffsetEarliest)
 
 	// Assert
 	assert.NoError(t, err)
-	assert.Equal(t, initialLag, lag)
-	mockExec.AssertExpectations(t)
+	assert.Equal(t, []models.KafkaOffsetReset{{Topic: dikuPartition.Topic, Partition: 0, PreviousOffset: 12, NewOffset: 2}}, resets)
+	mockClient.AssertExpectations(t)
 }
 
-func TestGetConsumerGroupLag_OtherStderrError(t *testing.T) {
+func TestResetOffsets_DryRun(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
+	svc, mockClient := newTestSvc()
+	svc.Action.Param.DryRun = true
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateEmpty}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).Return(map[models.KafkaTopicPartition]int64{tenantPartition: 3}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{tenantPartition}, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 9}, nil).Once()
+
+	// Act
+	resets, err := svc.ResetOffsets(testConsumerGroup, "", constant.KafkaOffsetLatest)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.KafkaOffsetReset{{Topic: tenantPartition.Topic, Partition: 0, PreviousOffset: 3, NewOffset: 9}}, resets)
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "CommitOffsets", mock.Anything, mock.Anything)
+}
 
-	tenantName := "diku"
-	consumerGroup := "test-env-consumer-group"
-	initialLag := 10
-	stderrText := "some other error"
+func TestResetOffsets_ActiveGroup(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	group := &models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable, Members: []models.KafkaConsumerGroupMember{{MemberID: "member-1"}}}
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(group, nil).Once()
 
-	lagStdout := bytes.NewBuffer(nil)
-	lagStderr := bytes.NewBufferString(stderrText)
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
+	// Act
+	resets, err := svc.ResetOffsets(testConsumerGroup, testTenant, constant.KafkaOffsetLatest)
+
+	// Assert
+	assert.Equal(t, errors.ConsumerGroupActive(testConsumerGroup, constant.KafkaGroupStateStable, 1), err)
+	assert.Nil(t, resets)
+	mockClient.AssertExpectations(t)
+}
+
+func TestResetOffsets_GroupNotFound(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateDead}, nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	_, err := svc.ResetOffsets(testConsumerGroup, testTenant, constant.KafkaOffsetLatest)
 
 	// Assert
-	assert.Error(t, err)
-	assert.Equal(t, initialLag, lag)
-	assert.Equal(t, errors.ContainerCommandFailed(stderrText), err)
-	mockExec.AssertExpectations(t)
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+	mockClient.AssertExpectations(t)
 }
 
-func TestGetConsumerGroupLag_InvalidLagValue(t *testing.T) {
+func TestRestoreOffsets(t *testing.T) {
 	// Arrange
-	action := testhelpers.NewMockAction()
-	mockExec := new(testhelpers.MockCommandExecutor)
-	svc := New(action, mockExec)
+	svc, mockClient := newTestSvc()
+	otherPartition := models.KafkaTopicPartition{Topic: "test-env.diku.inventory.holdings", Partition: 0}
+	topicPartitions := []models.KafkaTopicPartition{tenantPartition, otherPartition}
+	newOffsets := map[models.KafkaTopicPartition]int64{tenantPartition: 4, otherPartition: 20}
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateEmpty}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).Return(map[models.KafkaTopicPartition]int64{tenantPartition: 9}, nil).Once()
+	mockClient.On("ListOffsets", topicPartitions, int64(constant.KafkaOffsetEarliest)).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 0, otherPartition: 20}, nil).Once()
+	mockClient.On("ListOffsets", topicPartitions, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 10, otherPartition: 25}, nil).Once()
+	mockClient.On("CommitOffsets", testConsumerGroup, newOffsets).Return(nil).Once()
+
+	// Act
+	resets, err := svc.RestoreOffsets(testConsumerGroup, map[models.KafkaTopicPartition]int64{tenantPartition: 4, otherPartition: 12})
 
-	tenantName := "diku"
-	consumerGroup := "test-env-consumer-group"
-	initialLag := 10
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.KafkaOffsetReset{
+		{Topic: tenantPartition.Topic, Partition: 0, PreviousOffset: 9, NewOffset: 4},
+		{Topic: otherPartition.Topic, Partition: 0, PreviousOffset: -1, NewOffset: 20},
+	}, resets)
+	mockClient.AssertExpectations(t)
+}
 
-	lagStdout := bytes.NewBufferString("not-a-number\n")
-	lagStderr := bytes.NewBuffer(nil)
-	mockExec.On("ExecReturnOutput", mock.Anything).Return(*lagStdout, *lagStderr, nil).Once()
+func TestRestoreOffsets_Latest(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	topicPartitions := []models.KafkaTopicPartition{tenantPartition}
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateDead}, nil).Once()
+	mockClient.On("FetchCommittedOffsets", testConsumerGroup).Return(map[models.KafkaTopicPartition]int64{}, nil).Once()
+	mockClient.On("ListOffsets", topicPartitions, int64(constant.KafkaOffsetEarliest)).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 3}, nil).Once()
+	mockClient.On("ListOffsets", topicPartitions, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{tenantPartition: 10}, nil).Once()
+	mockClient.On("CommitOffsets", testConsumerGroup, map[models.KafkaTopicPartition]int64{tenantPartition: 10}).Return(nil).Once()
 
 	// Act
-	lag, err := svc.getConsumerGroupLag(tenantName, consumerGroup, initialLag)
+	resets, err := svc.RestoreOffsets(testConsumerGroup, map[models.KafkaTopicPartition]int64{tenantPartition: constant.KafkaOffsetLatest})
 
 	// Assert
 	assert.NoError(t, err)
-	assert.Equal(t, 0, lag) // GetKafkaConsumerLagFromLogLine returns "0" for invalid input, strconv.Atoi succeeds
-	mockExec.AssertExpectations(t)
+	assert.Equal(t, []models.KafkaOffsetReset{{Topic: tenantPartition.Topic, Partition: 0, PreviousOffset: -1, NewOffset: 10}}, resets)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRestoreOffsets_ActiveGroup(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	group := &models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable, Members: []models.KafkaConsumerGroupMember{{MemberID: "member-1"}}}
+	mockClient.On("DescribeConsumerGroup", testConsumerGroup).Return(group, nil).Once()
+
+	// Act
+	resets, err := svc.RestoreOffsets(testConsumerGroup, map[models.KafkaTopicPartition]int64{tenantPartition: 4})
+
+	// Assert
+	assert.Equal(t, errors.ConsumerGroupActive(testConsumerGroup, constant.KafkaGroupStateStable, 1), err)
+	assert.Nil(t, resets)
+	mockClient.AssertExpectations(t)
+	mockClient.AssertNotCalled(t, "CommitOffsets", mock.Anything, mock.Anything)
+}
+
+func TestGetTopicName(t *testing.T) {
+	tests := []struct {
+		name     string
+		topic    string
+		tenant   string
+		expected string
+	}{
+		{"TenantTopic", "inventory.instance", testTenant, "test-env.diku.inventory.instance"},
+		{"FullName", "test-env.diku.inventory.instance", testTenant, "test-env.diku.inventory.instance"},
+		{"NoTenant", "test-env.ALL.inventory.instance", "", "test-env.ALL.inventory.instance"},
+	}
+
+	for _, tt := range tests {
+		t.Run("TestGetTopicName_"+tt.name, func(t *testing.T) {
+			// Arrange
+			svc, _ := newTestSvc()
+
+			// Act
+			topicName := svc.GetTopicName(tt.topic, tt.tenant)
+
+			// Assert
+			assert.Equal(t, tt.expected, topicName)
+		})
+	}
+}
+
+func TestGetTopicName_TopicSharing(t *testing.T) {
+	// Arrange
+	svc, _ := newTestSvc()
+	svc.Action.ConfigManagementTopicSharing = true
+	svc.Action.ConfigTopicSharingTenant = "ALL"
+
+	// Act
+	topicName := svc.GetTopicName("inventory.instance", testTenant)
+
+	// Assert
+	assert.Equal(t, "test-env.ALL.inventory.instance", topicName)
+}
+
+func TestTailTopic_FromBeginning(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	topicName := "test-env.diku.inventory.instance"
+	partition0 := models.KafkaTopicPartition{Topic: topicName, Partition: 0}
+	partition1 := models.KafkaTopicPartition{Topic: topicName, Partition: 1}
+	startOffsets := map[models.KafkaTopicPartition]int64{partition0: 0, partition1: 5}
+	nextOffsets := map[models.KafkaTopicPartition]int64{partition0: 1, partition1: 6}
+	records := []models.KafkaRecord{
+		{Topic: topicName, Partition: 1, Offset: 5, Timestamp: time.UnixMilli(2000)},
+		{Topic: topicName, Partition: 0, Offset: 0, Timestamp: time.UnixMilli(1000)},
+	}
+	mockClient.On("GetMetadata", []string{topicName}).
+		Return(&models.KafkaMetadata{Topics: []models.KafkaTopic{{Name: topicName, Partitions: []models.KafkaPartition{{Partition: 0}, {Partition: 1}}}}}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{partition0, partition1}, int64(constant.KafkaOffsetEarliest)).Return(startOffsets, nil).Once()
+	mockClient.On("FetchRecords", startOffsets, constant.KafkaClientFetchMaxWait).Return(records, nextOffsets, nil).Once()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var handled []int64
+
+	// Act
+	err := svc.TailTopic(ctx, topicName, true, func(record models.KafkaRecord) {
+		handled = append(handled, record.Offset)
+		if len(handled) == len(records) {
+			cancel()
+		}
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 5}, handled)
+	mockClient.AssertExpectations(t)
+}
+
+func TestTailTopic_OffsetOutOfRange(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	topicName := "test-env.diku.inventory.instance"
+	partition := models.KafkaTopicPartition{Topic: topicName, Partition: 0}
+	staleOffsets := map[models.KafkaTopicPartition]int64{partition: 3}
+	clampedOffsets := map[models.KafkaTopicPartition]int64{partition: 10}
+	mockClient.On("GetMetadata", []string{topicName}).
+		Return(&models.KafkaMetadata{Topics: []models.KafkaTopic{{Name: topicName, Partitions: []models.KafkaPartition{{Partition: 0}}}}}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{partition}, int64(constant.KafkaOffsetLatest)).Return(staleOffsets, nil).Once()
+	mockClient.On("FetchRecords", staleOffsets, constant.KafkaClientFetchMaxWait).
+		Return(nil, nil, errors.KafkaRequestFailed("Fetch", errors.KafkaOffsetOutOfRange)).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{partition}, int64(constant.KafkaOffsetEarliest)).Return(clampedOffsets, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{partition}, int64(constant.KafkaOffsetLatest)).Return(map[models.KafkaTopicPartition]int64{partition: 12}, nil).Once()
+	mockClient.On("FetchRecords", clampedOffsets, constant.KafkaClientFetchMaxWait).
+		Return([]models.KafkaRecord{{Topic: topicName, Offset: 10}}, map[models.KafkaTopicPartition]int64{partition: 11}, nil).Once()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var handled []int64
+
+	// Act
+	err := svc.TailTopic(ctx, topicName, false, func(record models.KafkaRecord) {
+		handled = append(handled, record.Offset)
+		cancel()
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{10}, handled)
+	mockClient.AssertExpectations(t)
+}
+
+func TestTailTopic_TopicNotFound(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	topicName := "test-env.diku.missing"
+	mockClient.On("GetMetadata", []string{topicName}).Return(nil, errors.KafkaRequestFailed("Metadata", errors.KafkaUnknownTopicOrPartition)).Once()
+
+	// Act
+	err := svc.TailTopic(context.Background(), topicName, false, func(models.KafkaRecord) {})
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+	mockClient.AssertExpectations(t)
+}
+
+func TestTailTopic_FetchError(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	topicName := "test-env.diku.inventory.instance"
+	partition := models.KafkaTopicPartition{Topic: topicName, Partition: 0}
+	offsets := map[models.KafkaTopicPartition]int64{partition: 0}
+	expectedErr := errors.KafkaRequestFailed("Fetch", errors.KafkaTopicAuthorizationFailed)
+	mockClient.On("GetMetadata", []string{topicName}).
+		Return(&models.KafkaMetadata{Topics: []models.KafkaTopic{{Name: topicName, Partitions: []models.KafkaPartition{{Partition: 0}}}}}, nil).Once()
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{partition}, int64(constant.KafkaOffsetLatest)).Return(offsets, nil).Once()
+	mockClient.On("FetchRecords", offsets, constant.KafkaClientFetchMaxWait).Return(nil, nil, expectedErr).Once()
+
+	// Act
+	err := svc.TailTopic(context.Background(), topicName, false, func(models.KafkaRecord) {})
+
+	// Assert
+	assert.Equal(t, expectedErr, err)
+	mockClient.AssertExpectations(t)
+}
+
+func TestPublishMessage_Success(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	record := models.KafkaRecord{Topic: "test-env.diku.inventory.instance", Partition: -1, Value: []byte(`{"type":"CREATE"}`)}
+	published := &models.KafkaRecord{Topic: record.Topic, Partition: 3, Offset: 42, Value: record.Value}
+	mockClient.On("ProduceRecord", record).Return(published, nil).Once()
+
+	// Act
+	result, err := svc.PublishMessage(record)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, published, result)
+	mockClient.AssertExpectations(t)
+}
+
+func TestPublishMessage_DryRun(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	svc.Action.Param.DryRun = true
+	record := models.KafkaRecord{Topic: "test-env.diku.inventory.instance", Partition: -1, Value: []byte(`{}`)}
+
+	// Act
+	result, err := svc.PublishMessage(record)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &record, result)
+	mockClient.AssertNotCalled(t, "ProduceRecord", mock.Anything)
+}
+
+func TestPublishMessage_TopicNotFound(t *testing.T) {
+	// Arrange
+	svc, mockClient := newTestSvc()
+	record := models.KafkaRecord{Topic: "test-env.diku.missing", Partition: -1, Value: []byte(`{}`)}
+	mockClient.On("ProduceRecord", record).Return(nil, errors.KafkaRequestFailed("Metadata", errors.KafkaUnknownTopicOrPartition)).Once()
+
+	// Act
+	result, err := svc.PublishMessage(record)
+
+	// Assert
+	assert.Equal(t, errors.KafkaTopicNotFound(record.Topic), err)
+	assert.Nil(t, result)
+	mockClient.AssertExpectations(t)
 }
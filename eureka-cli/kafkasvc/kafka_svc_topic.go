@@ -0,0 +1,134 @@
+package kafkasvc
+
+import (
+	"log/slog"
+	"sort"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// KafkaTopicManager defines the interface for Kafka topic operations
+type KafkaTopicManager interface {
+	ListTopics(tenantName string) ([]models.KafkaTopicSummary, error)
+	PurgeTopics(tenantName string) error
+}
+
+// ListTopics returns the topics of the environment or of the tenant together with the number of retained messages
+func (ks *KafkaSvc) ListTopics(tenantName string) ([]models.KafkaTopicSummary, error) {
+	topics, earliestOffsets, latestOffsets, err := ks.getTopicOffsets(ks.GetTopicPrefix(tenantName))
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]models.KafkaTopicSummary, 0, len(topics))
+	for _, topic := range topics {
+		summaries = append(summaries, models.KafkaTopicSummary{
+			Name:       topic.Name,
+			Tenant:     ks.getTopicTenant(topic.Name),
+			Partitions: len(topic.Partitions),
+			Messages:   countMessages(topic, earliestOffsets, latestOffsets),
+		})
+	}
+
+	return summaries, nil
+}
+
+// PurgeTopics deletes all messages of the tenant topics while keeping the topics and the committed offsets of the consumer groups,
+// topics shared by all tenants are only purged with the force flag
+func (ks *KafkaSvc) PurgeTopics(tenantName string) error {
+	if tenantName == "" {
+		return appErrors.TenantNameBlank()
+	}
+	if ks.Action.ConfigManagementTopicSharing {
+		if !ks.Action.IsDryRun() && (ks.Action.Param == nil || !ks.Action.Param.Force) {
+			return appErrors.KafkaSharedTopicsPurgeRefused(ks.Action.ConfigTopicSharingTenant)
+		}
+		slog.Warn(ks.Action.Name, "text", "Topics are shared by all tenants, purging them removes the messages of every tenant", "topicTenant", ks.Action.ConfigTopicSharingTenant)
+	}
+
+	prefix := ks.GetTopicPrefix(tenantName)
+	topics, earliestOffsets, latestOffsets, err := ks.getTopicOffsets(prefix)
+	if err != nil {
+		return err
+	}
+	if len(topics) == 0 {
+		slog.Warn(ks.Action.Name, "text", "No topics found to purge", "prefix", prefix)
+		return nil
+	}
+
+	deleteOffsets := make(map[models.KafkaTopicPartition]int64)
+	for _, topic := range topics {
+		messages := countMessages(topic, earliestOffsets, latestOffsets)
+		if ks.Action.IsDryRun() {
+			slog.Info(ks.Action.Name, "text", "Skipping purging of topic in dry run mode", "topic", topic.Name, "messages", messages)
+			continue
+		}
+		if messages == 0 {
+			continue
+		}
+		for _, partition := range topic.Partitions {
+			deleteOffsets[models.KafkaTopicPartition{Topic: topic.Name, Partition: partition.Partition}] = constant.KafkaOffsetLatest
+		}
+		slog.Info(ks.Action.Name, "text", "Purging topic", "topic", topic.Name, "messages", messages)
+	}
+	if _, err := ks.KafkaClient.DeleteRecords(deleteOffsets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getTopicOffsets returns the topics with the given prefix sorted by name with the log start and log end offsets of their partitions
+func (ks *KafkaSvc) getTopicOffsets(prefix string) (topics []models.KafkaTopic, earliestOffsets, latestOffsets map[models.KafkaTopicPartition]int64, err error) {
+	metadata, err := ks.KafkaClient.GetMetadata(nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var topicPartitions []models.KafkaTopicPartition
+	for _, topic := range metadata.Topics {
+		if topic.Internal || !strings.HasPrefix(topic.Name, prefix) {
+			continue
+		}
+		topics = append(topics, topic)
+		for _, partition := range topic.Partitions {
+			topicPartitions = append(topicPartitions, models.KafkaTopicPartition{Topic: topic.Name, Partition: partition.Partition})
+		}
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		return topics[i].Name < topics[j].Name
+	})
+
+	earliestOffsets, err = ks.KafkaClient.ListOffsets(topicPartitions, constant.KafkaOffsetEarliest)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	latestOffsets, err = ks.KafkaClient.ListOffsets(topicPartitions, constant.KafkaOffsetLatest)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return topics, earliestOffsets, latestOffsets, nil
+}
+
+// getTopicTenant returns the tenant segment of a topic named <ENV>.<tenant>.<topic>
+func (ks *KafkaSvc) getTopicTenant(topicName string) string {
+	segments := strings.SplitN(strings.TrimPrefix(topicName, ks.GetTopicPrefix("")), ".", 2)
+	if len(segments) < 2 {
+		return ""
+	}
+
+	return segments[0]
+}
+
+func countMessages(topic models.KafkaTopic, earliestOffsets, latestOffsets map[models.KafkaTopicPartition]int64) (messages int64) {
+	for _, partition := range topic.Partitions {
+		topicPartition := models.KafkaTopicPartition{Topic: topic.Name, Partition: partition.Partition}
+		messages += latestOffsets[topicPartition] - earliestOffsets[topicPartition]
+	}
+
+	return messages
+}
@@ -23,11 +23,13 @@ type KeycloakProcessor interface {
 	KeycloakUserManager
 	KeycloakRoleManager
 	KeycloakCapabilitySetManager
+	KeycloakRealmManager
 }
 
 // KeycloakAdminManager defines the interface for Keycloak admin operations
 type KeycloakAdminManager interface {
 	GetAccessToken(tenantName string) (string, error)
+	GetUserAccessToken(tenantName string, username string, password string) (string, error)
 	GetMasterAccessToken(grantType constant.KeycloakGrantType) (string, error)
 	UpdateRealmAccessTokenSettings(tenantName string, lifespan int) error
 	UpdatePublicClientSettings(tenantName string, url string) error
@@ -50,27 +52,48 @@ func New(action *action.Action,
 }
 
 func (ks *KeycloakSvc) GetAccessToken(tenantName string) (string, error) {
-	client, err := ks.VaultClient.Create()
+	secrets, err := ks.getTenantSecrets(tenantName)
 	if err != nil {
 		return "", err
 	}
 
-	secrets, err := ks.VaultClient.GetSecretKey(context.Background(), client, ks.Action.VaultRootToken, fmt.Sprintf("folio/%s", tenantName))
+	clientID := action.GetConfigEnv("KC_SERVICE_CLIENT_ID", ks.Action.ConfigGlobalEnv)
+	clientSecret := helpers.GetString(secrets, clientID)
+	systemUser := fmt.Sprintf("%s-system-user", tenantName)
+	systemUserPassword := helpers.GetString(secrets, systemUser)
+
+	return ks.getPasswordGrantAccessToken(tenantName, clientID, clientSecret, systemUser, systemUserPassword)
+}
+
+// GetUserAccessToken logs a user in to the login client of the tenant realm, which is the client used by the UI
+func (ks *KeycloakSvc) GetUserAccessToken(tenantName string, username string, password string) (string, error) {
+	secrets, err := ks.getTenantSecrets(tenantName)
 	if err != nil {
 		return "", err
 	}
 
-	clientID := action.GetConfigEnv("KC_SERVICE_CLIENT_ID", ks.Action.ConfigGlobalEnv)
+	clientID := tenantName + action.GetConfigEnv("KC_LOGIN_CLIENT_SUFFIX", ks.Action.ConfigGlobalEnv)
 	clientSecret := helpers.GetString(secrets, clientID)
-	systemUser := fmt.Sprintf("%s-system-user", tenantName)
-	systemUserPassword := helpers.GetString(secrets, systemUser)
 
+	return ks.getPasswordGrantAccessToken(tenantName, clientID, clientSecret, username, password)
+}
+
+func (ks *KeycloakSvc) getTenantSecrets(tenantName string) (map[string]any, error) {
+	client, err := ks.VaultClient.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.VaultClient.GetSecretKey(context.Background(), client, ks.Action.VaultRootToken, fmt.Sprintf("folio/%s", tenantName))
+}
+
+func (ks *KeycloakSvc) getPasswordGrantAccessToken(tenantName, clientID, clientSecret, username, password string) (string, error) {
 	formData := url.Values{}
 	formData.Set("grant_type", constant.Password)
 	formData.Set("client_id", clientID)
 	formData.Set("client_secret", clientSecret)
-	formData.Set("username", systemUser)
-	formData.Set("password", systemUserPassword)
+	formData.Set("username", username)
+	formData.Set("password", password)
 
 	requestURL := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", constant.KeycloakHTTP, tenantName)
 	headers := helpers.ApplicationFormURLEncodedHeaders()
@@ -19,6 +19,7 @@ type KeycloakCapabilitySetManager interface {
 	GetCapabilitySets(headers map[string]string) ([]any, error)
 	GetCapabilitySetsByName(headers map[string]string, capabilityName string) ([]any, error)
 	AttachCapabilitySetsToRoles(tenantName string) error
+	AttachCapabilitySetsToRole(tenantName string, roleName string) error
 	DetachCapabilitySetsFromRoles(tenantName string) error
 }
 
@@ -96,48 +97,72 @@ func (ks *KeycloakSvc) AttachCapabilitySetsToRoles(tenantName string) error {
 		return nil
 	}
 
-	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/roles/capability-sets")
 	for _, roleValue := range roles {
 		entry := roleValue.(map[string]any)
 		roleName := ks.Action.Caser.String(helpers.GetString(entry, "name"))
 		if ks.Action.ConfigRoles[roleName] == nil {
 			continue
 		}
-
-		rolesMapConfig := helpers.GetMapOrDefault(ks.Action.ConfigRoles, roleName, nil)
-		if tenantName != helpers.GetString(rolesMapConfig, field.RolesTenantEntry) {
-			continue
+		if err := ks.attachCapabilitySetsToRole(headers, tenantName, roleName, helpers.GetString(entry, "id")); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
+
+func (ks *KeycloakSvc) AttachCapabilitySetsToRole(tenantName string, roleName string) error {
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	if err != nil {
+		return err
+	}
+
+	role, err := ks.GetRoleByName(ks.Action.Caser.String(roleName), headers)
+	if err != nil {
+		return err
+	}
+	if role == nil {
+		return apperrors.RoleNotFound(roleName)
+	}
+
+	return ks.attachCapabilitySetsToRole(headers, tenantName, roleName, helpers.GetString(role, "id"))
+}
 
-		rolesCapabilitySets := helpers.GetAnySlice(rolesMapConfig, field.RolesCapabilitySetsEntry)
-		capabilitySets, err := ks.populateCapabilitySets(headers, rolesCapabilitySets)
+func (ks *KeycloakSvc) attachCapabilitySetsToRole(headers map[string]string, tenantName string, roleName string, roleID string) error {
+	rolesMapConfig := helpers.GetMapOrDefault(ks.Action.ConfigRoles, roleName, nil)
+	if tenantName != helpers.GetString(rolesMapConfig, field.RolesTenantEntry) {
+		return nil
+	}
+
+	rolesCapabilitySets := helpers.GetAnySlice(rolesMapConfig, field.RolesCapabilitySetsEntry)
+	capabilitySets, err := ks.populateCapabilitySets(headers, rolesCapabilitySets)
+	if err != nil {
+		return err
+	}
+	if len(capabilitySets) == 0 {
+		slog.Warn(ks.Action.Name, "text", "No capability sets were attached", "role", roleName, "tenant", tenantName)
+		return nil
+	}
+
+	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/roles/capability-sets")
+	batchSize := 250
+	for lowerBound := 0; lowerBound < len(capabilitySets); lowerBound += batchSize {
+		upperBound := min(lowerBound+batchSize, len(capabilitySets))
+		batchCapabilitySetIDs := capabilitySets[lowerBound:upperBound]
+		slog.Info(ks.Action.Name, "text", "Attaching capability sets", "start", lowerBound, "end", upperBound, "total", len(capabilitySets), "role", roleName, "tenant", tenantName)
+
+		payload, err := json.Marshal(map[string]any{
+			"roleId":           roleID,
+			"capabilitySetIds": batchCapabilitySetIDs,
+		})
 		if err != nil {
 			return err
 		}
-		if len(capabilitySets) == 0 {
-			slog.Warn(ks.Action.Name, "text", "No capability sets were attached", "role", roleName, "tenant", tenantName)
-			continue
-		}
-
-		batchSize := 250
-		for lowerBound := 0; lowerBound < len(capabilitySets); lowerBound += batchSize {
-			upperBound := min(lowerBound+batchSize, len(capabilitySets))
-			batchCapabilitySetIDs := capabilitySets[lowerBound:upperBound]
-			slog.Info(ks.Action.Name, "text", "Attaching capability sets", "start", lowerBound, "end", upperBound, "total", len(capabilitySets), "role", roleName, "tenant", tenantName)
-
-			payload, err := json.Marshal(map[string]any{
-				"roleId":           helpers.GetString(entry, "id"),
-				"capabilitySetIds": batchCapabilitySetIDs,
-			})
-			if err != nil {
-				return err
-			}
-			if err := ks.HTTPClient.PostRetryReturnNoContent(requestURL, payload, headers); err != nil {
-				return err
-			}
+		if err := ks.HTTPClient.PostRetryReturnNoContent(requestURL, payload, headers); err != nil {
+			return err
 		}
-		slog.Info(ks.Action.Name, "text", "Attached capability sets", "count", len(capabilitySets), "role", roleName, "tenant", tenantName)
 	}
+	slog.Info(ks.Action.Name, "text", "Attached capability sets", "count", len(capabilitySets), "role", roleName, "tenant", tenantName)
 
 	return nil
 }
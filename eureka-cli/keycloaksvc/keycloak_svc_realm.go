@@ -0,0 +1,235 @@
+package keycloaksvc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// realmSettingsSkippedKeys are the keys of an exported realm that are not updated on the realm, the identifiers differ
+// between environments, the key providers of components hold masked private keys and the rest goes to the partial import
+var realmSettingsSkippedKeys = []string{"id", "realm", "defaultRole", "components", "clients", "roles", "groups", "identityProviders", "users"}
+
+// KeycloakRealmManager defines the interface for Keycloak realm export and import operations
+type KeycloakRealmManager interface {
+	ExportRealm(tenantName string, includeUsers bool) (map[string]any, error)
+	ImportRealm(tenantName string, realm map[string]any, overwrite bool) (*models.KeycloakPartialImportResponse, error)
+}
+
+// ExportRealm returns the partial export of a tenant realm with its settings, clients, roles and groups, the users
+// together with their role mappings and groups are added on request, Keycloak masks the client secrets in the export
+func (ks *KeycloakSvc) ExportRealm(tenantName string, includeUsers bool) (map[string]any, error) {
+	requestURL := fmt.Sprintf("%s/admin/realms/%s/partial-export?exportClients=true&exportGroupsAndRoles=true", constant.KeycloakHTTP, tenantName)
+	headers, err := helpers.SecureApplicationJSONHeaders(ks.Action.KeycloakMasterAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var realm map[string]any
+	if err := ks.HTTPClient.PostReturnStruct(requestURL, nil, headers, &realm); err != nil {
+		return nil, err
+	}
+	if realm == nil {
+		realm = map[string]any{"realm": tenantName}
+	}
+	if includeUsers {
+		users, err := ks.exportRealmUsers(tenantName, headers)
+		if err != nil {
+			return nil, err
+		}
+		realm["users"] = users
+	}
+	slog.Info(ks.Action.Name, "text", "Exported keycloak realm", "realm", tenantName, "clients", len(helpers.GetAnySlice(realm, "clients")), "users", len(helpers.GetAnySlice(realm, "users")))
+
+	return realm, nil
+}
+
+// exportRealmUsers pages through the users of a realm, service account users are left out because they are recreated with their clients
+func (ks *KeycloakSvc) exportRealmUsers(tenantName string, headers map[string]string) ([]any, error) {
+	users := []any{}
+	for first := 0; ; first += constant.KeycloakUsersPageSize {
+		requestURL := fmt.Sprintf("%s/admin/realms/%s/users?briefRepresentation=false&first=%d&max=%d", constant.KeycloakHTTP, tenantName, first, constant.KeycloakUsersPageSize)
+		var page []map[string]any
+		if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, headers, &page); err != nil {
+			return nil, err
+		}
+		for _, user := range page {
+			if helpers.GetString(user, "serviceAccountClientId") != "" {
+				continue
+			}
+			if err := ks.addUserMemberships(tenantName, user, headers); err != nil {
+				return nil, err
+			}
+			users = append(users, user)
+		}
+		if len(page) < constant.KeycloakUsersPageSize {
+			return users, nil
+		}
+	}
+}
+
+// addUserMemberships adds the realm roles, client roles and group paths of a user in the form expected by the partial import
+func (ks *KeycloakSvc) addUserMemberships(tenantName string, user map[string]any, headers map[string]string) error {
+	userID := helpers.GetString(user, "id")
+	roleMappingsURL := fmt.Sprintf("%s/admin/realms/%s/users/%s/role-mappings", constant.KeycloakHTTP, tenantName, userID)
+	var roleMappings models.KeycloakUserRoleMappings
+	if err := ks.HTTPClient.GetRetryReturnStruct(roleMappingsURL, headers, &roleMappings); err != nil {
+		return err
+	}
+	realmRoles := []string{}
+	for _, role := range roleMappings.RealmMappings {
+		realmRoles = append(realmRoles, role.Name)
+	}
+	clientRoles := map[string][]string{}
+	for clientID, clientMappings := range roleMappings.ClientMappings {
+		for _, role := range clientMappings.Mappings {
+			clientRoles[clientID] = append(clientRoles[clientID], role.Name)
+		}
+	}
+
+	groupsURL := fmt.Sprintf("%s/admin/realms/%s/users/%s/groups", constant.KeycloakHTTP, tenantName, userID)
+	var groups []models.KeycloakGroup
+	if err := ks.HTTPClient.GetRetryReturnStruct(groupsURL, headers, &groups); err != nil {
+		return err
+	}
+	groupPaths := []string{}
+	for _, group := range groups {
+		groupPaths = append(groupPaths, group.Path)
+	}
+
+	user["realmRoles"] = realmRoles
+	user["clientRoles"] = clientRoles
+	user["groups"] = groupPaths
+
+	return nil
+}
+
+// ImportRealm applies the settings of a realm export to the tenant realm and imports its clients, roles, groups,
+// identity providers and users, existing resources are skipped unless overwrite is set, masked client secrets
+// are replaced with the secrets kept in Vault so that the sidecars can still authenticate
+func (ks *KeycloakSvc) ImportRealm(tenantName string, realm map[string]any, overwrite bool) (*models.KeycloakPartialImportResponse, error) {
+	if realmName := helpers.GetString(realm, "realm"); realmName != tenantName {
+		return nil, errors.RealmMismatch(realmName, tenantName)
+	}
+	headers, err := helpers.SecureApplicationJSONHeaders(ks.Action.KeycloakMasterAccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, droppedKeys := getRealmSettings(realm)
+	if len(droppedKeys) > 0 {
+		slog.Warn(ks.Action.Name, "text", "Skipping realm settings that cannot be updated", "realm", tenantName, "keys", droppedKeys)
+	}
+	settingsPayload, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	realmURL := fmt.Sprintf("%s/admin/realms/%s", constant.KeycloakHTTP, tenantName)
+	if err := ks.HTTPClient.PutReturnNoContent(realmURL, settingsPayload, headers); err != nil {
+		return nil, err
+	}
+
+	clients, err := ks.restoreClientSecrets(tenantName, helpers.GetAnySlice(realm, "clients"))
+	if err != nil {
+		return nil, err
+	}
+	ifResourceExists := constant.KeycloakImportSkip
+	if overwrite {
+		ifResourceExists = constant.KeycloakImportOverwrite
+	}
+	importPayload, err := json.Marshal(models.KeycloakPartialImportRequest{
+		IfResourceExists:  ifResourceExists,
+		Clients:           clients,
+		Roles:             realm["roles"],
+		Groups:            helpers.GetAnySlice(realm, "groups"),
+		IdentityProviders: helpers.GetAnySlice(realm, "identityProviders"),
+		Users:             helpers.GetAnySlice(realm, "users"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.KeycloakPartialImportResponse
+	importURL := fmt.Sprintf("%s/admin/realms/%s/partialImport", constant.KeycloakHTTP, tenantName)
+	if err := ks.HTTPClient.PostReturnStruct(importURL, importPayload, headers, &response); err != nil {
+		return nil, err
+	}
+	slog.Info(ks.Action.Name, "text", "Imported keycloak realm", "realm", tenantName, "added", response.Added, "overwritten", response.Overwritten, "skipped", response.Skipped)
+
+	return &response, nil
+}
+
+// getRealmSettings returns the settings of an exported realm that are updated on the realm, i.e. the scalar values, the
+// maps such as attributes or smtpServer and the lists of scalars such as eventsListeners, together with the sorted keys
+// of the lists of objects, e.g. authenticationFlows, that are neither updated nor part of the partial import
+func getRealmSettings(realm map[string]any) (map[string]any, []string) {
+	settings := map[string]any{}
+	var droppedKeys []string
+	for key, value := range realm {
+		if slices.Contains(realmSettingsSkippedKeys, key) {
+			continue
+		}
+		switch typedValue := value.(type) {
+		case string, bool, float64, map[string]any:
+			settings[key] = value
+		case []any:
+			if !slices.ContainsFunc(typedValue, func(element any) bool {
+				switch element.(type) {
+				case map[string]any, []any:
+					return true
+				default:
+					return false
+				}
+			}) {
+				settings[key] = value
+				continue
+			}
+			droppedKeys = append(droppedKeys, key)
+		}
+	}
+	slices.Sort(droppedKeys)
+
+	return settings, droppedKeys
+}
+
+// restoreClientSecrets replaces the masked client secrets with the secrets of the tenant in Vault,
+// a masked secret without a Vault entry is dropped so that Keycloak generates a new one
+func (ks *KeycloakSvc) restoreClientSecrets(tenantName string, clients []any) ([]any, error) {
+	var secrets map[string]any
+	for _, value := range clients {
+		client, ok := value.(map[string]any)
+		if !ok || helpers.GetString(client, "secret") != constant.KeycloakMaskedSecret {
+			continue
+		}
+		if secrets == nil {
+			vaultClient, err := ks.VaultClient.Create()
+			if err != nil {
+				return nil, err
+			}
+			secrets, err = ks.VaultClient.GetSecretKey(context.Background(), vaultClient, ks.Action.VaultRootToken, fmt.Sprintf("folio/%s", tenantName))
+			if err != nil {
+				return nil, err
+			}
+			if secrets == nil {
+				secrets = map[string]any{}
+			}
+		}
+
+		clientID := helpers.GetString(client, "clientId")
+		if secret := helpers.GetString(secrets, clientID); secret != "" {
+			client["secret"] = secret
+			continue
+		}
+		delete(client, "secret")
+		slog.Warn(ks.Action.Name, "text", "Client secret not found in Vault, a new secret will be generated", "client", clientID, "realm", tenantName)
+	}
+
+	return clients, nil
+}
@@ -16,6 +16,7 @@ type KeycloakRoleManager interface {
 	GetRoles(headers map[string]string) ([]any, error)
 	GetRoleByName(roleName string, headers map[string]string) (map[string]any, error)
 	CreateRoles(configTenant string) error
+	CreateRole(tenantName string, roleName string) error
 	RemoveRoles(tenantName string) error
 }
 
@@ -64,7 +65,6 @@ func (ks *KeycloakSvc) GetRoleByName(roleName string, headers map[string]string)
 }
 
 func (ks *KeycloakSvc) CreateRoles(configTenant string) error {
-	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/roles")
 	roleNames := helpers.SortedMapKeys(ks.Action.ConfigRoles)
 
 	for _, role := range roleNames {
@@ -74,24 +74,32 @@ func (ks *KeycloakSvc) CreateRoles(configTenant string) error {
 		if configTenant != tenantName {
 			continue
 		}
-
-		headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
-		if err != nil {
+		if err := ks.CreateRole(tenantName, role); err != nil {
 			return err
 		}
+	}
 
-		payload, err := json.Marshal(map[string]string{
-			"name":        ks.Action.Caser.String(role),
-			"description": "Default",
-		})
-		if err != nil {
-			return err
-		}
-		if err := ks.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
-			return err
-		}
-		slog.Info(ks.Action.Name, "text", "Created role", "role", role, "tenant", tenantName)
+	return nil
+}
+
+func (ks *KeycloakSvc) CreateRole(tenantName string, roleName string) error {
+	requestURL := ks.Action.GetRequestURL(constant.KongPort, "/roles")
+	headers, err := helpers.SecureOkapiTenantApplicationJSONHeaders(tenantName, ks.Action.KeycloakAccessToken)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"name":        ks.Action.Caser.String(roleName),
+		"description": "Default",
+	})
+	if err != nil {
+		return err
+	}
+	if err := ks.HTTPClient.PostReturnNoContent(requestURL, payload, headers); err != nil {
+		return err
 	}
+	slog.Info(ks.Action.Name, "text", "Created role", "role", roleName, "tenant", tenantName)
 
 	return nil
 }
@@ -40,6 +40,24 @@ func (m *MockVaultClient) GetSecretKey(ctx context.Context, client *vault.Client
 	return args.Get(0).(map[string]any), args.Error(1)
 }
 
+func (m *MockVaultClient) ListSecrets(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) ([]string, error) {
+	args := m.Called(ctx, client, vaultRootToken, secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockVaultClient) PutSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string, data map[string]any) error {
+	args := m.Called(ctx, client, vaultRootToken, secretPath, data)
+	return args.Error(0)
+}
+
+func (m *MockVaultClient) DeleteSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) error {
+	args := m.Called(ctx, client, vaultRootToken, secretPath)
+	return args.Error(0)
+}
+
 // MockManagementSvc is a mock for managementsvc.ManagementProcessor
 type MockManagementSvc struct {
 	mock.Mock
@@ -58,6 +76,11 @@ func (m *MockManagementSvc) CreateTenants() error {
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) CreateTenant(tenantName string) error {
+	args := m.Called(tenantName)
+	return args.Error(0)
+}
+
 func (m *MockManagementSvc) RemoveTenants(consortiumName string, tenantType constant.TenantType) error {
 	args := m.Called(consortiumName, tenantType)
 	return args.Error(0)
@@ -124,6 +147,11 @@ func (m *MockManagementSvc) CreateTenantEntitlement(consortiumName string, tenan
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) CreateTenantEntitlementForTenant(consortiumName string, tenantID string, tenantName string) error {
+	args := m.Called(consortiumName, tenantID, tenantName)
+	return args.Error(0)
+}
+
 func (m *MockManagementSvc) UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
 	args := m.Called(consortiumName, tenantType, newApplicationID)
 	return args.Error(0)
@@ -134,6 +162,11 @@ func (m *MockManagementSvc) RemoveTenantEntitlements(consortiumName string, tena
 	return args.Error(0)
 }
 
+func (m *MockManagementSvc) RemoveTenantEntitlementForTenant(tenantID string, tenantName string, purgeSchemas bool) error {
+	args := m.Called(tenantID, tenantName, purgeSchemas)
+	return args.Error(0)
+}
+
 func TestNew(t *testing.T) {
 	// Arrange
 	action := testhelpers.NewMockAction()
@@ -823,6 +856,78 @@ func TestGetAccessToken_NoToken(t *testing.T) {
 	assert.Empty(t, token)
 }
 
+// ==================== GetUserAccessToken Tests ====================
+
+func TestGetUserAccessToken_Success(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.VaultRootToken = "root-token"
+	action.ConfigGlobalEnv = map[string]string{
+		"kc_login_client_suffix": "-application",
+	}
+	mockVault := &MockVaultClient{}
+	mockMgmt := &MockManagementSvc{}
+	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
+
+	vaultClient := &vault.Client{}
+	secrets := map[string]any{
+		"test-tenant-application": "login-client-secret",
+	}
+
+	mockVault.On("Create").Return(vaultClient, nil)
+	mockVault.On("GetSecretKey", mock.Anything, vaultClient, "root-token", "folio/test-tenant").Return(secrets, nil)
+	mockHTTP.On("PostFormDataReturnStruct",
+		"http://keycloak.eureka:8080/realms/test-tenant/protocol/openid-connect/token",
+		mock.MatchedBy(func(formData url.Values) bool {
+			return formData.Get("grant_type") == "password" &&
+				formData.Get("client_id") == "test-tenant-application" &&
+				formData.Get("client_secret") == "login-client-secret" &&
+				formData.Get("username") == "test-user" &&
+				formData.Get("password") == "test-password"
+		}),
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(3).(*map[string]any)
+			*target = map[string]any{"access_token": "user-access-token"}
+		}).
+		Return(nil)
+
+	// Act
+	token, err := svc.GetUserAccessToken("test-tenant", "test-user", "test-password")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "user-access-token", token)
+	mockVault.AssertExpectations(t)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestGetUserAccessToken_NoToken(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.VaultRootToken = "root-token"
+	mockVault := &MockVaultClient{}
+	mockMgmt := &MockManagementSvc{}
+	svc := keycloaksvc.New(action, mockHTTP, mockVault, mockMgmt)
+
+	vaultClient := &vault.Client{}
+	mockVault.On("Create").Return(vaultClient, nil)
+	mockVault.On("GetSecretKey", mock.Anything, vaultClient, "root-token", "folio/test-tenant").Return(map[string]any{}, nil)
+	mockHTTP.On("PostFormDataReturnStruct", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Act
+	token, err := svc.GetUserAccessToken("test-tenant", "test-user", "test-password")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "access token")
+	assert.Empty(t, token)
+	mockHTTP.AssertExpectations(t)
+}
+
 // ==================== Role Tests ====================
 
 func TestGetRoles_Success(t *testing.T) {
@@ -1436,6 +1541,21 @@ func TestCreateUsers_SkipsDifferentTenant(t *testing.T) {
 	mockHTTP.AssertNotCalled(t, "PostReturnStruct")
 }
 
+func TestCreateUser_NotInConfig(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.ConfigUsers = map[string]any{}
+	svc := keycloaksvc.New(action, mockHTTP, &MockVaultClient{}, &MockManagementSvc{})
+
+	// Act
+	err := svc.CreateUser("test-tenant", "testuser")
+
+	// Assert
+	assert.Equal(t, apperrors.ConfigUserNotFound("testuser"), err)
+	mockHTTP.AssertNotCalled(t, "PostReturnStruct")
+}
+
 func TestCreateUsers_HeaderCreationError(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
@@ -2538,3 +2658,197 @@ func TestAttachCapabilitySetsToRoles_PostError(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 	mockHTTP.AssertExpectations(t)
 }
+
+func TestResetUserPassword_Success(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.KeycloakMasterAccessToken = "master-token"
+	svc := keycloaksvc.New(action, mockHTTP, &MockVaultClient{}, &MockManagementSvc{})
+
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.MatchedBy(func(urlStr string) bool {
+			return strings.HasSuffix(urlStr, "/admin/realms/diku/users?username=diku_admin&exact=true")
+		}),
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.KeycloakRealmUsersResponse)
+			*target = models.KeycloakRealmUsersResponse{{ID: "user-1", Username: "diku_admin"}}
+		}).
+		Return(nil)
+	mockHTTP.On("PutReturnNoContent",
+		mock.MatchedBy(func(urlStr string) bool {
+			return strings.HasSuffix(urlStr, "/admin/realms/diku/users/user-1/reset-password")
+		}),
+		mock.MatchedBy(func(payload []byte) bool {
+			var credential models.KeycloakCredentialRequest
+			return json.Unmarshal(payload, &credential) == nil &&
+				credential.Type == constant.Password && credential.Value == "new-password" && !credential.Temporary
+		}),
+		mock.MatchedBy(func(headers map[string]string) bool {
+			return headers[constant.AuthorizationHeader] == "Bearer master-token"
+		})).
+		Return(nil)
+
+	// Act
+	err := svc.ResetUserPassword("diku", "diku_admin", "new-password")
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestResetUserPassword_UserNotFound(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.KeycloakMasterAccessToken = "master-token"
+	svc := keycloaksvc.New(action, mockHTTP, &MockVaultClient{}, &MockManagementSvc{})
+
+	mockHTTP.On("GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Act
+	err := svc.ResetUserPassword("diku", "missing", "new-password")
+
+	// Assert
+	assert.ErrorIs(t, err, apperrors.ErrNotFound)
+	mockHTTP.AssertNotCalled(t, "PutReturnNoContent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExportRealm_WithUsers(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.KeycloakMasterAccessToken = "test-master-token"
+	svc := keycloaksvc.New(action, mockHTTP, &MockVaultClient{}, &MockManagementSvc{})
+
+	mockHTTP.On("PostReturnStruct", "http://keycloak.eureka:8080/admin/realms/diku/partial-export?exportClients=true&exportGroupsAndRoles=true", []byte(nil), mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(3).(*map[string]any)
+			*target = map[string]any{"realm": "diku", "accessTokenLifespan": float64(3600), "clients": []any{map[string]any{"clientId": "diku-application"}}}
+		}).
+		Return(nil)
+	mockHTTP.On("GetRetryReturnStruct", "http://keycloak.eureka:8080/admin/realms/diku/users?briefRepresentation=false&first=0&max=100", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*[]map[string]any)
+			*target = []map[string]any{
+				{"id": "user-1", "username": "diku_admin"},
+				{"id": "user-2", "username": "service-account-diku-application", "serviceAccountClientId": "diku-application"},
+			}
+		}).
+		Return(nil)
+	mockHTTP.On("GetRetryReturnStruct", "http://keycloak.eureka:8080/admin/realms/diku/users/user-1/role-mappings", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.KeycloakUserRoleMappings)
+			*target = models.KeycloakUserRoleMappings{
+				RealmMappings: []models.KeycloakRole{{Name: "default-roles-diku"}},
+				ClientMappings: map[string]models.KeycloakClientRoleMappings{
+					"diku-application": {Client: "diku-application", Mappings: []models.KeycloakRole{{Name: "uma_protection"}}},
+				},
+			}
+		}).
+		Return(nil)
+	mockHTTP.On("GetRetryReturnStruct", "http://keycloak.eureka:8080/admin/realms/diku/users/user-1/groups", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*[]models.KeycloakGroup)
+			*target = []models.KeycloakGroup{{ID: "group-1", Name: "staff", Path: "/staff"}}
+		}).
+		Return(nil)
+
+	// Act
+	realm, err := svc.ExportRealm("diku", true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, float64(3600), realm["accessTokenLifespan"])
+	users := realm["users"].([]any)
+	assert.Len(t, users, 1)
+	user := users[0].(map[string]any)
+	assert.Equal(t, "diku_admin", user["username"])
+	assert.Equal(t, []string{"default-roles-diku"}, user["realmRoles"])
+	assert.Equal(t, map[string][]string{"diku-application": {"uma_protection"}}, user["clientRoles"])
+	assert.Equal(t, []string{"/staff"}, user["groups"])
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestImportRealm(t *testing.T) {
+	t.Run("TestImportRealm_Success", func(t *testing.T) {
+		// Arrange
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		action := testhelpers.NewMockAction()
+		action.KeycloakMasterAccessToken = "test-master-token"
+		action.VaultRootToken = "root-token"
+		mockVault := &MockVaultClient{}
+		svc := keycloaksvc.New(action, mockHTTP, mockVault, &MockManagementSvc{})
+		vaultClient := &vault.Client{}
+		realm := map[string]any{
+			"id":                  "realm-uuid",
+			"realm":               "diku",
+			"accessTokenLifespan": float64(600),
+			"enabled":             true,
+			"attributes":          map[string]any{"frontendUrl": ""},
+			"eventsListeners":     []any{"jboss-logging"},
+			"defaultRole":         map[string]any{"id": "role-uuid", "name": "default-roles-diku"},
+			"components":          map[string]any{"org.keycloak.keys.KeyProvider": []any{map[string]any{"name": "rsa-generated"}}},
+			"authenticationFlows": []any{map[string]any{"alias": "browser"}},
+			"roles":               map[string]any{"realm": []any{map[string]any{"name": "admin"}}},
+			"clients": []any{
+				map[string]any{"clientId": "sidecar-module-access-client", "secret": constant.KeycloakMaskedSecret},
+				map[string]any{"clientId": "diku-application", "secret": constant.KeycloakMaskedSecret},
+				map[string]any{"clientId": "diku-login-application", "publicClient": true},
+			},
+		}
+
+		mockHTTP.On("PutReturnNoContent", "http://keycloak.eureka:8080/admin/realms/diku", mock.MatchedBy(func(payload []byte) bool {
+			var settings map[string]any
+			_ = json.Unmarshal(payload, &settings)
+			return assert.ObjectsAreEqual(map[string]any{
+				"accessTokenLifespan": float64(600),
+				"enabled":             true,
+				"attributes":          map[string]any{"frontendUrl": ""},
+				"eventsListeners":     []any{"jboss-logging"},
+			}, settings)
+		}), mock.Anything).Return(nil)
+		mockVault.On("Create").Return(vaultClient, nil)
+		mockVault.On("GetSecretKey", mock.Anything, vaultClient, "root-token", "folio/diku").
+			Return(map[string]any{"sidecar-module-access-client": "sidecar-secret"}, nil).Once()
+		mockHTTP.On("PostReturnStruct", "http://keycloak.eureka:8080/admin/realms/diku/partialImport", mock.MatchedBy(func(payload []byte) bool {
+			var request models.KeycloakPartialImportRequest
+			_ = json.Unmarshal(payload, &request)
+			sidecarClient := request.Clients[0].(map[string]any)
+			applicationClient := request.Clients[1].(map[string]any)
+			_, hasSecret := applicationClient["secret"]
+			return request.IfResourceExists == constant.KeycloakImportOverwrite && sidecarClient["secret"] == "sidecar-secret" && !hasSecret && request.Roles != nil
+		}), mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				target := args.Get(3).(*models.KeycloakPartialImportResponse)
+				*target = models.KeycloakPartialImportResponse{Added: 2, Overwritten: 3}
+			}).
+			Return(nil)
+
+		// Act
+		response, err := svc.ImportRealm("diku", realm, true)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &models.KeycloakPartialImportResponse{Added: 2, Overwritten: 3}, response)
+		mockHTTP.AssertExpectations(t)
+		mockVault.AssertExpectations(t)
+	})
+
+	t.Run("TestImportRealm_RealmMismatch", func(t *testing.T) {
+		// Arrange
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		action := testhelpers.NewMockAction()
+		svc := keycloaksvc.New(action, mockHTTP, &MockVaultClient{}, &MockManagementSvc{})
+
+		// Act
+		response, err := svc.ImportRealm("fs09000000", map[string]any{"realm": "diku"}, false)
+
+		// Assert
+		assert.Equal(t, apperrors.RealmMismatch("diku", "fs09000000"), err)
+		assert.Nil(t, response)
+		mockHTTP.AssertNotCalled(t, "PutReturnNoContent", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net/url"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 )
@@ -14,7 +16,9 @@ import (
 type KeycloakUserManager interface {
 	GetUsers(tenantName string) ([]any, error)
 	CreateUsers(configTenant string) error
+	CreateUser(tenantName string, username string) error
 	RemoveUsers(tenantName string) error
+	ResetUserPassword(tenantName string, username string, password string) error
 }
 
 func (ks *KeycloakSvc) GetUsers(tenantName string) ([]any, error) {
@@ -56,22 +60,33 @@ func (ks *KeycloakSvc) CreateUsers(configTenant string) error {
 		if configTenant != tenantName {
 			continue
 		}
-
-		createdUser, err := ks.createUser(tenantName, username, entry)
-		if err != nil {
+		if err := ks.CreateUser(tenantName, username); err != nil {
 			return err
 		}
+	}
 
-		userID := helpers.GetString(createdUser, "id")
-		if err := ks.attachUserPassword(tenantName, userID, username, entry); err != nil {
-			return err
-		}
+	return nil
+}
 
-		userRoles := helpers.GetAnySlice(entry, "roles")
-		if len(userRoles) > 0 {
-			if err := ks.attachUserRoles(tenantName, userID, username, userRoles); err != nil {
-				return err
-			}
+func (ks *KeycloakSvc) CreateUser(tenantName string, username string) error {
+	entry, ok := ks.Action.ConfigUsers[username].(map[string]any)
+	if !ok {
+		return errors.ConfigUserNotFound(username)
+	}
+	createdUser, err := ks.createUser(tenantName, username, entry)
+	if err != nil {
+		return err
+	}
+
+	userID := helpers.GetString(createdUser, "id")
+	if err := ks.attachUserPassword(tenantName, userID, username, entry); err != nil {
+		return err
+	}
+
+	userRoles := helpers.GetAnySlice(entry, "roles")
+	if len(userRoles) > 0 {
+		if err := ks.attachUserRoles(tenantName, userID, username, userRoles); err != nil {
+			return err
 		}
 	}
 
@@ -195,3 +210,33 @@ func (ks *KeycloakSvc) RemoveUsers(tenantName string) error {
 
 	return nil
 }
+
+// ResetUserPassword sets a new permanent password for a user of the tenant realm through the Keycloak admin API
+func (ks *KeycloakSvc) ResetUserPassword(tenantName string, username string, password string) error {
+	headers, err := helpers.SecureApplicationJSONHeaders(ks.Action.KeycloakMasterAccessToken)
+	if err != nil {
+		return err
+	}
+
+	getRequestURL := fmt.Sprintf("%s/admin/realms/%s/users?username=%s&exact=true", constant.KeycloakHTTP, tenantName, url.QueryEscape(username))
+	var decodedResponse models.KeycloakRealmUsersResponse
+	if err := ks.HTTPClient.GetRetryReturnStruct(getRequestURL, headers, &decodedResponse); err != nil {
+		return err
+	}
+	if len(decodedResponse) != 1 {
+		return errors.UserNotFound(username, tenantName)
+	}
+
+	payload, err := json.Marshal(models.KeycloakCredentialRequest{Type: constant.Password, Value: password, Temporary: false})
+	if err != nil {
+		return err
+	}
+
+	putRequestURL := fmt.Sprintf("%s/admin/realms/%s/users/%s/reset-password", constant.KeycloakHTTP, tenantName, decodedResponse[0].ID)
+	if err := ks.HTTPClient.PutReturnNoContent(putRequestURL, payload, headers); err != nil {
+		return err
+	}
+	slog.Info(ks.Action.Name, "text", "Reset keycloak user password", "username", username, "realm", tenantName)
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 type KongProcessor interface {
 	KongRouteReader
 	KongRouteReadinessChecker
+	KongRouteInspector
 }
 
 // KongRouteReader defines the interface for Kong route read operations
@@ -0,0 +1,368 @@
+package kongsvc
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+)
+
+// routeRequest holds the parts of a request that the route expressions are evaluated against
+type routeRequest struct {
+	method string
+	path   string
+}
+
+// routeExpression is a parsed Kong router expression, predicates on fields other than the method
+// and the path, e.g. the tenant header, cannot be decided from a request line and match any request
+type routeExpression interface {
+	matches(request routeRequest) bool
+	methods() []string
+}
+
+type orExpression struct {
+	left  routeExpression
+	right routeExpression
+}
+
+func (e orExpression) matches(request routeRequest) bool {
+	return e.left.matches(request) || e.right.matches(request)
+}
+
+func (e orExpression) methods() []string {
+	return mergeMethods(e.left.methods(), e.right.methods())
+}
+
+type andExpression struct {
+	left  routeExpression
+	right routeExpression
+}
+
+func (e andExpression) matches(request routeRequest) bool {
+	return e.left.matches(request) && e.right.matches(request)
+}
+
+func (e andExpression) methods() []string {
+	return mergeMethods(e.left.methods(), e.right.methods())
+}
+
+type notExpression struct {
+	operand routeExpression
+}
+
+func (e notExpression) matches(request routeRequest) bool {
+	return !e.operand.matches(request)
+}
+
+func (e notExpression) methods() []string {
+	return nil
+}
+
+type predicateExpression struct {
+	field    string
+	lower    bool
+	operator string
+	value    string
+	pattern  *regexp.Regexp
+}
+
+func (e predicateExpression) matches(request routeRequest) bool {
+	var actual string
+	switch e.field {
+	case "http.path":
+		actual = request.path
+	case "http.method":
+		actual = request.method
+	default:
+		return true
+	}
+	if e.lower {
+		actual = strings.ToLower(actual)
+	}
+
+	switch e.operator {
+	case "==":
+		return actual == e.value
+	case "!=":
+		return actual != e.value
+	case "~":
+		return e.pattern.MatchString(actual)
+	case "^=":
+		return strings.HasPrefix(actual, e.value)
+	case "=^":
+		return strings.HasSuffix(actual, e.value)
+	default:
+		return strings.Contains(actual, e.value)
+	}
+}
+
+func (e predicateExpression) methods() []string {
+	if e.field == "http.method" && e.operator == "==" {
+		return []string{e.value}
+	}
+
+	return nil
+}
+
+func mergeMethods(left, right []string) []string {
+	methods := append(slices.Clone(left), right...)
+	slices.Sort(methods)
+
+	return slices.Compact(methods)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenValue
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLeftParen
+	tokenRightParen
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	position int
+}
+
+// expressionParser is a recursive descent parser for the subset of the Kong router expression
+// language used by the gateway routes: predicates combined with &&, || and !, and parentheses
+type expressionParser struct {
+	expression string
+	tokens     []token
+	index      int
+}
+
+func parseRouteExpression(expression string) (routeExpression, error) {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &expressionParser{expression: expression, tokens: tokens}
+	result, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := parser.peek(); next.kind != tokenEOF {
+		return nil, errors.KongRouteExpressionInvalid(expression, next.position, "unexpected "+next.text)
+	}
+
+	return result, nil
+}
+
+func (p *expressionParser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *expressionParser) next() token {
+	current := p.tokens[p.index]
+	if current.kind != tokenEOF {
+		p.index++
+	}
+
+	return current
+}
+
+func (p *expressionParser) parseOr() (routeExpression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpression{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parseAnd() (routeExpression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpression{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *expressionParser) parseUnary() (routeExpression, error) {
+	switch current := p.peek(); current.kind {
+	case tokenNot:
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpression{operand: operand}, nil
+	case tokenLeftParen:
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return nil, errors.KongRouteExpressionInvalid(p.expression, closing.position, "expected )")
+		}
+		return inner, nil
+	case tokenIdentifier:
+		return p.parsePredicate()
+	default:
+		return nil, errors.KongRouteExpressionInvalid(p.expression, current.position, "expected a predicate")
+	}
+}
+
+// parsePredicate parses a field, optionally wrapped in lower(), followed by an operator and a value
+func (p *expressionParser) parsePredicate() (routeExpression, error) {
+	field := p.next()
+	predicate := predicateExpression{field: field.text}
+	if field.text == "lower" && p.peek().kind == tokenLeftParen {
+		p.next()
+		inner := p.next()
+		if inner.kind != tokenIdentifier {
+			return nil, errors.KongRouteExpressionInvalid(p.expression, inner.position, "expected a field")
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return nil, errors.KongRouteExpressionInvalid(p.expression, closing.position, "expected )")
+		}
+		predicate.field, predicate.lower = inner.text, true
+	}
+
+	operator := p.next()
+	if operator.kind != tokenOperator && (operator.kind != tokenIdentifier || operator.text != "contains") {
+		return nil, errors.KongRouteExpressionInvalid(p.expression, operator.position, "expected an operator")
+	}
+	predicate.operator = operator.text
+
+	value := p.next()
+	if value.kind != tokenValue {
+		return nil, errors.KongRouteExpressionInvalid(p.expression, value.position, "expected a value")
+	}
+	predicate.value = value.text
+	if predicate.operator == "~" {
+		pattern, err := regexp.Compile(value.text)
+		if err != nil {
+			return nil, errors.KongRouteExpressionInvalid(p.expression, value.position, err.Error())
+		}
+		predicate.pattern = pattern
+	}
+
+	return predicate, nil
+}
+
+// tokenizeExpression splits an expression into tokens, values are double-quoted strings,
+// raw strings in the r#"..."# form or numbers
+func tokenizeExpression(expression string) ([]token, error) {
+	var tokens []token
+	for position := 0; position < len(expression); {
+		rest := expression[position:]
+		switch {
+		case rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\n' || rest[0] == '\r':
+			position++
+		case strings.HasPrefix(rest, "&&"):
+			tokens = append(tokens, token{kind: tokenAnd, text: "&&", position: position})
+			position += 2
+		case strings.HasPrefix(rest, "||"):
+			tokens = append(tokens, token{kind: tokenOr, text: "||", position: position})
+			position += 2
+		case strings.HasPrefix(rest, "=="), strings.HasPrefix(rest, "!="), strings.HasPrefix(rest, "^="), strings.HasPrefix(rest, "=^"):
+			tokens = append(tokens, token{kind: tokenOperator, text: rest[:2], position: position})
+			position += 2
+		case rest[0] == '~':
+			tokens = append(tokens, token{kind: tokenOperator, text: "~", position: position})
+			position++
+		case rest[0] == '!':
+			tokens = append(tokens, token{kind: tokenNot, text: "!", position: position})
+			position++
+		case rest[0] == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", position: position})
+			position++
+		case rest[0] == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", position: position})
+			position++
+		case strings.HasPrefix(rest, `r#"`):
+			end := strings.Index(rest[3:], `"#`)
+			if end < 0 {
+				return nil, errors.KongRouteExpressionInvalid(expression, position, "unterminated raw string")
+			}
+			tokens = append(tokens, token{kind: tokenValue, text: rest[3 : 3+end], position: position})
+			position += 3 + end + 2
+		case rest[0] == '"':
+			value, length, ok := readQuotedString(rest)
+			if !ok {
+				return nil, errors.KongRouteExpressionInvalid(expression, position, "unterminated string")
+			}
+			tokens = append(tokens, token{kind: tokenValue, text: value, position: position})
+			position += length
+		case isIdentifierChar(rest[0]):
+			length := 1
+			for length < len(rest) && isIdentifierChar(rest[length]) {
+				length++
+			}
+			kind := tokenIdentifier
+			if rest[0] >= '0' && rest[0] <= '9' {
+				kind = tokenValue
+			}
+			tokens = append(tokens, token{kind: kind, text: rest[:length], position: position})
+			position += length
+		default:
+			return nil, errors.KongRouteExpressionInvalid(expression, position, "unexpected character "+rest[:1])
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, text: "end of expression", position: len(expression)}), nil
+}
+
+// readQuotedString returns the unescaped content of the double-quoted string at the start of the text and its length
+func readQuotedString(text string) (string, int, bool) {
+	var value strings.Builder
+	for i := 1; i < len(text); i++ {
+		switch text[i] {
+		case '"':
+			return value.String(), i + 1, true
+		case '\\':
+			if i+1 == len(text) {
+				return "", 0, false
+			}
+			i++
+			switch text[i] {
+			case '"', '\\':
+				value.WriteByte(text[i])
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			default:
+				value.WriteByte('\\')
+				value.WriteByte(text[i])
+			}
+		default:
+			value.WriteByte(text[i])
+		}
+	}
+
+	return "", 0, false
+}
+
+func isIdentifierChar(char byte) bool {
+	return char == '_' || char == '.' || char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char >= '0' && char <= '9'
+}
@@ -14,30 +14,35 @@ type KongRouteReadinessChecker interface {
 	CheckRouteReadiness() error
 }
 
+// GetRouteReadinessExpressions returns the route expressions of the management APIs required by the deployment
+func GetRouteReadinessExpressions() []string {
+	return []string{
+		// Applications
+		`(http.path == "/applications" && http.method == "GET")`,
+		`(http.path == "/applications" && http.method == "POST")`,
+		`(http.path ~ "^/applications/([^/]+)$" && http.method == "DELETE")`,
+
+		// Module Discovery
+		`(http.path == "/modules/discovery" && http.method == "GET")`,
+		`(http.path == "/modules/discovery" && http.method == "POST")`,
+		`(http.path ~ "^/modules/([^/]+)/discovery$" && http.method == "PUT")`,
+
+		// Tenants
+		`(http.path == "/tenants" && http.method == "GET")`,
+		`(http.path == "/tenants" && http.method == "POST")`,
+		`(http.path ~ "^/tenants/([^/]+)$" && http.method == "DELETE")`,
+
+		// Tenant Entitlement
+		`(http.path == "/entitlements" && http.method == "GET")`,
+		`(http.path == "/entitlements" && http.method == "POST")`,
+		`(http.path == "/entitlements" && http.method == "PUT")`,
+		`(http.path == "/entitlements" && http.method == "DELETE")`,
+	}
+}
+
 func (ks *KongSvc) CheckRouteReadiness() error {
 	var (
-		expressions = []string{
-			// Applications
-			`(http.path == "/applications" && http.method == "GET")`,
-			`(http.path == "/applications" && http.method == "POST")`,
-			`(http.path ~ "^/applications/([^/]+)$" && http.method == "DELETE")`,
-
-			// Module Discovery
-			`(http.path == "/modules/discovery" && http.method == "GET")`,
-			`(http.path == "/modules/discovery" && http.method == "POST")`,
-			`(http.path ~ "^/modules/([^/]+)/discovery$" && http.method == "PUT")`,
-
-			// Tenants
-			`(http.path == "/tenants" && http.method == "GET")`,
-			`(http.path == "/tenants" && http.method == "POST")`,
-			`(http.path ~ "^/tenants/([^/]+)$" && http.method == "DELETE")`,
-
-			// Tenant Entitlement
-			`(http.path == "/entitlements" && http.method == "GET")`,
-			`(http.path == "/entitlements" && http.method == "POST")`,
-			`(http.path == "/entitlements" && http.method == "PUT")`,
-			`(http.path == "/entitlements" && http.method == "DELETE")`,
-		}
+		expressions  = GetRouteReadinessExpressions()
 		expected     = len(expressions)
 		maxRetries   = helpers.DefaultInt(ks.ReadinessMaxRetries, constant.KongRouteReadinessMaxRetries)
 		waitDuration = helpers.DefaultDuration(ks.ReadinessWait, constant.KongReadinessWait)
@@ -0,0 +1,189 @@
+package kongsvc
+
+import (
+	"cmp"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// KongRouteInspector defines the interface for listing the module routes and finding the routes that serve a request
+type KongRouteInspector interface {
+	ListAllServices() ([]models.KongService, error)
+	ListRoutes(moduleName string) ([]models.KongRouteInfo, error)
+	FindRoutes(method, path string) ([]models.KongRouteInfo, error)
+}
+
+// inspectedRoute holds a route with its parsed expression, the expression is nil for classic routes and unsupported expressions
+type inspectedRoute struct {
+	info       models.KongRouteInfo
+	route      models.KongRoute
+	expression routeExpression
+}
+
+func (ks *KongSvc) ListAllServices() ([]models.KongService, error) {
+	var allServices []models.KongService
+	path := "/services"
+	for {
+		requestURL := ks.Action.GetRequestURL(constant.KongAdminPort, path)
+
+		var decodedResponse models.KongServicesResponse
+		if err := ks.HTTPClient.GetRetryReturnStruct(requestURL, nil, &decodedResponse); err != nil {
+			return nil, err
+		}
+
+		allServices = append(allServices, decodedResponse.Data...)
+		if decodedResponse.Next == "" {
+			break
+		}
+		path = decodedResponse.Next
+	}
+
+	return allServices, nil
+}
+
+// ListRoutes returns the routes with their module and discovery location sorted by module id,
+// only the routes of the module are returned when a module name is given
+func (ks *KongSvc) ListRoutes(moduleName string) ([]models.KongRouteInfo, error) {
+	inspectedRoutes, err := ks.inspectRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	routes := []models.KongRouteInfo{}
+	for _, inspected := range inspectedRoutes {
+		if moduleName == "" || helpers.GetModuleNameFromID(inspected.info.ModuleID) == moduleName {
+			routes = append(routes, inspected.info)
+		}
+	}
+
+	return routes, nil
+}
+
+// FindRoutes returns the routes whose expression or classic method and path rules match the request line, ordered
+// by descending priority so that the route Kong picks comes first, the query string of the path is ignored
+func (ks *KongSvc) FindRoutes(method, path string) ([]models.KongRouteInfo, error) {
+	inspectedRoutes, err := ks.inspectRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	path, _, _ = strings.Cut(path, "?")
+	request := routeRequest{method: strings.ToUpper(method), path: path}
+	routes := []models.KongRouteInfo{}
+	for _, inspected := range inspectedRoutes {
+		if inspected.route.Expression == "" && matchesClassicRoute(inspected.route, request) ||
+			inspected.expression != nil && inspected.expression.matches(request) {
+			routes = append(routes, inspected.info)
+		}
+	}
+	slices.SortStableFunc(routes, func(a, b models.KongRouteInfo) int {
+		return cmp.Compare(b.Priority, a.Priority)
+	})
+
+	return routes, nil
+}
+
+func (ks *KongSvc) inspectRoutes() ([]inspectedRoute, error) {
+	routes, err := ks.ListAllRoutes()
+	if err != nil {
+		return nil, err
+	}
+	services, err := ks.ListAllServices()
+	if err != nil {
+		return nil, err
+	}
+	servicesByID := make(map[string]models.KongService, len(services))
+	for _, service := range services {
+		servicesByID[service.ID] = service
+	}
+
+	inspectedRoutes := make([]inspectedRoute, 0, len(routes))
+	for _, route := range routes {
+		service := servicesByID[route.Service.ID]
+		inspected := inspectedRoute{
+			route: route,
+			info: models.KongRouteInfo{
+				RouteID:    route.ID,
+				Name:       route.Name,
+				Expression: route.Expression,
+				Paths:      route.Paths,
+				Methods:    route.Methods,
+				Priority:   route.Priority,
+				Service:    service.Name,
+				ModuleID:   getRouteModuleID(route, service),
+				Location:   getServiceLocation(service),
+			},
+		}
+		if route.Expression != "" {
+			expression, err := parseRouteExpression(route.Expression)
+			if err != nil {
+				slog.Warn(ks.Action.Name, "text", "Kong route expression is not supported", "route", route.ID, "error", err)
+			} else {
+				inspected.expression = expression
+				inspected.info.Methods = expression.methods()
+			}
+		}
+		if inspected.info.Methods == nil {
+			inspected.info.Methods = []string{}
+		}
+		inspectedRoutes = append(inspectedRoutes, inspected)
+	}
+	slices.SortFunc(inspectedRoutes, func(a, b inspectedRoute) int {
+		return cmp.Or(cmp.Compare(a.info.ModuleID, b.info.ModuleID),
+			cmp.Compare(a.info.Expression, b.info.Expression),
+			cmp.Compare(a.info.Name, b.info.Name))
+	})
+
+	return inspectedRoutes, nil
+}
+
+// getRouteModuleID returns the module id of a route, the services are named after the module ids they forward to
+func getRouteModuleID(route models.KongRoute, service models.KongService) string {
+	if service.Name != "" {
+		return service.Name
+	}
+	if len(route.Tags) > 0 {
+		return route.Tags[0]
+	}
+
+	return ""
+}
+
+// getServiceLocation returns the upstream URL of a service, which is the discovery location of its module
+func getServiceLocation(service models.KongService) string {
+	if service.Host == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s://%s:%d%s", service.Protocol, service.Host, service.Port, service.Path)
+}
+
+// matchesClassicRoute matches a route without an expression by its methods and its path prefixes, paths starting with ~ are regular expressions
+func matchesClassicRoute(route models.KongRoute, request routeRequest) bool {
+	if len(route.Methods) > 0 && !slices.Contains(route.Methods, request.method) {
+		return false
+	}
+	if len(route.Paths) == 0 {
+		return true
+	}
+	for _, path := range route.Paths {
+		if pattern, ok := strings.CutPrefix(path, "~"); ok {
+			if matcher, err := regexp.Compile("^(?:" + pattern + ")"); err == nil && matcher.MatchString(request.path) {
+				return true
+			}
+			continue
+		}
+		if strings.HasPrefix(request.path, path) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -546,3 +546,168 @@ func TestFindRouteByExpressions_AllExpressionsMatched(t *testing.T) {
 	assert.Len(t, routes, 13)
 	mockHTTP.AssertExpectations(t)
 }
+
+func mockRoutesAndServices(mockHTTP *testhelpers.MockHTTPClient, routes []models.KongRoute, services []models.KongService) {
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.MatchedBy(func(urlStr string) bool {
+			return strings.HasSuffix(urlStr, "/routes")
+		}),
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.KongRoutesResponse)
+			*target = models.KongRoutesResponse{Data: routes}
+		}).
+		Return(nil)
+	mockHTTP.On("GetRetryReturnStruct",
+		mock.MatchedBy(func(urlStr string) bool {
+			return strings.HasSuffix(urlStr, "/services")
+		}),
+		mock.Anything,
+		mock.Anything).
+		Run(func(args mock.Arguments) {
+			target := args.Get(2).(*models.KongServicesResponse)
+			*target = models.KongServicesResponse{Data: services}
+		}).
+		Return(nil)
+}
+
+func newOrdersRoutes() ([]models.KongRoute, []models.KongService) {
+	routes := []models.KongRoute{
+		{
+			ID:         "route-orders-get",
+			Expression: `(http.path ~ "^/orders/composite-orders/([^/]+)$" && http.method == "GET") && (http.headers.x_okapi_tenant ~ r#".*"#)`,
+			Priority:   10,
+		},
+		{
+			ID:         "route-orders-post",
+			Expression: `(http.path == "/orders/composite-orders" && (http.method == "POST" || http.method == "OPTIONS"))`,
+			Priority:   20,
+		},
+		{
+			ID:         "route-finance",
+			Expression: `(lower(http.path) ^= "/finance/" && !(http.method == "DELETE"))`,
+		},
+		{
+			ID:      "route-catch-all",
+			Paths:   []string{`~/orders/.*`},
+			Methods: []string{"GET"},
+			Tags:    []string{"mod-orders-storage-13.8.0"},
+		},
+		{
+			ID:         "route-invalid",
+			Expression: `(http.path == "/broken"`,
+		},
+	}
+	routes[0].Service.ID = "service-orders"
+	routes[1].Service.ID = "service-orders"
+	routes[2].Service.ID = "service-finance"
+	routes[3].Service.ID = "service-unknown"
+	routes[4].Service.ID = "service-finance"
+	services := []models.KongService{
+		{ID: "service-orders", Name: "mod-orders-12.9.0", Protocol: "http", Host: "mod-orders.eureka", Port: 8082},
+		{ID: "service-finance", Name: "mod-finance-5.1.0", Protocol: "http", Host: "mod-finance.eureka", Port: 8082, Path: "/"},
+	}
+
+	return routes, services
+}
+
+func TestListRoutes(t *testing.T) {
+	t.Run("TestListRoutes_AllModules", func(t *testing.T) {
+		// Arrange
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		svc := kongsvc.New(testhelpers.NewMockAction(), mockHTTP)
+		routes, services := newOrdersRoutes()
+		mockRoutesAndServices(mockHTTP, routes, services)
+
+		// Act
+		result, err := svc.ListRoutes("")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 5)
+		assert.Equal(t, "route-invalid", result[0].RouteID)
+		assert.Equal(t, []string{}, result[0].Methods)
+		assert.Equal(t, "route-finance", result[1].RouteID)
+		assert.Equal(t, "http://mod-finance.eureka:8082/", result[1].Location)
+		assert.Equal(t, "route-orders-post", result[2].RouteID)
+		assert.Equal(t, []string{"OPTIONS", "POST"}, result[2].Methods)
+		assert.Equal(t, "route-catch-all", result[4].RouteID)
+		assert.Equal(t, "mod-orders-storage-13.8.0", result[4].ModuleID)
+		assert.Equal(t, "", result[4].Location)
+	})
+
+	t.Run("TestListRoutes_ModuleName", func(t *testing.T) {
+		// Arrange
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		svc := kongsvc.New(testhelpers.NewMockAction(), mockHTTP)
+		routes, services := newOrdersRoutes()
+		mockRoutesAndServices(mockHTTP, routes, services)
+
+		// Act
+		result, err := svc.ListRoutes("mod-orders")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "route-orders-post", result[0].RouteID)
+		assert.Equal(t, "route-orders-get", result[1].RouteID)
+		assert.Equal(t, []string{"GET"}, result[1].Methods)
+		assert.Equal(t, "mod-orders-12.9.0", result[1].ModuleID)
+		assert.Equal(t, "http://mod-orders.eureka:8082", result[1].Location)
+	})
+}
+
+func TestFindRoutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		expected []string
+	}{
+		{name: "TestFindRoutes_RegexAndHeader", method: "GET", path: "/orders/composite-orders/123?lang=en", expected: []string{"route-orders-get", "route-catch-all"}},
+		{name: "TestFindRoutes_EqualityAndMethodAlternatives", method: "options", path: "/orders/composite-orders", expected: []string{"route-orders-post"}},
+		{name: "TestFindRoutes_LowerPrefixAndNegation", method: "PUT", path: "/Finance/budgets", expected: []string{"route-finance"}},
+		{name: "TestFindRoutes_NegatedMethod", method: "DELETE", path: "/finance/budgets", expected: []string{}},
+		{name: "TestFindRoutes_RegexDoesNotMatchSubPath", method: "POST", path: "/orders/composite-orders/123/receive", expected: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockHTTP := &testhelpers.MockHTTPClient{}
+			svc := kongsvc.New(testhelpers.NewMockAction(), mockHTTP)
+			routes, services := newOrdersRoutes()
+			mockRoutesAndServices(mockHTTP, routes, services)
+
+			// Act
+			result, err := svc.FindRoutes(tt.method, tt.path)
+
+			// Assert
+			assert.NoError(t, err)
+			routeIDs := []string{}
+			for _, route := range result {
+				routeIDs = append(routeIDs, route.RouteID)
+			}
+			assert.Equal(t, tt.expected, routeIDs)
+		})
+	}
+}
+
+func TestFindRoutes_ListServicesError(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	svc := kongsvc.New(testhelpers.NewMockAction(), mockHTTP)
+	mockHTTP.On("GetRetryReturnStruct", mock.MatchedBy(func(urlStr string) bool {
+		return strings.HasSuffix(urlStr, "/routes")
+	}), mock.Anything, mock.Anything).Return(nil)
+	mockHTTP.On("GetRetryReturnStruct", mock.MatchedBy(func(urlStr string) bool {
+		return strings.HasSuffix(urlStr, "/services")
+	}), mock.Anything, mock.Anything).Return(errors.New("connection refused"))
+
+	// Act
+	result, err := svc.FindRoutes("GET", "/orders")
+
+	// Assert
+	assert.EqualError(t, err, "connection refused")
+	assert.Nil(t, result)
+}
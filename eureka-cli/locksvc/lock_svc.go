@@ -0,0 +1,394 @@
+package locksvc
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/registrysvc"
+	"github.com/spf13/viper"
+)
+
+// LockProcessor defines the interface for profile lock file operations
+type LockProcessor interface {
+	LockReader
+	LockWriter
+	LockDiffer
+}
+
+// LockReader defines the interface for reading the profile lock file
+type LockReader interface {
+	GetLockFilePath() string
+	ReadLock() (*models.Lock, error)
+	ValidateLock(lock *models.Lock) error
+}
+
+// LockWriter defines the interface for creating and writing the profile lock file
+type LockWriter interface {
+	CreateLock(client *client.Client, modules *models.ProxyModulesByRegistry, images []models.ModuleImage, sidecarImage string) (*models.Lock, error)
+	CaptureLock(client *client.Client, baseLock *models.Lock) (*models.Lock, error)
+	WriteLock(lock *models.Lock) error
+}
+
+// LockDiffer defines the interface for comparing two lock files
+type LockDiffer interface {
+	DiffLock(oldLock *models.Lock, newLock *models.Lock) []models.LockDiff
+}
+
+// LockSvc provides functionality for pinning the resolved module versions and image digests of a profile
+// into a lock file placed next to the config file, so that a deployment can be reproduced exactly
+type LockSvc struct {
+	Action      *action.Action
+	RegistrySvc registrysvc.RegistryProcessor
+}
+
+// New creates a new LockSvc instance
+func New(action *action.Action, registrySvc registrysvc.RegistryProcessor) *LockSvc {
+	return &LockSvc{Action: action, RegistrySvc: registrySvc}
+}
+
+func (ls *LockSvc) GetLockFilePath() string {
+	fileName := fmt.Sprintf(constant.LockFilePattern, ls.Action.ConfigProfileName)
+	if configFile := viper.ConfigFileUsed(); configFile != "" {
+		return filepath.Join(filepath.Dir(configFile), fileName)
+	}
+
+	return fileName
+}
+
+func (ls *LockSvc) ReadLock() (*models.Lock, error) {
+	filePath := ls.GetLockFilePath()
+	if _, err := os.Stat(filePath); err != nil {
+		return nil, errors.LockNotFound(filePath)
+	}
+
+	var lock models.Lock
+	if err := helpers.ReadJSONFromFile(filePath, &lock); err != nil {
+		return nil, errors.LockReadFailed(filePath, err)
+	}
+
+	return &lock, nil
+}
+
+// ValidateLock checks that the lock file was created for the application of the current config
+func (ls *LockSvc) ValidateLock(lock *models.Lock) error {
+	if lock.ApplicationID != ls.Action.ConfigApplicationID {
+		return errors.LockApplicationMismatch(ls.GetLockFilePath(), lock.ApplicationID, ls.Action.ConfigApplicationID)
+	}
+
+	return nil
+}
+
+func (ls *LockSvc) WriteLock(lock *models.Lock) error {
+	filePath := ls.GetLockFilePath()
+	if err := helpers.WriteJSONToFile(filePath, lock); err != nil {
+		return errors.LockWriteFailed(filePath, err)
+	}
+	slog.Info(ls.Action.Name, "text", "Wrote lock file", "path", filePath, "modules", len(lock.Modules), "sidecarImage", lock.SidecarImage)
+
+	return nil
+}
+
+// CreateLock pins the given registry modules together with the digests of the module and sidecar images,
+// a digest is taken from the local image cache and otherwise from the image registry, local builds have no digest
+func (ls *LockSvc) CreateLock(client *client.Client, modules *models.ProxyModulesByRegistry, images []models.ModuleImage, sidecarImage string) (*models.Lock, error) {
+	var imageNames []string
+	for _, image := range images {
+		if !image.LocalBuild {
+			imageNames = append(imageNames, image.Image)
+		}
+	}
+	digests, err := ls.resolveDigests(imageNames, func(imageName string) (string, error) {
+		return ls.getImageDigest(client, imageName)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ls.buildLock(modules, images, sidecarImage, digests), nil
+}
+
+// CaptureLock pins the images of the running module, management module and sidecar containers of the profile,
+// the modules of the base lock that are not running are kept without an image so that they are not redeployed
+func (ls *LockSvc) CaptureLock(client *client.Client, baseLock *models.Lock) (*models.Lock, error) {
+	images, err := ls.getRunningImages(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return ls.buildCapturedLock(baseLock, images), nil
+}
+
+func (ls *LockSvc) getRunningImages(client *client.Client) ([]models.ModuleImage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	summaries, err := client.ContainerList(ctx, container.ListOptions{Filters: filters.NewArgs(
+		filters.Arg("name", fmt.Sprintf(constant.ProfileContainerPattern, ls.Action.ConfigProfileName)),
+		filters.Arg("name", constant.ManagementContainerPattern),
+		filters.Arg("status", container.StateRunning),
+	)})
+	if err != nil {
+		return nil, err
+	}
+
+	profilePrefix := fmt.Sprintf("eureka-%s-", ls.Action.ConfigProfileName)
+	var images []models.ModuleImage
+	for _, summary := range summaries {
+		if len(summary.Names) == 0 {
+			continue
+		}
+		containerName := strings.TrimPrefix(summary.Names[0], "/")
+		inspect, err := client.ContainerInspect(ctx, containerName)
+		if err != nil {
+			return nil, err
+		}
+		if inspect.Config == nil {
+			continue
+		}
+
+		// Containers deployed from a lock file run an image pinned to its digest
+		imageName, digest, _ := strings.Cut(inspect.Config.Image, "@")
+		if digest == "" {
+			imageInspect, err := client.ImageInspect(ctx, inspect.Image)
+			if err != nil {
+				return nil, err
+			}
+			digest = findRepoDigest(imageName, imageInspect.RepoDigests)
+		}
+		moduleName, found := strings.CutPrefix(containerName, profilePrefix)
+		if !found {
+			moduleName = strings.TrimPrefix(containerName, "eureka-")
+		}
+		if strings.HasSuffix(moduleName, "-sc") {
+			moduleName = constant.SidecarProjectName
+		}
+		images = append(images, models.ModuleImage{Module: moduleName, Image: imageName, Version: getImageTag(imageName), Digest: digest})
+	}
+
+	return images, nil
+}
+
+func (ls *LockSvc) buildCapturedLock(baseLock *models.Lock, images []models.ModuleImage) *models.Lock {
+	lock := &models.Lock{
+		Profile:       ls.Action.ConfigProfileName,
+		ApplicationID: ls.Action.ConfigApplicationID,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if baseLock != nil {
+		lock.SidecarImage, lock.SidecarDigest = baseLock.SidecarImage, baseLock.SidecarDigest
+	}
+
+	runningImages := make(map[string]models.ModuleImage)
+	for _, image := range images {
+		if image.Module == constant.SidecarProjectName {
+			lock.SidecarImage, lock.SidecarDigest = image.Image, image.Digest
+			continue
+		}
+		runningImages[image.Module] = image
+	}
+	if baseLock != nil {
+		for _, module := range baseLock.Modules {
+			if _, ok := runningImages[module.Name]; !ok {
+				lock.Modules = append(lock.Modules, models.LockedModule{ID: module.ID, Name: module.Name, Version: module.Version})
+			}
+		}
+	}
+	for moduleName, image := range runningImages {
+		lockedModule := models.LockedModule{ID: moduleName, Name: moduleName, Version: image.Version, Image: image.Image, Digest: image.Digest}
+		if image.Version != "" {
+			lockedModule.ID = fmt.Sprintf("%s-%s", moduleName, image.Version)
+		}
+		lock.Modules = append(lock.Modules, lockedModule)
+	}
+	slices.SortFunc(lock.Modules, func(a, b models.LockedModule) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	return lock
+}
+
+func (ls *LockSvc) buildLock(modules *models.ProxyModulesByRegistry, images []models.ModuleImage, sidecarImage string, digests map[string]string) *models.Lock {
+	lock := &models.Lock{
+		Profile:       ls.Action.ConfigProfileName,
+		ApplicationID: ls.Action.ConfigApplicationID,
+		SidecarImage:  sidecarImage,
+		SidecarDigest: digests[sidecarImage],
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	moduleImages := make(map[string]models.ModuleImage)
+	for _, image := range images {
+		if image.Module != constant.SidecarProjectName {
+			moduleImages[image.Module] = image
+		}
+	}
+	for _, module := range slices.Concat(modules.FolioModules, modules.EurekaModules) {
+		lockedModule := models.LockedModule{ID: module.ID, Name: helpers.GetModuleNameFromID(module.ID)}
+		if version := helpers.GetOptionalModuleVersion(module.ID); version != nil {
+			lockedModule.Version = *version
+		}
+		if image, ok := moduleImages[lockedModule.Name]; ok {
+			lockedModule.Image, lockedModule.Digest = image.Image, digests[image.Image]
+		}
+		lock.Modules = append(lock.Modules, lockedModule)
+	}
+	slices.SortFunc(lock.Modules, func(a, b models.LockedModule) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.ID, b.ID))
+	})
+
+	return lock
+}
+
+// resolveDigests resolves the digest of every image on a bounded pool of workers and joins the errors of all failed images
+func (ls *LockSvc) resolveDigests(imageNames []string, resolveFn func(imageName string) (string, error)) (map[string]string, error) {
+	imageNames = slices.Compact(slices.Sorted(slices.Values(imageNames)))
+	resolved := make([]string, len(imageNames))
+	err := helpers.RunParallel(len(imageNames), constant.DefaultParallelism, func(idx int) error {
+		digest, err := resolveFn(imageNames[idx])
+		if err != nil {
+			return errors.LockImageDigestNotFound(imageNames[idx], err)
+		}
+		resolved[idx] = digest
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	digests := make(map[string]string, len(imageNames))
+	for idx, imageName := range imageNames {
+		digests[imageName] = resolved[idx]
+	}
+
+	return digests, nil
+}
+
+func (ls *LockSvc) getImageDigest(client *client.Client, imageName string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	inspect, err := client.ImageInspect(ctx, imageName)
+	if err == nil {
+		if digest := findRepoDigest(imageName, inspect.RepoDigests); digest != "" {
+			return digest, nil
+		}
+	}
+
+	authorizationToken, err := ls.RegistrySvc.GetAuthorizationToken()
+	if err != nil {
+		return "", err
+	}
+	distribution, err := client.DistributionInspect(ctx, imageName, authorizationToken)
+	if err != nil {
+		return "", err
+	}
+	slog.Debug(ls.Action.Name, "text", "Resolved image digest from registry", "image", imageName, "digest", distribution.Descriptor.Digest)
+
+	return distribution.Descriptor.Digest.String(), nil
+}
+
+// getImageTag returns the tag of an image reference or an empty string when the image is not tagged
+func getImageTag(imageName string) string {
+	if idx := strings.LastIndex(imageName, ":"); idx >= 0 && !strings.Contains(imageName[idx:], "/") {
+		return imageName[idx+1:]
+	}
+
+	return ""
+}
+
+// findRepoDigest returns the digest of the repository digest matching the repository of the image
+func findRepoDigest(imageName string, repoDigests []string) string {
+	repository := imageName
+	if idx := strings.LastIndex(imageName, ":"); idx >= 0 && !strings.Contains(imageName[idx:], "/") {
+		repository = imageName[:idx]
+	}
+	for _, repoDigest := range repoDigests {
+		digestRepository, digest, found := strings.Cut(repoDigest, "@")
+		if found && (digestRepository == repository || strings.HasSuffix(digestRepository, "/"+repository)) {
+			return digest
+		}
+	}
+
+	return ""
+}
+
+// DiffLock lists the modules added, removed or updated between two lock files,
+// together with the changes of the sidecar image and the application
+func (ls *LockSvc) DiffLock(oldLock *models.Lock, newLock *models.Lock) []models.LockDiff {
+	if oldLock == nil {
+		oldLock = &models.Lock{}
+	}
+
+	var diffs []models.LockDiff
+	if oldLock.ApplicationID != newLock.ApplicationID {
+		diffs = append(diffs, newLockDiff(constant.LockApplicationEntry, oldLock.ApplicationID, newLock.ApplicationID, "", ""))
+	}
+	if oldLock.SidecarImage != newLock.SidecarImage || oldLock.SidecarDigest != newLock.SidecarDigest {
+		diffs = append(diffs, newLockDiff(constant.LockSidecarImageEntry, oldLock.SidecarImage, newLock.SidecarImage, oldLock.SidecarDigest, newLock.SidecarDigest))
+	}
+
+	oldModules := make(map[string]models.LockedModule)
+	for _, module := range oldLock.Modules {
+		oldModules[module.Name] = module
+	}
+	newModules := make(map[string]models.LockedModule)
+	for _, module := range newLock.Modules {
+		newModules[module.Name] = module
+	}
+	var moduleDiffs []models.LockDiff
+	for name, newModule := range newModules {
+		oldModule, ok := oldModules[name]
+		if !ok {
+			moduleDiffs = append(moduleDiffs, newLockDiff(name, "", newModule.Version, "", newModule.Digest))
+		} else if oldModule.Version != newModule.Version || oldModule.Digest != newModule.Digest {
+			moduleDiffs = append(moduleDiffs, newLockDiff(name, oldModule.Version, newModule.Version, oldModule.Digest, newModule.Digest))
+		}
+	}
+	for name, oldModule := range oldModules {
+		if _, ok := newModules[name]; !ok {
+			moduleDiffs = append(moduleDiffs, newLockDiff(name, oldModule.Version, "", oldModule.Digest, ""))
+		}
+	}
+	slices.SortFunc(moduleDiffs, func(a, b models.LockDiff) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return append(diffs, moduleDiffs...)
+}
+
+func newLockDiff(name string, oldVersion string, newVersion string, oldDigest string, newDigest string) models.LockDiff {
+	change := constant.LockChangeUpdated
+	switch {
+	case oldVersion == "":
+		change = constant.LockChangeAdded
+	case newVersion == "":
+		change = constant.LockChangeRemoved
+	case oldVersion == newVersion:
+		change = constant.LockChangeRebuilt
+	}
+
+	return models.LockDiff{
+		Name:       name,
+		Change:     change,
+		OldVersion: oldVersion,
+		NewVersion: newVersion,
+		OldDigest:  oldDigest,
+		NewDigest:  newDigest,
+	}
+}
@@ -0,0 +1,293 @@
+package locksvc
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestLockSvc(t *testing.T) *LockSvc {
+	configFile := filepath.Join(t.TempDir(), "config.combined.yaml")
+	viper.SetConfigFile(configFile)
+	t.Cleanup(viper.Reset)
+
+	return New(&action.Action{Name: "test-action", ConfigProfileName: "combined", ConfigApplicationID: "app-combined-1.0.0"}, &testhelpers.MockRegistrySvc{})
+}
+
+func newTestModules(moduleIDs ...string) *models.ProxyModulesByRegistry {
+	modules := &models.ProxyModulesByRegistry{}
+	for _, moduleID := range moduleIDs {
+		modules.FolioModules = append(modules.FolioModules, &models.ProxyModule{ID: moduleID})
+	}
+
+	return modules
+}
+
+// ==================== Read/Write Tests ====================
+
+func TestGetLockFilePath_NextToConfigFile(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+
+	// Act
+	filePath := svc.GetLockFilePath()
+
+	// Assert
+	assert.Equal(t, filepath.Join(filepath.Dir(viper.ConfigFileUsed()), "eureka.combined.lock"), filePath)
+}
+
+func TestReadLock_NotFound(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+
+	// Act
+	lock, err := svc.ReadLock()
+
+	// Assert
+	assert.Nil(t, lock)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "updateLock")
+}
+
+func TestWriteLock_ReadLock_RoundTrip(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+	lock := svc.buildLock(newTestModules("mod-users-19.4.0"), []models.ModuleImage{
+		{Module: "mod-users", Image: "folioorg/mod-users:19.4.0"},
+	}, "folioorg/folio-module-sidecar:3.0.0", map[string]string{"folioorg/mod-users:19.4.0": "sha256:abc"})
+
+	// Act
+	writeErr := svc.WriteLock(lock)
+	result, readErr := svc.ReadLock()
+
+	// Assert
+	assert.NoError(t, writeErr)
+	assert.NoError(t, readErr)
+	assert.Equal(t, "app-combined-1.0.0", result.ApplicationID)
+	assert.Equal(t, lock.Modules, result.Modules)
+	assert.NoError(t, svc.ValidateLock(result))
+}
+
+func TestValidateLock_ApplicationMismatch(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+
+	// Act
+	err := svc.ValidateLock(&models.Lock{ApplicationID: "app-combined-0.9.0"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "app-combined-0.9.0")
+	assert.Contains(t, err.Error(), "app-combined-1.0.0")
+}
+
+// ==================== Create Tests ====================
+
+func TestBuildLock(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+	modules := newTestModules("mod-users-19.4.0", "folio_users-12.0.0", "mod-local-1.0.0")
+	modules.EurekaModules = []*models.ProxyModule{{ID: "folio-module-sidecar-3.0.0"}}
+	images := []models.ModuleImage{
+		{Module: "mod-users", Image: "folioorg/mod-users:19.4.0"},
+		{Module: "mod-local", Image: "folioci/mod-local:1.0.0", LocalBuild: true},
+		{Module: constant.SidecarProjectName, Image: "folioorg/folio-module-sidecar:3.1.0"},
+	}
+	digests := map[string]string{
+		"folioorg/mod-users:19.4.0":           "sha256:users",
+		"folioorg/folio-module-sidecar:3.1.0": "sha256:sidecar",
+	}
+
+	// Act
+	lock := svc.buildLock(modules, images, "folioorg/folio-module-sidecar:3.1.0", digests)
+
+	// Assert
+	assert.Equal(t, "combined", lock.Profile)
+	assert.Equal(t, "app-combined-1.0.0", lock.ApplicationID)
+	assert.Equal(t, "sha256:sidecar", lock.SidecarDigest)
+	assert.Equal(t, []models.LockedModule{
+		{ID: "folio-module-sidecar-3.0.0", Name: "folio-module-sidecar", Version: "3.0.0"},
+		{ID: "folio_users-12.0.0", Name: "folio_users", Version: "12.0.0"},
+		{ID: "mod-local-1.0.0", Name: "mod-local", Version: "1.0.0", Image: "folioci/mod-local:1.0.0"},
+		{ID: "mod-users-19.4.0", Name: "mod-users", Version: "19.4.0", Image: "folioorg/mod-users:19.4.0", Digest: "sha256:users"},
+	}, lock.Modules)
+}
+
+func TestBuildCapturedLock(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+	baseLock := &models.Lock{
+		SidecarImage: "folioorg/folio-module-sidecar:3.0.0",
+		Modules: []models.LockedModule{
+			{ID: "folio_users-12.0.0", Name: "folio_users", Version: "12.0.0"},
+			{ID: "mod-orders-13.0.0", Name: "mod-orders", Version: "13.0.0", Image: "folioorg/mod-orders:13.0.0", Digest: "sha256:orders"},
+			{ID: "mod-users-19.3.0", Name: "mod-users", Version: "19.3.0", Image: "folioorg/mod-users:19.3.0"},
+		},
+	}
+	images := []models.ModuleImage{
+		{Module: "mod-users", Image: "folioorg/mod-users:19.4.0", Version: "19.4.0", Digest: "sha256:users"},
+		{Module: "mgr-tenants", Image: "folioci/mgr-tenants:3.1.0-SNAPSHOT.42", Version: "3.1.0-SNAPSHOT.42", Digest: "sha256:tenants"},
+		{Module: constant.SidecarProjectName, Image: "folioorg/folio-module-sidecar:3.1.0", Digest: "sha256:sidecar"},
+	}
+
+	// Act
+	lock := svc.buildCapturedLock(baseLock, images)
+
+	// Assert
+	assert.Equal(t, "combined", lock.Profile)
+	assert.Equal(t, "app-combined-1.0.0", lock.ApplicationID)
+	assert.Equal(t, "folioorg/folio-module-sidecar:3.1.0", lock.SidecarImage)
+	assert.Equal(t, "sha256:sidecar", lock.SidecarDigest)
+	assert.Equal(t, []models.LockedModule{
+		{ID: "folio_users-12.0.0", Name: "folio_users", Version: "12.0.0"},
+		{ID: "mgr-tenants-3.1.0-SNAPSHOT.42", Name: "mgr-tenants", Version: "3.1.0-SNAPSHOT.42", Image: "folioci/mgr-tenants:3.1.0-SNAPSHOT.42", Digest: "sha256:tenants"},
+		{ID: "mod-orders-13.0.0", Name: "mod-orders", Version: "13.0.0"},
+		{ID: "mod-users-19.4.0", Name: "mod-users", Version: "19.4.0", Image: "folioorg/mod-users:19.4.0", Digest: "sha256:users"},
+	}, lock.Modules)
+}
+
+func TestBuildCapturedLock_NoBaseLock(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+
+	// Act
+	lock := svc.buildCapturedLock(nil, []models.ModuleImage{{Module: "mod-local", Image: "mod-local"}})
+
+	// Assert
+	assert.Empty(t, lock.SidecarImage)
+	assert.Equal(t, []models.LockedModule{{ID: "mod-local", Name: "mod-local", Image: "mod-local"}}, lock.Modules)
+}
+
+func TestResolveDigests(t *testing.T) {
+	t.Run("TestResolveDigests_Success", func(t *testing.T) {
+		// Arrange
+		svc := newTestLockSvc(t)
+
+		// Act
+		digests, err := svc.resolveDigests([]string{"a:1", "b:1", "a:1"}, func(imageName string) (string, error) {
+			return "sha256:" + imageName, nil
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"a:1": "sha256:a:1", "b:1": "sha256:b:1"}, digests)
+	})
+
+	t.Run("TestResolveDigests_Error", func(t *testing.T) {
+		// Arrange
+		svc := newTestLockSvc(t)
+
+		// Act
+		digests, err := svc.resolveDigests([]string{"a:1", "b:1"}, func(imageName string) (string, error) {
+			if imageName == "b:1" {
+				return "", errors.New("manifest unknown")
+			}
+			return "sha256:a", nil
+		})
+
+		// Assert
+		assert.Nil(t, digests)
+		assert.ErrorContains(t, err, "b:1")
+	})
+	t.Run("TestResolveDigests_JoinsAllErrors", func(t *testing.T) {
+		// Arrange
+		svc := newTestLockSvc(t)
+
+		// Act
+		digests, err := svc.resolveDigests([]string{"a:1", "b:1", "c:1"}, func(imageName string) (string, error) {
+			if imageName == "b:1" {
+				return "sha256:b", nil
+			}
+			return "", errors.New("manifest unknown")
+		})
+
+		// Assert
+		assert.Nil(t, digests)
+		assert.ErrorContains(t, err, "a:1")
+		assert.ErrorContains(t, err, "c:1")
+		assert.NotContains(t, err.Error(), "b:1")
+	})
+}
+
+func TestFindRepoDigest(t *testing.T) {
+	repoDigests := []string{"other/mod-users@sha256:other", "folioorg/mod-users@sha256:users"}
+
+	t.Run("TestFindRepoDigest_MatchingRepository", func(t *testing.T) {
+		assert.Equal(t, "sha256:users", findRepoDigest("folioorg/mod-users:19.4.0", repoDigests))
+	})
+
+	t.Run("TestFindRepoDigest_RegistryWithPort", func(t *testing.T) {
+		assert.Equal(t, "sha256:local", findRepoDigest("localhost:5000/mod-users", []string{"localhost:5000/mod-users@sha256:local"}))
+	})
+
+	t.Run("TestFindRepoDigest_NoMatch", func(t *testing.T) {
+		assert.Empty(t, findRepoDigest("folioorg/mod-orders:13.0.0", repoDigests))
+	})
+}
+
+func TestGetImageTag(t *testing.T) {
+	assert.Equal(t, "19.4.0", getImageTag("folioorg/mod-users:19.4.0"))
+	assert.Equal(t, "1.0.0", getImageTag("localhost:5000/mod-users:1.0.0"))
+	assert.Empty(t, getImageTag("localhost:5000/mod-users"))
+}
+
+// ==================== Diff Tests ====================
+
+func TestDiffLock(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+	oldLock := &models.Lock{
+		ApplicationID: "app-combined-1.0.0",
+		SidecarImage:  "folioorg/folio-module-sidecar:3.0.0",
+		Modules: []models.LockedModule{
+			{Name: "mod-orders", Version: "13.0.0"},
+			{Name: "mod-removed", Version: "1.0.0"},
+			{Name: "mod-snapshot", Version: "2.0.0-SNAPSHOT", Digest: "sha256:old"},
+			{Name: "mod-users", Version: "19.4.0", Digest: "sha256:users"},
+		},
+	}
+	newLock := &models.Lock{
+		ApplicationID: "app-combined-1.0.0",
+		SidecarImage:  "folioorg/folio-module-sidecar:3.1.0",
+		Modules: []models.LockedModule{
+			{Name: "mod-added", Version: "1.0.0"},
+			{Name: "mod-orders", Version: "13.1.0"},
+			{Name: "mod-snapshot", Version: "2.0.0-SNAPSHOT", Digest: "sha256:new"},
+			{Name: "mod-users", Version: "19.4.0", Digest: "sha256:users"},
+		},
+	}
+
+	// Act
+	diffs := svc.DiffLock(oldLock, newLock)
+
+	// Assert
+	assert.Equal(t, []models.LockDiff{
+		{Name: constant.LockSidecarImageEntry, Change: constant.LockChangeUpdated, OldVersion: "folioorg/folio-module-sidecar:3.0.0", NewVersion: "folioorg/folio-module-sidecar:3.1.0"},
+		{Name: "mod-added", Change: constant.LockChangeAdded, NewVersion: "1.0.0"},
+		{Name: "mod-orders", Change: constant.LockChangeUpdated, OldVersion: "13.0.0", NewVersion: "13.1.0"},
+		{Name: "mod-removed", Change: constant.LockChangeRemoved, OldVersion: "1.0.0"},
+		{Name: "mod-snapshot", Change: constant.LockChangeRebuilt, OldVersion: "2.0.0-SNAPSHOT", NewVersion: "2.0.0-SNAPSHOT", OldDigest: "sha256:old", NewDigest: "sha256:new"},
+	}, diffs)
+}
+
+func TestDiffLock_NoPreviousLock(t *testing.T) {
+	// Arrange
+	svc := newTestLockSvc(t)
+	newLock := &models.Lock{ApplicationID: "app-combined-1.0.0", Modules: []models.LockedModule{{Name: "mod-users", Version: "19.4.0"}}}
+
+	// Act
+	diffs := svc.DiffLock(nil, newLock)
+
+	// Assert
+	assert.Len(t, diffs, 2)
+	assert.Equal(t, constant.LockApplicationEntry, diffs[0].Name)
+	assert.Equal(t, constant.LockChangeAdded, diffs[0].Change)
+	assert.Equal(t, constant.LockChangeAdded, diffs[1].Change)
+}
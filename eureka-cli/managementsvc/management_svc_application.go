@@ -100,6 +100,8 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 		return err
 	}
 
+	// Offline mode embeds the snapshot module descriptors as the module descriptor URLs are unreachable
+	fetchDescriptors := ms.Action.ConfigApplicationFetchDescriptors || ms.Action.IsOffline()
 	allModules := [][]*models.ProxyModule{extract.Modules.FolioModules, extract.Modules.EurekaModules}
 	for _, modules := range allModules {
 		for _, module := range modules {
@@ -125,7 +127,7 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 			isLocalBackendModule := existsBackend && backendModule.LocalDescriptorPath != ""
 			isLocalFrontendModule := existsFrontend && frontendModule.LocalDescriptorPath != ""
 			isLocalModule := isLocalBackendModule || isLocalFrontendModule
-			if ms.Action.ConfigApplicationFetchDescriptors || isLocalModule {
+			if fetchDescriptors || isLocalModule {
 				var descriptorPath string
 				if isLocalBackendModule {
 					descriptorPath = backendModule.LocalDescriptorPath
@@ -143,7 +145,7 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 					"name":    module.Metadata.Name,
 					"version": *module.Metadata.Version,
 				}
-				if ms.Action.ConfigApplicationFetchDescriptors || isLocalModule {
+				if fetchDescriptors || isLocalModule {
 					backendModuleDescriptors = append(backendModuleDescriptors, extract.ModuleDescriptors[module.ID])
 				} else {
 					newBackendModule["url"] = moduleDescriptorURL
@@ -163,7 +165,7 @@ func (ms *ManagementSvc) CreateApplication(extract *models.RegistryExtract) erro
 					"name":    module.Metadata.Name,
 					"version": *module.Metadata.Version,
 				}
-				if ms.Action.ConfigApplicationFetchDescriptors || isLocalModule {
+				if fetchDescriptors || isLocalModule {
 					frontendModuleDescriptors = append(frontendModuleDescriptors, extract.ModuleDescriptors[module.ID])
 				} else {
 					newFrontendModule["url"] = moduleDescriptorURL
@@ -227,6 +229,19 @@ func (ms *ManagementSvc) FetchModuleDescriptor(extract *models.RegistryExtract,
 
 		return nil
 	}
+	if ms.Action.IsOffline() {
+		descriptorPath = ms.Action.GetSnapshotModuleDescriptorPath(moduleID)
+		slog.Info(ms.Action.Name, "text", "Fetching snapshot module descriptor", "module", moduleID, "path", descriptorPath)
+
+		var moduleDescriptorData map[string]any
+		if err := helpers.ReadJSONFromFile(descriptorPath, &moduleDescriptorData); err != nil {
+			return errors.RegistrySnapshotEntryNotFound(descriptorPath, err)
+		}
+		extract.ModuleDescriptors[moduleID] = moduleDescriptorData
+		slog.Info(ms.Action.Name, "text", "Loaded module descriptor", "module", moduleID)
+
+		return nil
+	}
 	slog.Info(ms.Action.Name, "text", "Fetching module descriptor", "module", moduleID, "url", moduleDescriptorURL)
 
 	var decodedResponse any
@@ -16,6 +16,7 @@ import (
 type ManagementTenantManager interface {
 	GetTenants(consortiumName string, tenantType constant.TenantType) ([]any, error)
 	CreateTenants() error
+	CreateTenant(tenantName string) error
 	RemoveTenants(consortiumName string, tenantType constant.TenantType) error
 }
 
@@ -56,30 +57,37 @@ func (ms *ManagementSvc) GetTenants(consortiumName string, tenantType constant.T
 }
 
 func (ms *ManagementSvc) CreateTenants() error {
+	for _, tenantName := range helpers.SortedMapKeys(ms.Action.ConfigTenants) {
+		if err := ms.CreateTenant(tenantName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ms *ManagementSvc) CreateTenant(tenantName string) error {
 	requestURL := ms.Action.GetRequestURL(constant.KongPort, "/tenants")
 	headers, err := helpers.SecureApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
 	if err != nil {
 		return err
 	}
-	tenantNames := helpers.SortedMapKeys(ms.Action.ConfigTenants)
-
-	for _, tenantName := range tenantNames {
-		properties := ms.Action.ConfigTenants[tenantName]
-		entry := properties.(map[string]any)
-		payload, err := json.Marshal(map[string]string{
-			"name":        tenantName,
-			"description": ms.GetTenantType(entry),
-		})
-		if err != nil {
-			return err
-		}
 
-		var tenant models.Tenant
-		if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &tenant); err != nil {
-			return err
-		}
-		slog.Info(ms.Action.Name, "text", "Created tenant", "tenant", tenant.Name, "id", tenant.ID, "description", tenant.Description)
+	properties := ms.Action.ConfigTenants[tenantName]
+	entry := properties.(map[string]any)
+	payload, err := json.Marshal(map[string]string{
+		"name":        tenantName,
+		"description": ms.GetTenantType(entry),
+	})
+	if err != nil {
+		return err
+	}
+
+	var tenant models.Tenant
+	if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &tenant); err != nil {
+		return err
 	}
+	slog.Info(ms.Action.Name, "text", "Created tenant", "tenant", tenant.Name, "id", tenant.ID, "description", tenant.Description)
 
 	return nil
 }
@@ -15,8 +15,10 @@ import (
 type ManagementTenantEntitlementManager interface {
 	GetTenantEntitlements(tenantName string, includeModules bool) (models.TenantEntitlementResponse, error)
 	CreateTenantEntitlement(consortiumName string, tenantType constant.TenantType) error
+	CreateTenantEntitlementForTenant(consortiumName string, tenantID string, tenantName string) error
 	UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error
 	RemoveTenantEntitlements(consortiumName string, tenantType constant.TenantType, purgeSchemas bool) error
+	RemoveTenantEntitlementForTenant(tenantID string, tenantName string, purgeSchemas bool) error
 }
 
 func (ms *ManagementSvc) GetTenantEntitlements(tenantName string, includeModules bool) (models.TenantEntitlementResponse, error) {
@@ -58,19 +60,9 @@ func (ms *ManagementSvc) CreateTenantEntitlement(consortiumName string, tenantTy
 			continue
 		}
 
-		payload, err := json.Marshal(map[string]any{
-			"tenantId":     helpers.GetString(entry, "id"),
-			"applications": []string{ms.Action.ConfigApplicationID},
-		})
-		if err != nil {
-			return err
-		}
-
-		var decodedResponse models.TenantEntitlementResponse
-		if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
+		if err := ms.createTenantEntitlement(requestURL, headers, helpers.GetString(entry, "id"), tenantName); err != nil {
 			return err
 		}
-		slog.Info(ms.Action.Name, "text", "Created tenant entitlement", "tenant", tenantName, "flowId", decodedResponse.FlowID)
 
 	  time.Sleep(30 * time.Second)
 	}
@@ -78,6 +70,39 @@ func (ms *ManagementSvc) CreateTenantEntitlement(consortiumName string, tenantTy
 	return nil
 }
 
+func (ms *ManagementSvc) CreateTenantEntitlementForTenant(consortiumName string, tenantID string, tenantName string) error {
+	tenantParameters, err := ms.TenantSvc.GetEntitlementTenantParameters(consortiumName)
+	if err != nil {
+		return err
+	}
+
+	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/entitlements?purgeOnRollback=true&ignoreErrors=false&async=false&tenantParameters=%s", tenantParameters))
+	headers, err := helpers.SecureOkapiApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	if err != nil {
+		return err
+	}
+
+	return ms.createTenantEntitlement(requestURL, headers, tenantID, tenantName)
+}
+
+func (ms *ManagementSvc) createTenantEntitlement(requestURL string, headers map[string]string, tenantID string, tenantName string) error {
+	payload, err := json.Marshal(map[string]any{
+		"tenantId":     tenantID,
+		"applications": []string{ms.Action.ConfigApplicationID},
+	})
+	if err != nil {
+		return err
+	}
+
+	var decodedResponse models.TenantEntitlementResponse
+	if err := ms.HTTPClient.PostReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
+		return err
+	}
+	slog.Info(ms.Action.Name, "text", "Created tenant entitlement", "tenant", tenantName, "flowId", decodedResponse.FlowID)
+
+	return nil
+}
+
 func (ms *ManagementSvc) UpgradeTenantEntitlement(consortiumName string, tenantType constant.TenantType, newApplicationID string) error {
 	tenantParameters, err := ms.TenantSvc.GetEntitlementTenantParameters(consortiumName)
 	if err != nil {
@@ -138,22 +163,39 @@ func (ms *ManagementSvc) RemoveTenantEntitlements(consortiumName string, tenantT
 		if !helpers.HasTenant(tenantName, ms.Action.ConfigTenants) {
 			continue
 		}
-		tenantID := helpers.GetString(entry, "id")
 
-		payload, err := json.Marshal(map[string]any{
-			"tenantId":     tenantID,
-			"applications": []string{ms.Action.ConfigApplicationID},
-		})
-		if err != nil {
+		if err := ms.removeTenantEntitlement(requestURL, headers, helpers.GetString(entry, "id"), tenantName); err != nil {
 			return err
 		}
+	}
 
-		var decodedResponse models.TenantEntitlementResponse
-		if err := ms.HTTPClient.DeleteWithPayloadReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
-			return err
-		}
-		slog.Info(ms.Action.Name, "text", "Removed tenant entitlement", "tenant", tenantName, "flowId", decodedResponse.FlowID)
+	return nil
+}
+
+func (ms *ManagementSvc) RemoveTenantEntitlementForTenant(tenantID string, tenantName string, purgeSchemas bool) error {
+	requestURL := ms.Action.GetRequestURL(constant.KongPort, fmt.Sprintf("/entitlements?purge=%t&ignoreErrors=false", purgeSchemas))
+	headers, err := helpers.SecureOkapiApplicationJSONHeaders(ms.Action.KeycloakMasterAccessToken)
+	if err != nil {
+		return err
+	}
+
+	return ms.removeTenantEntitlement(requestURL, headers, tenantID, tenantName)
+}
+
+func (ms *ManagementSvc) removeTenantEntitlement(requestURL string, headers map[string]string, tenantID string, tenantName string) error {
+	payload, err := json.Marshal(map[string]any{
+		"tenantId":     tenantID,
+		"applications": []string{ms.Action.ConfigApplicationID},
+	})
+	if err != nil {
+		return err
+	}
+
+	var decodedResponse models.TenantEntitlementResponse
+	if err := ms.HTTPClient.DeleteWithPayloadReturnStruct(requestURL, payload, headers, &decodedResponse); err != nil {
+		return err
 	}
+	slog.Info(ms.Action.Name, "text", "Removed tenant entitlement", "tenant", tenantName, "flowId", decodedResponse.FlowID)
 
 	return nil
 }
@@ -3,10 +3,13 @@ package managementsvc_test
 import (
 	"encoding/json"
 	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/managementsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
@@ -1002,6 +1005,36 @@ func TestRemoveTenantEntitlements_GetTenantsError(t *testing.T) {
 	mockHTTP.AssertExpectations(t)
 }
 
+func TestRemoveTenantEntitlementForTenant_Success(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.KeycloakMasterAccessToken = "test-token"
+	action.ConfigApplicationID = "app-123"
+	mockTenantSvc := &MockTenantSvc{}
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+
+	mockHTTP.On("DeleteWithPayloadReturnStruct",
+		mock.MatchedBy(func(url string) bool {
+			return strings.Contains(url, "/entitlements") && strings.Contains(url, "purge=false")
+		}),
+		mock.MatchedBy(func(payload []byte) bool {
+			var data map[string]any
+			_ = json.Unmarshal(payload, &data)
+			return data["tenantId"] == "tenant-123" && data["applications"].([]any)[0] == "app-123"
+		}),
+		mock.Anything,
+		mock.Anything).
+		Return(nil)
+
+	// Act
+	err := svc.RemoveTenantEntitlementForTenant("tenant-123", "test-tenant", false)
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTP.AssertExpectations(t)
+}
+
 func TestGetModuleDiscovery_NilResponse(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
@@ -3087,6 +3120,54 @@ func TestFetchModuleDescriptor_LocalModule_FileNotFound(t *testing.T) {
 	assert.Empty(t, extract.ModuleDescriptors)
 }
 
+func TestFetchModuleDescriptor_Offline_Success(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.Param.Offline = true
+	action.RegistrySnapshotDir = t.TempDir()
+	mockTenantSvc := &MockTenantSvc{}
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+
+	extract := &models.RegistryExtract{
+		ModuleDescriptors: make(map[string]any),
+	}
+	moduleID := "mod-test-1.0.0"
+	descriptorPath := action.GetSnapshotModuleDescriptorPath(moduleID)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(descriptorPath), 0755))
+	assert.NoError(t, helpers.WriteJSONToFile(descriptorPath, map[string]any{"id": moduleID}))
+
+	// Act
+	err := svc.FetchModuleDescriptor(extract, moduleID, "http://registry.example.com/_/proxy/modules/"+moduleID, "", false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, moduleID, extract.ModuleDescriptors[moduleID].(map[string]any)["id"])
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFetchModuleDescriptor_Offline_MissingDescriptor(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	action := testhelpers.NewMockAction()
+	action.Param.Offline = true
+	action.RegistrySnapshotDir = t.TempDir()
+	mockTenantSvc := &MockTenantSvc{}
+	svc := managementsvc.New(action, mockHTTP, mockTenantSvc)
+
+	extract := &models.RegistryExtract{
+		ModuleDescriptors: make(map[string]any),
+	}
+
+	// Act
+	err := svc.FetchModuleDescriptor(extract, "mod-test-1.0.0", "", "", false)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "registry snapshot entry")
+	assert.Empty(t, extract.ModuleDescriptors)
+}
+
 func TestFetchModuleDescriptor_LocalModule_InvalidJSON(t *testing.T) {
 	// Arrange
 	mockHTTP := &testhelpers.MockHTTPClient{}
@@ -0,0 +1,60 @@
+package models
+
+// ApplyPlan represents the ordered list of actions needed to reconcile the live environment with the config
+type ApplyPlan struct {
+	Actions []ApplyAction `json:"actions"`
+}
+
+// ApplyAction represents a single reconcile action on a resource
+type ApplyAction struct {
+	Resource  string `json:"resource"`
+	Operation string `json:"operation"`
+	Name      string `json:"name"`
+	Tenant    string `json:"tenant,omitempty"`
+	Reason    string `json:"reason"`
+}
+
+// HasResource checks if the plan contains at least one action for the given resource
+func (p *ApplyPlan) HasResource(resource string) bool {
+	for _, action := range p.Actions {
+		if action.Resource == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasOperation checks if the plan contains at least one action with the given operation on a resource
+func (p *ApplyPlan) HasOperation(resource string, operation string) bool {
+	for _, action := range p.Actions {
+		if action.Resource == resource && action.Operation == operation {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasTenantAction checks if the plan contains an action for the given resource in a tenant
+func (p *ApplyPlan) HasTenantAction(resource string, tenantName string) bool {
+	for _, action := range p.Actions {
+		if action.Resource == resource && action.Tenant == tenantName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetActions returns the actions of the plan for the given resource
+func (p *ApplyPlan) GetActions(resource string) []ApplyAction {
+	var actions []ApplyAction
+	for _, action := range p.Actions {
+		if action.Resource == resource {
+			actions = append(actions, action)
+		}
+	}
+
+	return actions
+}
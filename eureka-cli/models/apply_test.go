@@ -0,0 +1,55 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== ApplyPlan Tests ====================
+
+func TestApplyPlan_HasResource(t *testing.T) {
+	// Arrange
+	plan := ApplyPlan{Actions: []ApplyAction{{Resource: "tenant", Name: "diku", Tenant: "diku"}}}
+
+	// Act & Assert
+	assert.True(t, plan.HasResource("tenant"))
+	assert.False(t, plan.HasResource("role"))
+}
+
+func TestApplyPlan_HasOperation(t *testing.T) {
+	// Arrange
+	plan := ApplyPlan{Actions: []ApplyAction{{Resource: "application", Operation: "update", Name: "app-combined-1.0.0"}}}
+
+	// Act & Assert
+	assert.True(t, plan.HasOperation("application", "update"))
+	assert.False(t, plan.HasOperation("application", "deploy"))
+	assert.False(t, plan.HasOperation("module", "update"))
+}
+
+func TestApplyPlan_HasTenantAction(t *testing.T) {
+	// Arrange
+	plan := ApplyPlan{Actions: []ApplyAction{{Resource: "entitlement", Tenant: "diku"}}}
+
+	// Act & Assert
+	assert.True(t, plan.HasTenantAction("entitlement", "diku"))
+	assert.False(t, plan.HasTenantAction("entitlement", "consortium"))
+}
+
+func TestApplyPlan_GetActions(t *testing.T) {
+	// Arrange
+	plan := ApplyPlan{Actions: []ApplyAction{
+		{Resource: "role", Name: "admin"},
+		{Resource: "user", Name: "diku_admin"},
+		{Resource: "role", Name: "reader"},
+	}}
+
+	// Act
+	actions := plan.GetActions("role")
+
+	// Assert
+	assert.Len(t, actions, 2)
+	assert.Equal(t, "admin", actions[0].Name)
+	assert.Equal(t, "reader", actions[1].Name)
+	assert.Empty(t, plan.GetActions("consortium"))
+}
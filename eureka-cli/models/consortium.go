@@ -88,6 +88,37 @@ func (c SortedConsortiumTenants) String() string {
 	return builder.String()
 }
 
+// ==================== Consortium User Migration ====================
+
+// IdentityProviderCreateRequest represents the payload for creating the identity provider of a member tenant in the central tenant realm
+type IdentityProviderCreateRequest struct {
+	CreateProvider bool `json:"createProvider"`
+	MigrateUsers   bool `json:"migrateUsers"`
+}
+
+// UserTenantsResponse represents the response containing a list of user tenant affiliations
+type UserTenantsResponse struct {
+	UserTenants  []UserTenant `json:"userTenants"`
+	TotalRecords int          `json:"totalRecords"`
+}
+
+// UserTenant represents the affiliation of a user with a consortium tenant
+type UserTenant struct {
+	ID       string `json:"id"`
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+	TenantID string `json:"tenantId"`
+}
+
+// ConsortiumUserMigration represents the migration result of a consortium member tenant, users is the number
+// of users affiliated with the tenant when the migration started and is unset when they could not be listed
+type ConsortiumUserMigration struct {
+	Tenant string `json:"tenant"`
+	Users  *int   `json:"users,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
 // ==================== Central Ordering Settings ====================
 
 // CentralOrderingSettingRequest represents the payload for creating or updating a central ordering setting
@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// EnvironmentSnapshot represents the manifest of an environment snapshot holding a dump of all PostgreSQL databases,
+// including the Keycloak realms and the Kong configuration, the Vault secrets, the committed consumer group offsets
+// and the lock file of the deployed modules
+type EnvironmentSnapshot struct {
+	Name           string                  `json:"name"`
+	Profile        string                  `json:"profile"`
+	ApplicationID  string                  `json:"applicationId"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	Databases      []string                `json:"databases"`
+	Secrets        int                     `json:"secrets"`
+	ConsumerGroups []SnapshotConsumerGroup `json:"consumerGroups"`
+	Lock           *Lock                   `json:"lock"`
+}
+
+// SnapshotConsumerGroup represents the committed offsets of a consumer group saved in an environment snapshot
+type SnapshotConsumerGroup struct {
+	GroupID string                    `json:"groupId"`
+	Offsets []SnapshotPartitionOffset `json:"offsets"`
+}
+
+// SnapshotPartitionOffset represents the committed offset of a consumer group for a partition
+type SnapshotPartitionOffset struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+	Offset    int64  `json:"offset"`
+}
+
+// EnvironmentSnapshotSummary represents an environment snapshot with the total size of its files
+type EnvironmentSnapshotSummary struct {
+	Name      string    `json:"name"`
+	Profile   string    `json:"profile"`
+	CreatedAt time.Time `json:"createdAt"`
+	Size      int64     `json:"size"`
+}
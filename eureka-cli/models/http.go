@@ -0,0 +1,15 @@
+package models
+
+import (
+	"net/http"
+	"time"
+)
+
+// HTTPCallResponse holds the status, headers and body of a request sent as is together with its round trip time
+type HTTPCallResponse struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+	Duration   time.Duration
+}
@@ -0,0 +1,26 @@
+package models
+
+// ModuleImage represents the Docker image resolved for a module of the current profile
+type ModuleImage struct {
+	Module          string `json:"module"`
+	Image           string `json:"image"`
+	Version         string `json:"version"`
+	RegistryVersion string `json:"registryVersion,omitempty"`
+	LocalBuild      bool   `json:"localBuild,omitempty"`
+	Digest          string `json:"digest,omitempty"`
+}
+
+// IsStale reports whether the image version differs from the version published in the LSP/FAR registries
+func (mi ModuleImage) IsStale() bool {
+	return mi.RegistryVersion != "" && mi.Version != mi.RegistryVersion
+}
+
+// ImageReport represents the state of a module image in the local Docker image cache
+type ImageReport struct {
+	ModuleImage
+	Present    bool     `json:"present"`
+	Pulled     bool     `json:"pulled"`
+	Size       int64    `json:"size"`
+	Stale      bool     `json:"stale"`
+	CachedTags []string `json:"cachedTags,omitempty"`
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== ModuleImage Tests ====================
+
+func TestModuleImage_IsStale(t *testing.T) {
+	t.Run("TestModuleImage_IsStale_SameVersion", func(t *testing.T) {
+		assert.False(t, ModuleImage{Version: "1.0.0", RegistryVersion: "1.0.0"}.IsStale())
+	})
+
+	t.Run("TestModuleImage_IsStale_PinnedVersion", func(t *testing.T) {
+		assert.True(t, ModuleImage{Version: "0.9.0", RegistryVersion: "1.0.0"}.IsStale())
+	})
+
+	t.Run("TestModuleImage_IsStale_NoRegistryVersion", func(t *testing.T) {
+		assert.False(t, ModuleImage{Version: "1.0.0"}.IsStale())
+	})
+}
+
+// ==================== Lock Tests ====================
+
+func TestLock_GetPinnedImage(t *testing.T) {
+	lock := &Lock{
+		SidecarImage:  "folioorg/folio-module-sidecar:3.0.0",
+		SidecarDigest: "sha256:sidecar",
+		Modules: []LockedModule{
+			{Name: "mod-users", Image: "folioorg/mod-users:19.4.0", Digest: "sha256:users"},
+			{Name: "mod-local", Image: "folioci/mod-local:1.0.0"},
+		},
+	}
+
+	t.Run("TestLock_GetPinnedImage_Module", func(t *testing.T) {
+		image, pinned := lock.GetPinnedImage("folioorg/mod-users:19.4.0")
+		assert.True(t, pinned)
+		assert.Equal(t, "folioorg/mod-users:19.4.0@sha256:users", image)
+	})
+
+	t.Run("TestLock_GetPinnedImage_Sidecar", func(t *testing.T) {
+		assert.Equal(t, "folioorg/folio-module-sidecar:3.0.0@sha256:sidecar", lock.GetPinnedSidecarImage())
+	})
+
+	t.Run("TestLock_GetPinnedImage_NoDigest", func(t *testing.T) {
+		image, pinned := lock.GetPinnedImage("folioci/mod-local:1.0.0")
+		assert.False(t, pinned)
+		assert.Equal(t, "folioci/mod-local:1.0.0", image)
+	})
+
+	t.Run("TestLock_GetPinnedImage_NilLock", func(t *testing.T) {
+		var nilLock *Lock
+		image, pinned := nilLock.GetPinnedImage("folioorg/mod-users:19.4.0")
+		assert.False(t, pinned)
+		assert.Equal(t, "folioorg/mod-users:19.4.0", image)
+	})
+
+	t.Run("TestLock_PinImage_AlreadyPinned", func(t *testing.T) {
+		assert.Equal(t, "folioorg/mod-users@sha256:users", PinImage("folioorg/mod-users@sha256:users", "sha256:other"))
+	})
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Journal represents the persisted step journal of a deployment for a single profile
+type Journal struct {
+	Profile string         `json:"profile"`
+	Entries []JournalEntry `json:"entries"`
+}
+
+// JournalEntry represents the last known status of a deployment step in a partition
+type JournalEntry struct {
+	Step      string    `json:"step"`
+	Partition string    `json:"partition,omitempty"`
+	Status    string    `json:"status"`
+	Timestamp time.Time `json:"timestamp"`
+	Error     string    `json:"error,omitempty"`
+}
@@ -0,0 +1,111 @@
+package models
+
+import "time"
+
+// KafkaBroker represents a broker of the Kafka cluster as advertised in the cluster metadata
+type KafkaBroker struct {
+	NodeID int32  `json:"nodeId"`
+	Host   string `json:"host"`
+	Port   int32  `json:"port"`
+}
+
+// KafkaAPIVersion represents the range of versions of a Kafka protocol API supported by a broker
+type KafkaAPIVersion struct {
+	APIKey     int16 `json:"apiKey"`
+	MinVersion int16 `json:"minVersion"`
+	MaxVersion int16 `json:"maxVersion"`
+}
+
+// KafkaMetadata represents the brokers and topics of the Kafka cluster
+type KafkaMetadata struct {
+	Brokers      []KafkaBroker `json:"brokers"`
+	ControllerID int32         `json:"controllerId"`
+	Topics       []KafkaTopic  `json:"topics"`
+}
+
+// KafkaTopic represents a Kafka topic with its partitions
+type KafkaTopic struct {
+	Name       string           `json:"name"`
+	Internal   bool             `json:"internal"`
+	Partitions []KafkaPartition `json:"partitions"`
+}
+
+// KafkaPartition represents a partition of a Kafka topic and the broker leading it
+type KafkaPartition struct {
+	Partition int32 `json:"partition"`
+	Leader    int32 `json:"leader"`
+}
+
+// KafkaTopicPartition identifies a partition of a Kafka topic
+type KafkaTopicPartition struct {
+	Topic     string `json:"topic"`
+	Partition int32  `json:"partition"`
+}
+
+// KafkaConsumerGroup represents the state and the members of a Kafka consumer group
+type KafkaConsumerGroup struct {
+	GroupID      string                     `json:"groupId"`
+	State        string                     `json:"state"`
+	ProtocolType string                     `json:"protocolType"`
+	Protocol     string                     `json:"protocol"`
+	Members      []KafkaConsumerGroupMember `json:"members"`
+}
+
+// KafkaConsumerGroupMember represents a member of a Kafka consumer group
+type KafkaConsumerGroupMember struct {
+	MemberID   string                `json:"memberId"`
+	ClientID   string                `json:"clientId"`
+	ClientHost string                `json:"clientHost"`
+	Assignment []KafkaTopicPartition `json:"assignment"`
+}
+
+// KafkaTopicSummary represents a Kafka topic with the number of messages retained in its partitions
+type KafkaTopicSummary struct {
+	Name       string `json:"name"`
+	Tenant     string `json:"tenant"`
+	Partitions int    `json:"partitions"`
+	Messages   int64  `json:"messages"`
+}
+
+// KafkaConsumerGroupDescription represents a Kafka consumer group with its offsets and lag per partition
+type KafkaConsumerGroupDescription struct {
+	KafkaConsumerGroup
+	Lag     int64                  `json:"lag"`
+	Offsets []KafkaPartitionOffset `json:"offsets"`
+}
+
+// KafkaPartitionOffset represents the committed offset of a consumer group for a partition, a committed offset
+// and a lag of -1 mean that the group has not committed an offset for the partition yet
+type KafkaPartitionOffset struct {
+	Topic           string `json:"topic"`
+	Partition       int32  `json:"partition"`
+	CommittedOffset int64  `json:"committedOffset"`
+	EndOffset       int64  `json:"endOffset"`
+	Lag             int64  `json:"lag"`
+	ClientID        string `json:"clientId,omitempty"`
+}
+
+// KafkaOffsetReset represents the change of the committed offset of a consumer group for a partition
+type KafkaOffsetReset struct {
+	Topic          string `json:"topic"`
+	Partition      int32  `json:"partition"`
+	PreviousOffset int64  `json:"previousOffset"`
+	NewOffset      int64  `json:"newOffset"`
+}
+
+// KafkaRecord represents a message of a Kafka topic partition
+type KafkaRecord struct {
+	Topic     string              `json:"topic"`
+	Partition int32               `json:"partition"`
+	Offset    int64               `json:"offset"`
+	Timestamp time.Time           `json:"timestamp"`
+	Key       []byte              `json:"key"`
+	Value     []byte              `json:"value"`
+	Headers   []KafkaRecordHeader `json:"headers"`
+}
+
+// KafkaRecordHeader represents a header of a Kafka message
+type KafkaRecordHeader struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+}
@@ -62,6 +62,29 @@ type KeycloakUser struct {
 	Personal map[string]any `json:"personal,omitempty"`
 }
 
+// KeycloakRealmUsersResponse represents the response of the Keycloak admin API containing the users of a realm
+type KeycloakRealmUsersResponse []KeycloakRealmUser
+
+// KeycloakRealmUser represents basic information about a user of a Keycloak realm
+type KeycloakRealmUser struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+// KeycloakCredentialRequest represents the payload for resetting the password of a Keycloak realm user
+type KeycloakCredentialRequest struct {
+	Type      string `json:"type"`
+	Value     string `json:"value"`
+	Temporary bool   `json:"temporary"`
+}
+
+// KeycloakPasswordReset represents a new password generated for a realm user, the error is set when Keycloak rejected it
+type KeycloakPasswordReset struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Error    string `json:"error,omitempty"`
+}
+
 // ==================== Role Management ====================
 
 // KeycloakRoleCreateRequest represents the payload for creating a new Keycloak role
@@ -121,6 +144,44 @@ type KeycloakClientUpdateRequest struct {
 	Attributes                   map[string]string `json:"attributes"`
 }
 
+// ==================== Realm Export and Import ====================
+
+// KeycloakUserRoleMappings represents the realm and client roles mapped to a Keycloak user
+type KeycloakUserRoleMappings struct {
+	RealmMappings  []KeycloakRole                        `json:"realmMappings"`
+	ClientMappings map[string]KeycloakClientRoleMappings `json:"clientMappings"`
+}
+
+// KeycloakClientRoleMappings represents the roles of a single client mapped to a Keycloak user
+type KeycloakClientRoleMappings struct {
+	Client   string         `json:"client"`
+	Mappings []KeycloakRole `json:"mappings"`
+}
+
+// KeycloakGroup represents a Keycloak group membership of a user
+type KeycloakGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// KeycloakPartialImportRequest represents the payload for importing the resources of a realm export into a realm
+type KeycloakPartialImportRequest struct {
+	IfResourceExists  string `json:"ifResourceExists"`
+	Clients           []any  `json:"clients,omitempty"`
+	Roles             any    `json:"roles,omitempty"`
+	Groups            []any  `json:"groups,omitempty"`
+	IdentityProviders []any  `json:"identityProviders,omitempty"`
+	Users             []any  `json:"users,omitempty"`
+}
+
+// KeycloakPartialImportResponse represents the number of added, overwritten and skipped resources of a partial import
+type KeycloakPartialImportResponse struct {
+	Added       int `json:"added"`
+	Overwritten int `json:"overwritten"`
+	Skipped     int `json:"skipped"`
+}
+
 // ==================== OAuth Token Responses ====================
 
 // KeycloakTokenResponse represents the OAuth token response from Keycloak
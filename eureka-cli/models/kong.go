@@ -8,6 +8,7 @@ type KongRoute struct {
 	Methods    []string `json:"methods"`
 	Paths      []string `json:"paths"`
 	Expression string   `json:"expression"`
+	Priority   int64    `json:"priority"`
 	Tags       []string `json:"tags"`
 	Service    struct {
 		ID string `json:"id"`
@@ -19,3 +20,33 @@ type KongRoutesResponse struct {
 	Data []KongRoute `json:"data"`
 	Next string      `json:"next,omitempty"`
 }
+
+// KongService represents a Kong API gateway service forwarding the requests of its routes to an upstream URL
+type KongService struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	Protocol string   `json:"protocol"`
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Path     string   `json:"path"`
+	Tags     []string `json:"tags"`
+}
+
+// KongServicesResponse represents the response containing a list of Kong services
+type KongServicesResponse struct {
+	Data []KongService `json:"data"`
+	Next string        `json:"next,omitempty"`
+}
+
+// KongRouteInfo represents a Kong route together with the module and the discovery location its service forwards to
+type KongRouteInfo struct {
+	RouteID    string   `json:"routeId"`
+	Name       string   `json:"name"`
+	Expression string   `json:"expression,omitempty"`
+	Paths      []string `json:"paths,omitempty"`
+	Methods    []string `json:"methods"`
+	Priority   int64    `json:"priority"`
+	Service    string   `json:"service"`
+	ModuleID   string   `json:"moduleId"`
+	Location   string   `json:"location"`
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Lock represents the lockfile of a profile pinning the module versions resolved from the LSP/FAR registries,
+// the image digests of the deployed modules, the sidecar image and the application ID
+type Lock struct {
+	Profile       string         `json:"profile"`
+	ApplicationID string         `json:"applicationId"`
+	SidecarImage  string         `json:"sidecarImage"`
+	SidecarDigest string         `json:"sidecarDigest,omitempty"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	Modules       []LockedModule `json:"modules"`
+}
+
+// LockedModule represents a module pinned by the lockfile, image and digest are only set for deployed backend modules
+type LockedModule struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Image   string `json:"image,omitempty"`
+	Digest  string `json:"digest,omitempty"`
+}
+
+// GetPinnedImage returns the image pinned to the digest recorded in the lockfile,
+// the image is returned unchanged when no lockfile is used or the image is not locked
+func (l *Lock) GetPinnedImage(imageName string) (string, bool) {
+	digest := l.GetDigest(imageName)
+	if digest == "" {
+		return imageName, false
+	}
+
+	return PinImage(imageName, digest), true
+}
+
+// GetDigest returns the digest recorded in the lockfile for an image
+func (l *Lock) GetDigest(imageName string) string {
+	if l == nil {
+		return ""
+	}
+	if imageName == l.SidecarImage {
+		return l.SidecarDigest
+	}
+	for _, module := range l.Modules {
+		if module.Image == imageName {
+			return module.Digest
+		}
+	}
+
+	return ""
+}
+
+// GetPinnedSidecarImage returns the sidecar image pinned to its digest
+func (l *Lock) GetPinnedSidecarImage() string {
+	return PinImage(l.SidecarImage, l.SidecarDigest)
+}
+
+// PinImage appends a digest to an image reference, pulling and running a pinned image
+// ignores its tag so the image can no longer change underneath a mutable tag
+func PinImage(imageName string, digest string) string {
+	if digest == "" || strings.Contains(imageName, "@") {
+		return imageName
+	}
+
+	return imageName + "@" + digest
+}
+
+// LockDiff represents a module version change between two lockfiles
+type LockDiff struct {
+	Name       string `json:"name"`
+	Change     string `json:"change"`
+	OldVersion string `json:"oldVersion,omitempty"`
+	NewVersion string `json:"newVersion,omitempty"`
+	OldDigest  string `json:"oldDigest,omitempty"`
+	NewDigest  string `json:"newDigest,omitempty"`
+}
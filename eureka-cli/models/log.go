@@ -0,0 +1,23 @@
+package models
+
+import (
+	"regexp"
+	"time"
+)
+
+// LogOptions holds the filters applied when reading module and sidecar container logs
+type LogOptions struct {
+	Follow bool
+	Since  string
+	Grep   *regexp.Regexp
+	Level  string
+}
+
+// LogLine represents a single line of a module or sidecar container log
+type LogLine struct {
+	Container string
+	Timestamp time.Time
+	Stderr    bool
+	Level     string
+	Text      string
+}
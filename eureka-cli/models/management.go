@@ -37,8 +37,9 @@ type TenantEntitlementResponse struct {
 
 // TenantEntitlementDTO represents a single tenant entitlement
 type TenantEntitlementDTO struct {
-	ApplicationID string `json:"applicationId"`
-	TenantID      string `json:"tenantId"`
+	ApplicationID string   `json:"applicationId"`
+	TenantID      string   `json:"tenantId"`
+	Modules       []string `json:"modules,omitempty"`
 }
 
 // ==================== Application Management ====================
@@ -191,12 +191,29 @@ type Containers struct {
 	Modules        *ProxyModulesByRegistry
 	BackendModules map[string]BackendModule
 	IsManagement   bool
+	Lock           *Lock
+}
+
+// ==================== Module Descriptor ====================
+
+// ModuleDescriptor represents the interfaces provided and required by a module descriptor
+type ModuleDescriptor struct {
+	ID       string            `json:"id"`
+	Provides []ModuleInterface `json:"provides"`
+	Requires []ModuleInterface `json:"requires"`
+}
+
+// ModuleInterface represents an interface reference of a module descriptor
+type ModuleInterface struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
 }
 
 // ==================== Event ====================
 
 // Event represents a Docker container event with status, error, and progress information
 type Event struct {
+	ID             string `json:"id"`
 	Status         string `json:"status"`
 	Error          string `json:"error"`
 	Progress       string `json:"progress"`
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// PortProxy represents a TCP forwarder from a sidecar hostname on the host network to the sidecar port on the gateway host,
+// it is recorded in a pid file while the forwarder process is running
+type PortProxy struct {
+	Name          string    `json:"name"`
+	ListenAddress string    `json:"listenAddress"`
+	TargetAddress string    `json:"targetAddress"`
+	PID           int       `json:"pid"`
+	LogFile       string    `json:"logFile,omitempty"`
+	StartedAt     time.Time `json:"startedAt"`
+	Running       bool      `json:"running"`
+}
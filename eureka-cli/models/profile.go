@@ -0,0 +1,13 @@
+package models
+
+// ProfileSummary represents a config profile discovered in one of the profile directories
+type ProfileSummary struct {
+	Name        string `json:"name"`
+	FilePath    string `json:"filePath"`
+	Application string `json:"application,omitempty"`
+	PortStart   int    `json:"portStart,omitempty"`
+	PortEnd     int    `json:"portEnd,omitempty"`
+	ChildApp    bool   `json:"childApp"`
+	Extends     string `json:"extends,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// RegistrySnapshot represents the manifest of a registry snapshot holding the LSP platform descriptor,
+// the FAR application descriptors and the module descriptors of a platform version
+type RegistrySnapshot struct {
+	Name            string    `json:"name"`
+	CreatedAt       time.Time `json:"createdAt"`
+	LspURL          string    `json:"lspUrl"`
+	FarURL          string    `json:"farUrl"`
+	RegistryURL     string    `json:"registryUrl"`
+	Platform        string    `json:"platform"`
+	PlatformVersion string    `json:"platformVersion"`
+	Applications    []string  `json:"applications"`
+	Modules         int       `json:"modules"`
+	MissingModules  []string  `json:"missingModules,omitempty"`
+}
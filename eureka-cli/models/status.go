@@ -0,0 +1,18 @@
+package models
+
+// StatusReport represents the health of every component of a deployed environment
+type StatusReport struct {
+	Profile     string        `json:"profile"`
+	Application string        `json:"application"`
+	Healthy     bool          `json:"healthy"`
+	Components  []StatusEntry `json:"components"`
+}
+
+// StatusEntry represents the health of a single component, e.g. a container, route or tenant
+type StatusEntry struct {
+	Category string `json:"category"`
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	Detail   string `json:"detail,omitempty"`
+	Healthy  bool   `json:"healthy"`
+}
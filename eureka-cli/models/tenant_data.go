@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// TenantDataArchive represents the manifest of a tenant data archive holding a dump of the module schemas of a tenant
+type TenantDataArchive struct {
+	Tenant    string             `json:"tenant"`
+	Profile   string             `json:"profile"`
+	Database  string             `json:"database"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Schemas   []TenantDataSchema `json:"schemas"`
+}
+
+// TenantDataSchema represents a module schema of a tenant together with the module entitled when it was exported
+type TenantDataSchema struct {
+	Name     string `json:"name"`
+	ModuleID string `json:"moduleId,omitempty"`
+}
+
+// TenantDataModuleMismatch represents a module schema whose exported module differs from the module entitled for the tenant
+type TenantDataModuleMismatch struct {
+	Schema          string `json:"schema"`
+	ArchiveModuleID string `json:"archiveModuleId"`
+	TenantModuleID  string `json:"tenantModuleId"`
+}
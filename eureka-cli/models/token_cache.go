@@ -0,0 +1,37 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// TokenCache represents the tokens shared across CLI invocations keyed by TokenCacheKey.String()
+type TokenCache struct {
+	Entries map[string]TokenCacheEntry `json:"entries"`
+}
+
+// TokenCacheKey identifies a token by the profile, realm, client and grant type it was issued for,
+// together with the user for tokens issued to a user instead of a client
+type TokenCacheKey struct {
+	Profile   string `json:"profile"`
+	Realm     string `json:"realm"`
+	Client    string `json:"client"`
+	GrantType string `json:"grantType"`
+	Username  string `json:"username,omitempty"`
+}
+
+// TokenCacheEntry represents a cached token, a zero expiry means that the token does not expire
+type TokenCacheEntry struct {
+	TokenCacheKey
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt,omitzero"`
+}
+
+func (k TokenCacheKey) String() string {
+	parts := []string{k.Profile, k.Realm, k.Client, k.GrantType}
+	if k.Username != "" {
+		parts = append(parts, k.Username)
+	}
+
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,43 @@
+package models
+
+import "fmt"
+
+// ValidationReport represents the result of validating a single config file
+type ValidationReport struct {
+	FilePath string            `json:"filePath"`
+	Issues   []ValidationIssue `json:"issues"`
+}
+
+// ValidationIssue represents a single problem found in a config file at the given YAML position,
+// File is only set when the issue was found in a config file extended by the validated one
+type ValidationIssue struct {
+	File     string `json:"file,omitempty"`
+	Severity string `json:"severity"`
+	Path     string `json:"path"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Message  string `json:"message"`
+}
+
+func (vr *ValidationReport) CountIssues(severity string) (count int) {
+	for _, issue := range vr.Issues {
+		if issue.Severity == severity {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (vi ValidationIssue) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s (%s)", vi.Line, vi.Column, vi.Severity, vi.Message, vi.Path)
+}
+
+// GetFilePath returns the config file the issue was found in
+func (vi ValidationIssue) GetFilePath(reportFilePath string) string {
+	if vi.File != "" {
+		return vi.File
+	}
+
+	return reportFilePath
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ==================== ValidationReport Tests ====================
+
+func TestValidationReport_CountIssues(t *testing.T) {
+	// Arrange
+	report := ValidationReport{Issues: []ValidationIssue{{Severity: "error"}, {Severity: "warning"}, {Severity: "error"}}}
+
+	// Act & Assert
+	assert.Equal(t, 2, report.CountIssues("error"))
+	assert.Equal(t, 1, report.CountIssues("warning"))
+}
+
+func TestValidationIssue_String(t *testing.T) {
+	// Arrange
+	issue := ValidationIssue{Severity: "error", Path: "tenants.diku.deploy-uii", Line: 3, Column: 5, Message: `unknown key "deploy-uii"`}
+
+	// Act
+	result := issue.String()
+
+	// Assert
+	assert.Equal(t, `3:5: error: unknown key "deploy-uii" (tenants.diku.deploy-uii)`, result)
+}
@@ -22,7 +22,10 @@ type ModuleProcessor interface {
 	ModuleReadinessChecker
 	ModuleProvisioner
 	ModuleManager
+	ModuleImagePuller
+	ModuleLogReader
 	ModuleCustomizer
+	ModuleDriftDetector
 }
 
 // ModuleProvisioner defines the interface for module provisioning operations
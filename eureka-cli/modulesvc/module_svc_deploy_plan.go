@@ -0,0 +1,198 @@
+package modulesvc
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// moduleDeployment is a module container together with its optional sidecar container,
+// dependencies hold the names of the modules that must be started before this module
+type moduleDeployment struct {
+	name                string
+	moduleID            string
+	localDescriptorPath string
+	serverPort          int
+	module              *models.Container
+	sidecar             *models.Container
+	dependencies        []string
+}
+
+func (ms *ModuleSvc) getParallelism() int {
+	if ms.Action.IsDryRun() {
+		return 1
+	}
+	if ms.Action.Param == nil || ms.Action.Param.Parallelism < 1 {
+		return constant.DefaultParallelism
+	}
+
+	return ms.Action.Param.Parallelism
+}
+
+// ==================== Dependencies ====================
+
+func (ms *ModuleSvc) getModuleDescriptor(deployment *moduleDeployment) (*models.ModuleDescriptor, error) {
+	var descriptor models.ModuleDescriptor
+	if deployment.localDescriptorPath != "" {
+		if err := helpers.ReadJSONFromFile(deployment.localDescriptorPath, &descriptor); err != nil {
+			return nil, err
+		}
+
+		return &descriptor, nil
+	}
+	if ms.Action.IsOffline() {
+		descriptorPath := ms.Action.GetSnapshotModuleDescriptorPath(deployment.moduleID)
+		if err := helpers.ReadJSONFromFile(descriptorPath, &descriptor); err != nil {
+			return nil, appErrors.RegistrySnapshotEntryNotFound(descriptorPath, err)
+		}
+
+		return &descriptor, nil
+	}
+	if err := ms.HTTPClient.GetReturnStruct(ms.Action.GetModuleURL(deployment.moduleID), map[string]string{}, &descriptor); err != nil {
+		return nil, err
+	}
+
+	return &descriptor, nil
+}
+
+// setDeploymentDependencies fetches the module descriptors concurrently and links every module
+// to the modules providing the interfaces it requires, modules without a descriptor have no dependencies
+func (ms *ModuleSvc) setDeploymentDependencies(deployments []*moduleDeployment, parallelism int) {
+	descriptors := make([]*models.ModuleDescriptor, len(deployments))
+	_ = helpers.RunParallel(len(deployments), parallelism, func(idx int) error {
+		descriptor, err := ms.getModuleDescriptor(deployments[idx])
+		if err != nil {
+			slog.Warn(ms.Action.Name, "text", "Module descriptor is unavailable, deploying module without dependency ordering", "module", deployments[idx].moduleID, "error", err)
+			return nil
+		}
+		descriptors[idx] = descriptor
+
+		return nil
+	})
+
+	providers := make(map[string][]string)
+	for idx, descriptor := range descriptors {
+		if descriptor == nil {
+			continue
+		}
+		for _, provided := range descriptor.Provides {
+			providers[provided.ID] = append(providers[provided.ID], deployments[idx].name)
+		}
+	}
+	for idx, descriptor := range descriptors {
+		if descriptor == nil {
+			continue
+		}
+		var dependencies []string
+		for _, required := range descriptor.Requires {
+			for _, provider := range providers[required.ID] {
+				if provider != deployments[idx].name && !slices.Contains(dependencies, provider) {
+					dependencies = append(dependencies, provider)
+				}
+			}
+		}
+		slices.Sort(dependencies)
+		deployments[idx].dependencies = dependencies
+	}
+}
+
+// ==================== Scheduling ====================
+
+type deploymentResult struct {
+	deployment *moduleDeployment
+	err        error
+}
+
+// deployInOrder runs deployFn for every deployment on a pool of parallelism workers, a deployment is started
+// only after all of its dependencies were deployed, dependents of a failed deployment are skipped
+// and a dependency cycle is broken by starting the module with the fewest pending dependencies
+func (ms *ModuleSvc) deployInOrder(deployments []*moduleDeployment, parallelism int, deployFn func(deployment *moduleDeployment) error) error {
+	var (
+		pending    = make(map[string]*moduleDeployment)
+		remaining  = make(map[string]int)
+		dependents = make(map[string][]string)
+		ready      []*moduleDeployment
+		errs       []error
+	)
+	for _, deployment := range deployments {
+		pending[deployment.name] = deployment
+	}
+	for _, deployment := range deployments {
+		for _, dependency := range deployment.dependencies {
+			if _, ok := pending[dependency]; ok {
+				remaining[deployment.name]++
+				dependents[dependency] = append(dependents[dependency], deployment.name)
+			}
+		}
+		if remaining[deployment.name] == 0 {
+			ready = append(ready, deployment)
+		}
+	}
+
+	var complete func(name string, err error)
+	complete = func(name string, err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for _, dependentName := range dependents[name] {
+			dependent, ok := pending[dependentName]
+			if !ok {
+				continue
+			}
+			if err != nil {
+				delete(pending, dependentName)
+				complete(dependentName, appErrors.ModuleDependencyFailed(dependentName, name))
+				continue
+			}
+			remaining[dependentName]--
+			if remaining[dependentName] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	results := make(chan deploymentResult)
+	running := 0
+	for len(pending) > 0 || running > 0 {
+		for running < max(1, parallelism) && len(ready) > 0 {
+			deployment := ready[0]
+			ready = ready[1:]
+			delete(pending, deployment.name)
+			running++
+			go func() {
+				results <- deploymentResult{deployment: deployment, err: deployFn(deployment)}
+			}()
+		}
+		if running == 0 {
+			deployment := ms.breakDependencyCycle(deployments, pending, remaining)
+			ready = append(ready, deployment)
+			continue
+		}
+
+		result := <-results
+		running--
+		complete(result.deployment.name, result.err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (ms *ModuleSvc) breakDependencyCycle(deployments []*moduleDeployment, pending map[string]*moduleDeployment, remaining map[string]int) *moduleDeployment {
+	var next *moduleDeployment
+	for _, deployment := range deployments {
+		if _, ok := pending[deployment.name]; !ok {
+			continue
+		}
+		if next == nil || remaining[deployment.name] < remaining[next.name] {
+			next = deployment
+		}
+	}
+	slog.Warn(ms.Action.Name, "text", "Module dependency cycle detected, deploying module before its dependencies", "module", next.name, "dependencies", next.dependencies)
+
+	return next
+}
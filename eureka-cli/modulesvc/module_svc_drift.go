@@ -0,0 +1,125 @@
+package modulesvc
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/field"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// ModuleDriftDetector defines the interface for detecting deployed module containers that differ from the config
+type ModuleDriftDetector interface {
+	GetModuleDrift(client *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]string, error)
+}
+
+// GetModuleDrift compares the deployed module and sidecar containers with the containers that would be deployed
+// and returns the first difference by module name, modules without a deployed container are not reported
+func (ms *ModuleSvc) GetModuleDrift(client *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]string, error) {
+	drift := make(map[string]string)
+	for _, deployment := range ms.getModuleDeployments(containers, sidecarImage, sidecarResources) {
+		fixedHostPort := 0
+		if entry, ok := ms.Action.ConfigBackendModules[deployment.name].(map[string]any); ok && helpers.GetIntPtr(entry, field.ModulePortEntry) != nil {
+			fixedHostPort = deployment.serverPort
+		}
+
+		reason, err := ms.inspectContainerDrift(client, deployment.module, fixedHostPort)
+		if err != nil {
+			return nil, err
+		}
+		if reason == "" && deployment.sidecar != nil {
+			if reason, err = ms.inspectContainerDrift(client, deployment.sidecar, 0); err != nil {
+				return nil, err
+			}
+		}
+		if reason != "" {
+			drift[deployment.name] = reason
+		}
+	}
+
+	return drift, nil
+}
+
+func (ms *ModuleSvc) inspectContainerDrift(client *client.Client, c *models.Container, fixedHostPort int) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	containerName := ms.getContainerName(c)
+	inspect, err := client.ContainerInspect(ctx, containerName)
+	if errdefs.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return getContainerDrift(containerName, c, inspect, fixedHostPort), nil
+}
+
+// getContainerDrift returns why the inspected container differs from the desired container or an empty string,
+// host ports are reserved anew on every run so only a host port fixed by the config is compared
+func getContainerDrift(containerName string, desired *models.Container, inspect container.InspectResponse, fixedHostPort int) string {
+	if inspect.Config == nil || inspect.HostConfig == nil {
+		return ""
+	}
+	if inspect.Config.Image != desired.Config.Image {
+		return fmt.Sprintf("container %s runs image %s instead of %s", containerName, inspect.Config.Image, desired.Config.Image)
+	}
+	for _, env := range desired.Config.Env {
+		if !slices.Contains(inspect.Config.Env, env) {
+			name, _, _ := strings.Cut(env, "=")
+			return fmt.Sprintf("container %s has a different %s environment variable", containerName, name)
+		}
+	}
+
+	desiredPorts, livePorts := getContainerPorts(desired.HostConfig.PortBindings), getContainerPorts(inspect.HostConfig.PortBindings)
+	if !slices.Equal(desiredPorts, livePorts) {
+		return fmt.Sprintf("container %s publishes ports %v instead of %v", containerName, livePorts, desiredPorts)
+	}
+	if fixedHostPort != 0 && !hasHostPort(inspect.HostConfig.PortBindings, strconv.Itoa(fixedHostPort)) {
+		return fmt.Sprintf("container %s does not publish host port %d", containerName, fixedHostPort)
+	}
+
+	// Docker drops the swap limit and the Windows-only CPU count when the host does not support them
+	desiredResources, liveResources := desired.HostConfig.Resources, inspect.HostConfig.Resources
+	if desiredResources.Memory != liveResources.Memory ||
+		desiredResources.MemoryReservation != liveResources.MemoryReservation ||
+		liveResources.MemorySwap > 0 && desiredResources.MemorySwap != liveResources.MemorySwap ||
+		liveResources.CPUCount > 0 && desiredResources.CPUCount != liveResources.CPUCount {
+		return fmt.Sprintf("container %s has different resources", containerName)
+	}
+
+	return ""
+}
+
+func getContainerPorts(portBindings nat.PortMap) []string {
+	ports := make([]string, 0, len(portBindings))
+	for port := range maps.Keys(portBindings) {
+		ports = append(ports, fmt.Sprintf("%s/%s", port.Port(), port.Proto()))
+	}
+	slices.Sort(ports)
+
+	return ports
+}
+
+func hasHostPort(portBindings nat.PortMap, hostPort string) bool {
+	for _, bindings := range portBindings {
+		for _, binding := range bindings {
+			if binding.HostPort == hostPort {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,306 @@
+package modulesvc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+var logLevelPattern = regexp.MustCompile(`\b(TRACE|DEBUG|INFO|WARN|WARNING|ERROR|FATAL)\b`)
+
+// ModuleLogReader defines the interface for reading module and sidecar container logs
+type ModuleLogReader interface {
+	GetLogContainers(client *client.Client, moduleNames []string, sidecar bool) ([]string, error)
+	ReadModuleLogs(ctx context.Context, client *client.Client, containerNames []string, options models.LogOptions, handleFn func(line models.LogLine)) error
+}
+
+// GetLogContainers returns the names of the deployed module containers of the current profile and of the management
+// containers, all modules are selected when no module names are given and sidecars are included on demand
+func (ms *ModuleSvc) GetLogContainers(client *client.Client, moduleNames []string, sidecar bool) ([]string, error) {
+	summaries, err := ms.GetDeployedModules(client, filters.NewArgs(
+		filters.KeyValuePair{Key: "name", Value: fmt.Sprintf(constant.ProfileContainerPattern, ms.Action.ConfigProfileName)},
+		filters.KeyValuePair{Key: "name", Value: constant.ManagementContainerPattern},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	var deployedNames []string
+	for _, summary := range summaries {
+		if len(summary.Names) > 0 {
+			deployedNames = append(deployedNames, strings.TrimPrefix(summary.Names[0], "/"))
+		}
+	}
+	containerNames := filterLogContainers(deployedNames, ms.Action.ConfigProfileName, moduleNames, sidecar)
+	if len(containerNames) == 0 {
+		return nil, appErrors.LogContainersNotFound(moduleNames)
+	}
+
+	return containerNames, nil
+}
+
+// filterLogContainers returns the sorted container names of the profile modules and the management modules
+// that belong to one of the module names, or to any module when no module names are given
+func filterLogContainers(deployedNames []string, profileName string, moduleNames []string, sidecar bool) []string {
+	profilePrefix := fmt.Sprintf("eureka-%s-", profileName)
+	var containerNames []string
+	for _, containerName := range deployedNames {
+		moduleName, found := strings.CutPrefix(containerName, profilePrefix)
+		if !found {
+			if moduleName, found = strings.CutPrefix(containerName, "eureka-"); !found || !strings.HasPrefix(moduleName, constant.ManagementModulePattern) {
+				continue
+			}
+		}
+		moduleName, isSidecar := strings.CutSuffix(moduleName, "-sc")
+		if isSidecar && !sidecar || len(moduleNames) > 0 && !slices.Contains(moduleNames, moduleName) {
+			continue
+		}
+		containerNames = append(containerNames, containerName)
+	}
+	slices.Sort(containerNames)
+
+	return containerNames
+}
+
+// ReadModuleLogs reads the logs of the containers, interleaves their lines by timestamp and passes
+// the lines matching the options to handleFn, in follow mode the merged history is followed
+// by new lines flushed in timestamp order in short intervals until the context is cancelled
+func (ms *ModuleSvc) ReadModuleLogs(ctx context.Context, client *client.Client, containerNames []string, options models.LogOptions, handleFn func(line models.LogLine)) error {
+	startedAt := time.Now()
+	parsers := make(map[string]*logParser)
+	for _, containerName := range containerNames {
+		parsers[containerName] = &logParser{container: containerName}
+	}
+
+	history := make([][]models.LogLine, len(containerNames))
+	err := helpers.RunParallel(len(containerNames), len(containerNames), func(idx int) error {
+		logsOptions := container.LogsOptions{Since: options.Since}
+		return ms.readContainerLogs(ctx, client, parsers[containerNames[idx]], logsOptions, func(line models.LogLine) {
+			history[idx] = append(history[idx], line)
+		})
+	})
+	if err != nil {
+		return err
+	}
+	lines := slices.Concat(history...)
+	writeLogLines(lines, options, handleFn)
+	if !options.Follow {
+		return nil
+	}
+
+	return ms.followModuleLogs(ctx, client, containerNames, parsers, getFollowSince(containerNames, history, startedAt), options, handleFn)
+}
+
+func (ms *ModuleSvc) followModuleLogs(ctx context.Context, client *client.Client, containerNames []string, parsers map[string]*logParser,
+	since map[string]string, options models.LogOptions, handleFn func(line models.LogLine)) error {
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, len(containerNames))
+	)
+	lines := make(chan models.LogLine)
+	for idx, containerName := range containerNames {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			logsOptions := container.LogsOptions{Follow: true, Since: since[containerName]}
+			errs[idx] = ms.readContainerLogs(ctx, client, parsers[containerName], logsOptions, func(line models.LogLine) {
+				lines <- line
+			})
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(lines)
+	}()
+
+	var buffer []models.LogLine
+	ticker := time.NewTicker(constant.DockerLogFlushEvery)
+	defer ticker.Stop()
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				writeLogLines(buffer, options, handleFn)
+				return errors.Join(errs...)
+			}
+			buffer = append(buffer, line)
+		case <-ticker.C:
+			writeLogLines(buffer, options, handleFn)
+			buffer = nil
+		}
+	}
+}
+
+// getFollowSince returns the timestamp to follow every container from, right after its last history line
+// so that no line is repeated, or the start of the history read for containers without any line
+func getFollowSince(containerNames []string, history [][]models.LogLine, startedAt time.Time) map[string]string {
+	since := make(map[string]string)
+	for idx, containerName := range containerNames {
+		timestamp := startedAt
+		if count := len(history[idx]); count > 0 && !history[idx][count-1].Timestamp.IsZero() {
+			timestamp = history[idx][count-1].Timestamp.Add(time.Nanosecond)
+		}
+		since[containerName] = fmt.Sprintf("%d.%09d", timestamp.Unix(), timestamp.Nanosecond())
+	}
+
+	return since
+}
+
+func writeLogLines(lines []models.LogLine, options models.LogOptions, handleFn func(line models.LogLine)) {
+	slices.SortStableFunc(lines, func(a, b models.LogLine) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+	for _, line := range lines {
+		if matchesLogOptions(line, options) {
+			handleFn(line)
+		}
+	}
+}
+
+func matchesLogOptions(line models.LogLine, options models.LogOptions) bool {
+	if options.Level != "" && slices.Index(constant.GetLogLevels(), line.Level) < slices.Index(constant.GetLogLevels(), options.Level) {
+		return false
+	}
+	if options.Grep != nil && !options.Grep.MatchString(line.Text) {
+		return false
+	}
+
+	return true
+}
+
+func (ms *ModuleSvc) readContainerLogs(ctx context.Context, client *client.Client, parser *logParser, logsOptions container.LogsOptions, handleFn func(line models.LogLine)) error {
+	inspect, err := client.ContainerInspect(ctx, parser.container)
+	if err != nil {
+		return err
+	}
+
+	logsOptions.ShowStdout, logsOptions.ShowStderr, logsOptions.Timestamps = true, true, true
+	logStream, err := client.ContainerLogs(ctx, parser.container, logsOptions)
+	if err != nil {
+		return err
+	}
+	defer helpers.CloseReader(logStream)
+
+	// Containers with a TTY write a raw stream without the multiplexing header
+	if inspect.Config != nil && inspect.Config.Tty {
+		err = readRawLogStream(logStream, func(rawLine string) {
+			handleFn(parser.parse(rawLine, false))
+		})
+	} else {
+		err = demuxLogStream(logStream, func(rawLine string, stderr bool) {
+			handleFn(parser.parse(rawLine, stderr))
+		})
+	}
+	if err != nil && ctx.Err() != nil {
+		return nil
+	}
+
+	return err
+}
+
+// demuxLogStream splits a multiplexed Docker log stream into the lines of its stdout and stderr streams,
+// a line split across frames is joined and a pending line without a line break is passed at the end
+func demuxLogStream(reader io.Reader, handleFn func(rawLine string, stderr bool)) error {
+	stdout, stderr := &logLineWriter{handleFn: handleFn}, &logLineWriter{stderr: true, handleFn: handleFn}
+	_, err := stdcopy.StdCopy(stdout, stderr, reader)
+	stdout.flush()
+	stderr.flush()
+
+	return err
+}
+
+// logLineWriter passes every complete line written to it and keeps the text after the last line break pending
+type logLineWriter struct {
+	stderr   bool
+	pending  string
+	handleFn func(rawLine string, stderr bool)
+}
+
+func (w *logLineWriter) Write(payload []byte) (int, error) {
+	text := w.pending + string(payload)
+	for {
+		rawLine, rest, found := strings.Cut(text, "\n")
+		if !found {
+			break
+		}
+		w.handleFn(rawLine, w.stderr)
+		text = rest
+	}
+	w.pending = text
+
+	return len(payload), nil
+}
+
+func (w *logLineWriter) flush() {
+	if w.pending != "" {
+		w.handleFn(w.pending, w.stderr)
+		w.pending = ""
+	}
+}
+
+func readRawLogStream(reader io.Reader, handleFn func(rawLine string)) error {
+	bufferedReader := bufio.NewReader(reader)
+	for {
+		rawLine, err := bufferedReader.ReadString('\n')
+		if rawLine = strings.TrimSuffix(rawLine, "\n"); rawLine != "" {
+			handleFn(rawLine)
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// logParser parses the timestamped log lines of a single container, lines without a timestamp
+// reuse the previous one and indented lines without a level, e.g. stack traces, reuse the previous level
+type logParser struct {
+	container     string
+	lastTimestamp time.Time
+	lastLevel     string
+}
+
+func (p *logParser) parse(rawLine string, stderr bool) models.LogLine {
+	rawLine = strings.TrimSuffix(rawLine, "\r")
+	line := models.LogLine{Container: p.container, Timestamp: p.lastTimestamp, Stderr: stderr, Text: rawLine}
+	if rawTimestamp, text, found := strings.Cut(rawLine, " "); found {
+		if timestamp, err := time.Parse(time.RFC3339Nano, rawTimestamp); err == nil {
+			line.Timestamp, line.Text = timestamp, text
+		}
+	}
+
+	line.Level = getLogLevel(line.Text)
+	if line.Level == "" && (strings.HasPrefix(line.Text, " ") || strings.HasPrefix(line.Text, "\t") || strings.HasPrefix(line.Text, "Caused by")) {
+		line.Level = p.lastLevel
+	}
+	p.lastTimestamp, p.lastLevel = line.Timestamp, line.Level
+
+	return line
+}
+
+func getLogLevel(text string) string {
+	level := logLevelPattern.FindString(text)
+	if level == "WARNING" {
+		return constant.WarnLevel
+	}
+
+	return level
+}
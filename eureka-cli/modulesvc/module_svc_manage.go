@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"slices"
 	"strings"
 	"sync"
 
@@ -46,27 +47,32 @@ func (ms *ModuleSvc) GetDeployedModules(client *client.Client, filters filters.A
 }
 
 func (ms *ModuleSvc) PullModule(client *client.Client, imageName string) error {
+	_, err := ms.pullModule(client, imageName, nil)
+	return err
+}
+
+func (ms *ModuleSvc) pullModule(client *client.Client, imageName string, progress *pullProgress) (bool, error) {
 	_, err := client.ImageInspect(context.Background(), imageName)
 	if err == nil {
 		slog.Debug(ms.Action.Name, "text", "Image already exists locally", "image", imageName)
-		return nil
+		return false, nil
 	}
 	if !errdefs.IsNotFound(err) {
-		return err
+		return false, err
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerImagePull)
 	defer cancel()
 
 	authorizationToken, err := ms.RegistrySvc.GetAuthorizationToken()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	reader, err := client.ImagePull(ctx, imageName, image.PullOptions{
 		RegistryAuth: authorizationToken,
 	})
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer helpers.CloseReader(reader)
 	decoder := json.NewDecoder(reader)
@@ -76,25 +82,70 @@ func (ms *ModuleSvc) PullModule(client *client.Client, imageName string) error {
 		if err := decoder.Decode(&event); errors.Is(err, io.EOF) {
 			break
 		} else if err != nil {
-			return err
+			return false, err
 		}
 		if event.Error == "" {
 			current := helpers.ConvertMemory(helpers.BytesToMib, int64(event.ProgressDetail.Current))
 			total := helpers.ConvertMemory(helpers.BytesToMib, int64(event.ProgressDetail.Total))
 			slog.Debug(ms.Action.Name, "text", "Pulling module", "imageName", imageName, "status", event.Status, "progressCurrent", current, "progressTotal", total)
+			progress.update(imageName, event)
 		} else {
-			return appErrors.ModulePullFailed(imageName, errors.New(event.Error))
+			return false, appErrors.ModulePullFailed(imageName, errors.New(event.Error))
 		}
 	}
+	slog.Debug(ms.Action.Name, "text", "Pulled module image", "image", imageName)
 
-	return nil
+	return true, nil
 }
 
 func (ms *ModuleSvc) DeployModules(client *client.Client, containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) (map[string]int, error) {
+	deployments := ms.getModuleDeployments(containers, sidecarImage, sidecarResources)
+	if len(deployments) == 0 {
+		return map[string]int{}, nil
+	}
+
+	parallelism := ms.getParallelism()
+	slog.Info(ms.Action.Name, "text", "Deploying modules", "count", len(deployments), "parallelism", parallelism)
+	ms.setDeploymentDependencies(deployments, parallelism)
+
+	var imageNames []string
+	for _, deployment := range deployments {
+		if deployment.localDescriptorPath == "" && !slices.Contains(imageNames, deployment.module.Config.Image) {
+			imageNames = append(imageNames, deployment.module.Config.Image)
+		}
+	}
+	if _, err := ms.PullModules(client, imageNames); err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
 	deployedModules := make(map[string]int)
+	if err := ms.deployInOrder(deployments, parallelism, func(deployment *moduleDeployment) error {
+		if err := ms.DeployModule(client, deployment.module); err != nil {
+			return err
+		}
+		mu.Lock()
+		deployedModules[deployment.name] = deployment.serverPort
+		mu.Unlock()
+
+		if deployment.sidecar != nil {
+			if err := ms.DeployModule(client, deployment.sidecar); err != nil {
+				return appErrors.SidecarDeployFailed(deployment.sidecar.Name, err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
 
-	var sidecarWG sync.WaitGroup
-	sidecarErrCh := make(chan error, 10)
+	return deployedModules, nil
+}
+
+// getModuleDeployments builds the module and sidecar containers of every module that should be deployed,
+// module images are pulled up front so the containers themselves never pull
+func (ms *ModuleSvc) getModuleDeployments(containers *models.Containers, sidecarImage string, sidecarResources *container.Resources) []*moduleDeployment {
+	var deployments []*moduleDeployment
 	allModules := [][]*models.ProxyModule{containers.Modules.FolioModules, containers.Modules.EurekaModules}
 	for _, modules := range allModules {
 		for _, module := range modules {
@@ -108,33 +159,37 @@ func (ms *ModuleSvc) DeployModules(client *client.Client, containers *models.Con
 			backendModule := containers.BackendModules[module.Metadata.Name]
 			version := ms.GetModuleImageVersion(backendModule, module)
 			module.Metadata.Version = &version
+			image, pinned := containers.Lock.GetPinnedImage(ms.GetModuleImage(module))
+			if containers.Lock != nil && !pinned && backendModule.LocalDescriptorPath == "" {
+				slog.Warn(ms.Action.Name, "text", "Module image is not pinned in the lock file", "image", image)
+			}
 
-			if err := ms.DeployModule(client, &models.Container{
-				Name: module.Metadata.Name,
-				Config: &container.Config{
-					Image:        ms.GetModuleImage(module),
-					Hostname:     module.Metadata.Name,
-					Env:          ms.GetModuleEnv(containers, module, backendModule),
-					ExposedPorts: *backendModule.ModuleExposedPorts,
+			deployment := &moduleDeployment{
+				name:                module.Metadata.Name,
+				moduleID:            fmt.Sprintf("%s-%s", module.Metadata.Name, version),
+				localDescriptorPath: backendModule.LocalDescriptorPath,
+				serverPort:          backendModule.ModuleExposedServerPort,
+				module: &models.Container{
+					Name: module.Metadata.Name,
+					Config: &container.Config{
+						Image:        image,
+						Hostname:     module.Metadata.Name,
+						Env:          ms.GetModuleEnv(containers, module, backendModule),
+						ExposedPorts: *backendModule.ModuleExposedPorts,
+					},
+					HostConfig: &container.HostConfig{
+						PortBindings:  *backendModule.ModulePortBindings,
+						RestartPolicy: *helpers.GetRestartPolicy(),
+						Resources:     backendModule.ModuleResources,
+						Binds:         backendModule.ModuleVolumes,
+					},
+					NetworkConfig: helpers.GetModuleNetworkConfig(),
+					Platform:      helpers.GetPlatform(),
+					PullImage:     false,
 				},
-				HostConfig: &container.HostConfig{
-					PortBindings:  *backendModule.ModulePortBindings,
-					RestartPolicy: *helpers.GetRestartPolicy(),
-					Resources:     backendModule.ModuleResources,
-					Binds:         backendModule.ModuleVolumes,
-				},
-				NetworkConfig: helpers.GetModuleNetworkConfig(),
-				Platform:      helpers.GetPlatform(),
-				PullImage:     backendModule.LocalDescriptorPath == "",
-			}); err != nil {
-				return nil, err
 			}
-			deployedModules[module.Metadata.Name] = backendModule.ModuleExposedServerPort
-
 			if backendModule.DeploySidecar && sidecarImage != "" {
-				sidecarWG.Add(1)
-				go ms.deploySidecarAsync(&sidecarWG, sidecarErrCh, &models.SidecarRequest{
-					Client:           client,
+				deployment.sidecar = ms.getSidecarContainer(&models.SidecarRequest{
 					Containers:       containers,
 					Module:           module,
 					BackendModule:    backendModule,
@@ -142,18 +197,11 @@ func (ms *ModuleSvc) DeployModules(client *client.Client, containers *models.Con
 					SidecarResources: sidecarResources,
 				})
 			}
+			deployments = append(deployments, deployment)
 		}
 	}
 
-	go func() {
-		sidecarWG.Wait()
-		close(sidecarErrCh)
-	}()
-	for err := range sidecarErrCh {
-		return nil, err
-	}
-
-	return deployedModules, nil
+	return deployments
 }
 
 func (ms *ModuleSvc) shouldSkipModule(module *models.ProxyModule, managementOnly bool) bool {
@@ -166,10 +214,8 @@ func (ms *ModuleSvc) shouldDeployModule(module *models.ProxyModule, backendModul
 	return exists && backendModule.DeployModule
 }
 
-func (ms *ModuleSvc) deploySidecarAsync(wg *sync.WaitGroup, errCh chan<- error, r *models.SidecarRequest) {
-	defer wg.Done()
-
-	container := &models.Container{
+func (ms *ModuleSvc) getSidecarContainer(r *models.SidecarRequest) *models.Container {
+	return &models.Container{
 		Name: r.Module.Metadata.SidecarName,
 		Config: &container.Config{
 			Image:        r.SidecarImage,
@@ -187,13 +233,6 @@ func (ms *ModuleSvc) deploySidecarAsync(wg *sync.WaitGroup, errCh chan<- error,
 		Platform:      helpers.GetPlatform(),
 		PullImage:     false,
 	}
-	if err := ms.DeployModule(r.Client, container); err != nil {
-		err := appErrors.SidecarDeployFailed(r.Module.Metadata.SidecarName, err)
-		select {
-		case errCh <- err:
-		default:
-		}
-	}
 }
 
 func (ms *ModuleSvc) DeployModule(client *client.Client, c *models.Container) error {
@@ -0,0 +1,328 @@
+package modulesvc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+const (
+	progressBarWidth    = 30
+	progressRenderEvery = 250 * time.Millisecond
+)
+
+// ModuleImagePuller defines the interface for resolving, pulling and reporting module images ahead of deployment
+type ModuleImagePuller interface {
+	GetModuleImages(containers *models.Containers, sidecarImage string) []models.ModuleImage
+	PullModules(client *client.Client, imageNames []string) ([]string, error)
+	GetImageReport(client *client.Client, images []models.ModuleImage, pulledImages []string) ([]models.ImageReport, error)
+}
+
+func (ms *ModuleSvc) GetModuleImages(containers *models.Containers, sidecarImage string) []models.ModuleImage {
+	var images []models.ModuleImage
+	allModules := [][]*models.ProxyModule{containers.Modules.FolioModules, containers.Modules.EurekaModules}
+	for _, modules := range allModules {
+		for _, module := range modules {
+			if !ms.shouldDeployModule(module, containers.BackendModules) {
+				continue
+			}
+
+			backendModule := containers.BackendModules[module.Metadata.Name]
+			version := ms.GetModuleImageVersion(backendModule, module)
+			var registryVersion string
+			if module.Metadata.Version != nil {
+				registryVersion = *module.Metadata.Version
+			}
+			resolvedModule := *module
+			resolvedModule.Metadata.Version = &version
+			images = append(images, models.ModuleImage{
+				Module:          module.Metadata.Name,
+				Image:           ms.GetModuleImage(&resolvedModule),
+				Version:         version,
+				RegistryVersion: registryVersion,
+				LocalBuild:      backendModule.LocalDescriptorPath != "",
+			})
+		}
+	}
+	if sidecarImage != "" {
+		registryVersion, _ := ms.findRegistrySidecarImageVersion(containers.Modules.EurekaModules)
+		images = append(images, models.ModuleImage{
+			Module:          constant.SidecarProjectName,
+			Image:           sidecarImage,
+			Version:         sidecarImage[strings.LastIndex(sidecarImage, ":")+1:],
+			RegistryVersion: registryVersion,
+		})
+	}
+
+	return images
+}
+
+// PullModules pulls the images missing from the local image cache in parallel and returns the images that were pulled,
+// images already present are skipped so deployment never pulls the same image twice
+func (ms *ModuleSvc) PullModules(client *client.Client, imageNames []string) ([]string, error) {
+	var (
+		mu           sync.Mutex
+		pulledImages []string
+	)
+	progress := newPullProgress(ms.getProgressWriter(), imageNames)
+	progress.start()
+	err := helpers.RunParallel(len(imageNames), ms.getParallelism(), func(idx int) error {
+		pulled, err := ms.pullModule(client, imageNames[idx], progress)
+		progress.finish(imageNames[idx])
+		if err != nil || !pulled {
+			return err
+		}
+		mu.Lock()
+		pulledImages = append(pulledImages, imageNames[idx])
+		mu.Unlock()
+
+		return nil
+	})
+	progress.stop()
+	slices.Sort(pulledImages)
+	slog.Info(ms.Action.Name, "text", "Pulled module images", "pulled", len(pulledImages), "total", len(imageNames))
+
+	return pulledImages, err
+}
+
+func (ms *ModuleSvc) getProgressWriter() io.Writer {
+	if ms.Action.IsDryRun() || !helpers.IsTerminal(os.Stderr) {
+		return nil
+	}
+
+	return os.Stderr
+}
+
+func (ms *ModuleSvc) GetImageReport(client *client.Client, images []models.ModuleImage, pulledImages []string) ([]models.ImageReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	summaries, err := client.ImageList(ctx, image.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []models.ImageReport
+	for _, moduleImage := range images {
+		report := models.ImageReport{
+			ModuleImage: moduleImage,
+			Pulled:      slices.Contains(pulledImages, models.PinImage(moduleImage.Image, moduleImage.Digest)),
+			Stale:       moduleImage.IsStale(),
+		}
+		for _, summary := range summaries {
+			if moduleImage.Digest != "" && hasRepoDigest(summary.RepoDigests, moduleImage.Digest) {
+				report.Present, report.Size = true, summary.Size
+			}
+			for _, repoTag := range summary.RepoTags {
+				repository, tag := splitRepoTag(repoTag)
+				if repoTag == moduleImage.Image {
+					if moduleImage.Digest == "" {
+						report.Present, report.Size = true, summary.Size
+					}
+				} else if repository == moduleImage.Module || strings.HasSuffix(repository, "/"+moduleImage.Module) {
+					report.CachedTags = append(report.CachedTags, tag)
+				}
+			}
+		}
+		slices.Sort(report.CachedTags)
+		report.CachedTags = slices.Compact(report.CachedTags)
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+func hasRepoDigest(repoDigests []string, digest string) bool {
+	for _, repoDigest := range repoDigests {
+		if strings.HasSuffix(repoDigest, "@"+digest) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func splitRepoTag(repoTag string) (string, string) {
+	idx := strings.LastIndex(repoTag, ":")
+	if idx < 0 || strings.Contains(repoTag[idx:], "/") {
+		return repoTag, ""
+	}
+
+	return repoTag[:idx], repoTag[idx+1:]
+}
+
+// ==================== Progress ====================
+
+type layerProgress struct {
+	current int64
+	total   int64
+}
+
+// pullProgress aggregates the layer download progress of concurrently pulled images and redraws
+// an overall progress bar followed by a progress bar per image in flight, a nil pullProgress is a no-op
+type pullProgress struct {
+	mu         sync.Mutex
+	writer     io.Writer
+	imageNames []string
+	layers     map[string]map[string]*layerProgress
+	finished   map[string]bool
+	lines      int
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+}
+
+func newPullProgress(writer io.Writer, imageNames []string) *pullProgress {
+	if writer == nil {
+		return nil
+	}
+
+	return &pullProgress{
+		writer:     writer,
+		imageNames: imageNames,
+		layers:     make(map[string]map[string]*layerProgress),
+		finished:   make(map[string]bool),
+		stopCh:     make(chan struct{}),
+		doneCh:     make(chan struct{}),
+	}
+}
+
+func (p *pullProgress) start() {
+	if p == nil {
+		return
+	}
+
+	go func() {
+		defer close(p.doneCh)
+		ticker := time.NewTicker(progressRenderEvery)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.stopCh:
+				return
+			case <-ticker.C:
+				p.render()
+			}
+		}
+	}()
+}
+
+func (p *pullProgress) stop() {
+	if p == nil {
+		return
+	}
+	close(p.stopCh)
+	<-p.doneCh
+	p.render()
+}
+
+func (p *pullProgress) update(imageName string, event *models.Event) {
+	if p == nil || event.ID == "" {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.layers[imageName] == nil {
+		p.layers[imageName] = make(map[string]*layerProgress)
+	}
+	layer, ok := p.layers[imageName][event.ID]
+	if !ok {
+		layer = &layerProgress{}
+		p.layers[imageName][event.ID] = layer
+	}
+	switch event.Status {
+	case "Downloading":
+		layer.current, layer.total = int64(event.ProgressDetail.Current), int64(event.ProgressDetail.Total)
+	case "Download complete", "Pull complete":
+		layer.current = layer.total
+	}
+}
+
+func (p *pullProgress) finish(imageName string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.finished[imageName] = true
+	for _, layer := range p.layers[imageName] {
+		layer.current = layer.total
+	}
+}
+
+func (p *pullProgress) render() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var builder strings.Builder
+	if p.lines > 0 {
+		_, _ = fmt.Fprintf(&builder, "\033[%dA", p.lines)
+	}
+
+	var (
+		lines          []string
+		current, total int64
+		finished       int
+		nameWidth      int
+	)
+	for _, imageName := range p.imageNames {
+		if !p.finished[imageName] && p.layers[imageName] != nil {
+			nameWidth = max(nameWidth, len(imageName))
+		}
+	}
+	for _, imageName := range p.imageNames {
+		imageCurrent, imageTotal := p.getImageProgress(imageName)
+		current, total = current+imageCurrent, total+imageTotal
+		if p.finished[imageName] {
+			finished++
+			continue
+		}
+		if p.layers[imageName] != nil {
+			lines = append(lines, fmt.Sprintf("  %-*s %s", nameWidth, imageName, formatProgress(imageCurrent, imageTotal)))
+		}
+	}
+	lines = append([]string{fmt.Sprintf("Pulling images %d/%d %s", finished, len(p.imageNames), formatProgress(current, total))}, lines...)
+
+	for _, line := range lines {
+		_, _ = fmt.Fprintf(&builder, "\033[2K%s\n", line)
+	}
+	for range p.lines - len(lines) {
+		builder.WriteString("\033[2K\n")
+	}
+	if p.lines > len(lines) {
+		_, _ = fmt.Fprintf(&builder, "\033[%dA", p.lines-len(lines))
+	}
+	p.lines = len(lines)
+	_, _ = io.WriteString(p.writer, builder.String())
+}
+
+func (p *pullProgress) getImageProgress(imageName string) (current int64, total int64) {
+	for _, layer := range p.layers[imageName] {
+		current, total = current+layer.current, total+layer.total
+	}
+
+	return current, total
+}
+
+func formatProgress(current, total int64) string {
+	filled := 0
+	if total > 0 {
+		filled = int(min(current, total) * progressBarWidth / total)
+	}
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", progressBarWidth-filled)
+
+	return fmt.Sprintf("[%s] %d/%d MiB", bar, helpers.ConvertMemory(helpers.BytesToMib, current), helpers.ConvertMemory(helpers.BytesToMib, total))
+}
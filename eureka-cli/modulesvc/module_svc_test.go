@@ -1,17 +1,25 @@
 package modulesvc
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	"net/http"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
 	"github.com/folio-org/eureka-setup/eureka-cli/field"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/moduleenv"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -1370,3 +1378,575 @@ func TestGetLocalModuleImage_LatestTag(t *testing.T) {
 	// Assert
 	assert.Equal(t, "docker.io/folioorg/mod-latest:latest", result)
 }
+
+// ==================== DeployModules Scheduling Tests ====================
+
+func TestGetParallelism(t *testing.T) {
+	t.Run("TestGetParallelism_Default", func(t *testing.T) {
+		// Arrange
+		svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+
+		// Act & Assert
+		assert.Equal(t, constant.DefaultParallelism, svc.getParallelism())
+	})
+
+	t.Run("TestGetParallelism_FromParam", func(t *testing.T) {
+		// Arrange
+		action := testhelpers.NewMockAction()
+		action.Param.Parallelism = 8
+		svc := New(action, nil, nil, nil, nil)
+
+		// Act & Assert
+		assert.Equal(t, 8, svc.getParallelism())
+	})
+
+	t.Run("TestGetParallelism_DryRun", func(t *testing.T) {
+		// Arrange
+		action := testhelpers.NewMockAction()
+		action.Param.Parallelism = 8
+		action.Param.DryRun = true
+		svc := New(action, nil, nil, nil, nil)
+
+		// Act & Assert
+		assert.Equal(t, 1, svc.getParallelism())
+	})
+}
+
+func TestSetDeploymentDependencies(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	action.ConfigRegistryURL = "http://registry"
+	mockHTTP := new(testhelpers.MockHTTPClient)
+	descriptors := map[string]models.ModuleDescriptor{
+		"mod-users-1.0.0": {
+			Provides: []models.ModuleInterface{{ID: "users", Version: "16.0"}},
+			Requires: []models.ModuleInterface{{ID: "permissions", Version: "5.0"}},
+		},
+		"mod-permissions-1.0.0": {
+			Provides: []models.ModuleInterface{{ID: "permissions", Version: "5.0"}},
+		},
+		"mod-orders-1.0.0": {
+			Requires: []models.ModuleInterface{{ID: "users", Version: "16.0"}, {ID: "permissions", Version: "5.0"}, {ID: "unknown", Version: "1.0"}},
+		},
+	}
+	for moduleID, descriptor := range descriptors {
+		mockHTTP.On("GetReturnStruct", "http://registry/_/proxy/modules/"+moduleID, mock.Anything, mock.Anything).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				*args.Get(2).(*models.ModuleDescriptor) = descriptor
+			})
+	}
+	mockHTTP.On("GetReturnStruct", "http://registry/_/proxy/modules/mod-missing-1.0.0", mock.Anything, mock.Anything).
+		Return(errors.New("not found"))
+	svc := New(action, mockHTTP, nil, nil, nil)
+	deployments := []*moduleDeployment{
+		{name: "mod-orders", moduleID: "mod-orders-1.0.0"},
+		{name: "mod-users", moduleID: "mod-users-1.0.0"},
+		{name: "mod-permissions", moduleID: "mod-permissions-1.0.0"},
+		{name: "mod-missing", moduleID: "mod-missing-1.0.0"},
+	}
+
+	// Act
+	svc.setDeploymentDependencies(deployments, 2)
+
+	// Assert
+	assert.Equal(t, []string{"mod-permissions", "mod-users"}, deployments[0].dependencies)
+	assert.Equal(t, []string{"mod-permissions"}, deployments[1].dependencies)
+	assert.Empty(t, deployments[2].dependencies)
+	assert.Empty(t, deployments[3].dependencies)
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestDeployInOrder_DependenciesFirst(t *testing.T) {
+	// Arrange
+	svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+	deployments := []*moduleDeployment{
+		{name: "mod-orders", dependencies: []string{"mod-permissions", "mod-users"}},
+		{name: "mod-users", dependencies: []string{"mod-permissions"}},
+		{name: "mod-permissions"},
+		{name: "mod-notes"},
+	}
+	var (
+		mu    sync.Mutex
+		order []string
+	)
+
+	// Act
+	err := svc.deployInOrder(deployments, 3, func(deployment *moduleDeployment) error {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, deployment.name)
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, order, 4)
+	indexOf := func(name string) int {
+		for idx, deployed := range order {
+			if deployed == name {
+				return idx
+			}
+		}
+		return -1
+	}
+	assert.Less(t, indexOf("mod-permissions"), indexOf("mod-users"))
+	assert.Less(t, indexOf("mod-users"), indexOf("mod-orders"))
+}
+
+func TestDeployInOrder_RespectsParallelism(t *testing.T) {
+	// Arrange
+	svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+	var deployments []*moduleDeployment
+	for _, name := range []string{"mod-a", "mod-b", "mod-c", "mod-d", "mod-e", "mod-f"} {
+		deployments = append(deployments, &moduleDeployment{name: name})
+	}
+	var (
+		mu         sync.Mutex
+		running    int
+		maxRunning int
+	)
+
+	// Act
+	err := svc.deployInOrder(deployments, 2, func(deployment *moduleDeployment) error {
+		mu.Lock()
+		running++
+		maxRunning = max(maxRunning, running)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, maxRunning)
+}
+
+func TestDeployInOrder_AggregatesErrorsAndSkipsDependents(t *testing.T) {
+	// Arrange
+	svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+	deployments := []*moduleDeployment{
+		{name: "mod-a"},
+		{name: "mod-b"},
+		{name: "mod-c", dependencies: []string{"mod-a"}},
+		{name: "mod-d", dependencies: []string{"mod-c"}},
+		{name: "mod-e"},
+	}
+	errA := errors.New("mod-a failed")
+	errB := errors.New("mod-b failed")
+	var deployed sync.Map
+
+	// Act
+	err := svc.deployInOrder(deployments, 2, func(deployment *moduleDeployment) error {
+		deployed.Store(deployment.name, true)
+		switch deployment.name {
+		case "mod-a":
+			return errA
+		case "mod-b":
+			return errB
+		}
+		return nil
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, errA)
+	assert.ErrorIs(t, err, errB)
+	assert.ErrorIs(t, err, appErrors.ErrDeploymentFailed)
+	assert.Contains(t, err.Error(), "skipped module mod-c because its dependency mod-a failed")
+	assert.Contains(t, err.Error(), "skipped module mod-d because its dependency mod-c failed")
+	_, deployedC := deployed.Load("mod-c")
+	_, deployedE := deployed.Load("mod-e")
+	assert.False(t, deployedC)
+	assert.True(t, deployedE)
+}
+
+func TestDeployInOrder_BreaksDependencyCycle(t *testing.T) {
+	// Arrange
+	svc := New(testhelpers.NewMockAction(), nil, nil, nil, nil)
+	deployments := []*moduleDeployment{
+		{name: "mod-a", dependencies: []string{"mod-b"}},
+		{name: "mod-b", dependencies: []string{"mod-a"}},
+		{name: "mod-c", dependencies: []string{"mod-a"}},
+	}
+	var order []string
+
+	// Act
+	err := svc.deployInOrder(deployments, 1, func(deployment *moduleDeployment) error {
+		order = append(order, deployment.name)
+		return nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"mod-a", "mod-b", "mod-c"}, order)
+}
+
+func TestGetModuleDeployments_ImagesArePrePulled(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	mockRegistry := new(testhelpers.MockRegistrySvc)
+	mockRegistry.On("GetNamespace", mock.Anything).Return("folioorg")
+	svc := New(action, nil, nil, mockRegistry, moduleenv.New(action))
+	usersVersion, notesVersion := "19.4.0", "7.0.0"
+	exposedPorts, portBindings := nat.PortSet{}, nat.PortMap{}
+	containers := &models.Containers{
+		Modules: &models.ProxyModulesByRegistry{
+			FolioModules: []*models.ProxyModule{
+				{ID: "mod-users-19.4.0", Metadata: models.ProxyModuleMetadata{Name: "mod-users", Version: &usersVersion}},
+				{ID: "mod-notes-7.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-notes", Version: &notesVersion}},
+			},
+		},
+		BackendModules: map[string]models.BackendModule{
+			"mod-users": {DeployModule: true, ModuleExposedPorts: &exposedPorts, ModulePortBindings: &portBindings},
+			"mod-notes": {DeployModule: true, LocalDescriptorPath: "/tmp/ModuleDescriptor.json", ModuleExposedPorts: &exposedPorts, ModulePortBindings: &portBindings},
+		},
+	}
+
+	// Act
+	deployments := svc.getModuleDeployments(containers, "", &container.Resources{})
+
+	// Assert
+	assert.Len(t, deployments, 2)
+	for _, deployment := range deployments {
+		assert.False(t, deployment.module.PullImage, deployment.name)
+	}
+}
+
+// ==================== PullModules Tests ====================
+
+func TestGetModuleImages(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	mockRegistry := new(testhelpers.MockRegistrySvc)
+	mockRegistry.On("GetNamespace", mock.Anything).Return("folioorg")
+	svc := New(action, nil, nil, mockRegistry, nil)
+	usersVersion, ordersVersion, notesVersion, sidecarVersion := "19.4.0", "13.1.0", "7.0.0", "3.0.0"
+	pinnedVersion := "13.0.0"
+	containers := &models.Containers{
+		Modules: &models.ProxyModulesByRegistry{
+			FolioModules: []*models.ProxyModule{
+				{ID: "mod-users-19.4.0", Metadata: models.ProxyModuleMetadata{Name: "mod-users", Version: &usersVersion}},
+				{ID: "mod-orders-13.1.0", Metadata: models.ProxyModuleMetadata{Name: "mod-orders", Version: &ordersVersion}},
+				{ID: "mod-notes-7.0.0", Metadata: models.ProxyModuleMetadata{Name: "mod-notes", Version: &notesVersion}},
+			},
+			EurekaModules: []*models.ProxyModule{
+				{ID: "folio-module-sidecar-3.0.0", Metadata: models.ProxyModuleMetadata{Name: constant.SidecarProjectName, Version: &sidecarVersion}},
+			},
+		},
+		BackendModules: map[string]models.BackendModule{
+			"mod-users":  {DeployModule: true, LocalDescriptorPath: "/tmp/ModuleDescriptor.json"},
+			"mod-orders": {DeployModule: true, ModuleVersion: &pinnedVersion},
+			"mod-notes":  {DeployModule: false},
+		},
+	}
+
+	// Act
+	images := svc.GetModuleImages(containers, "folioorg/folio-module-sidecar:3.0.0")
+
+	// Assert
+	assert.Equal(t, []models.ModuleImage{
+		{Module: "mod-users", Image: "folioorg/mod-users:19.4.0", Version: "19.4.0", RegistryVersion: "19.4.0", LocalBuild: true},
+		{Module: "mod-orders", Image: "folioorg/mod-orders:13.0.0", Version: "13.0.0", RegistryVersion: "13.1.0"},
+		{Module: constant.SidecarProjectName, Image: "folioorg/folio-module-sidecar:3.0.0", Version: "3.0.0", RegistryVersion: "3.0.0"},
+	}, images)
+	assert.Equal(t, "13.1.0", *containers.Modules.FolioModules[1].Metadata.Version)
+}
+
+func TestSplitRepoTag(t *testing.T) {
+	t.Run("TestSplitRepoTag_WithTag", func(t *testing.T) {
+		repository, tag := splitRepoTag("folioorg/mod-users:19.4.0")
+		assert.Equal(t, "folioorg/mod-users", repository)
+		assert.Equal(t, "19.4.0", tag)
+	})
+
+	t.Run("TestSplitRepoTag_RegistryPortWithoutTag", func(t *testing.T) {
+		repository, tag := splitRepoTag("localhost:5000/mod-users")
+		assert.Equal(t, "localhost:5000/mod-users", repository)
+		assert.Empty(t, tag)
+	})
+}
+
+func TestPullProgress_Render(t *testing.T) {
+	// Arrange
+	var buf strings.Builder
+	progress := newPullProgress(&buf, []string{"folioorg/mod-users:19.4.0", "folioorg/mod-orders:13.1.0"})
+	event := &models.Event{ID: "layer1", Status: "Downloading"}
+	event.ProgressDetail.Current = 1024 * 1024
+	event.ProgressDetail.Total = 4 * 1024 * 1024
+	progress.update("folioorg/mod-users:19.4.0", event)
+	progress.update("folioorg/mod-orders:13.1.0", &models.Event{ID: "layer2", Status: "Pull complete"})
+	progress.finish("folioorg/mod-orders:13.1.0")
+
+	// Act
+	progress.render()
+
+	// Assert
+	output := buf.String()
+	assert.Contains(t, output, "Pulling images 1/2 [#######-----------------------] 1/4 MiB")
+	assert.Contains(t, output, "folioorg/mod-users:19.4.0 [#######-----------------------] 1/4 MiB")
+	assert.NotContains(t, output, "mod-orders")
+	assert.Equal(t, 2, progress.lines)
+}
+
+func TestPullProgress_NilIsNoop(t *testing.T) {
+	// Arrange
+	progress := newPullProgress(nil, []string{"folioorg/mod-users:19.4.0"})
+
+	// Act & Assert
+	assert.Nil(t, progress)
+	assert.NotPanics(t, func() {
+		progress.start()
+		progress.update("folioorg/mod-users:19.4.0", &models.Event{ID: "layer1", Status: "Downloading"})
+		progress.finish("folioorg/mod-users:19.4.0")
+		progress.stop()
+	})
+}
+
+// ==================== ModuleLogs Tests ====================
+
+func newLogFrame(stream byte, payload string) []byte {
+	frame := make([]byte, constant.DockerLogHeaderSize, constant.DockerLogHeaderSize+len(payload))
+	frame[0] = stream
+	binary.BigEndian.PutUint32(frame[constant.DockerLogSizeOffset:], uint32(len(payload)))
+	return append(frame, payload...)
+}
+
+func TestDemuxLogStream(t *testing.T) {
+	// Arrange
+	var stream bytes.Buffer
+	stream.Write(newLogFrame(1, "2025-01-02T10:00:00Z first\n2025-01-02T10:00:01Z sec"))
+	stream.Write(newLogFrame(2, "2025-01-02T10:00:02Z failure\n"))
+	stream.Write(newLogFrame(1, "ond\n2025-01-02T10:00:03Z last"))
+	type demuxedLine struct {
+		rawLine string
+		stderr  bool
+	}
+	var lines []demuxedLine
+
+	// Act
+	err := demuxLogStream(&stream, func(rawLine string, stderr bool) {
+		lines = append(lines, demuxedLine{rawLine, stderr})
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []demuxedLine{
+		{"2025-01-02T10:00:00Z first", false},
+		{"2025-01-02T10:00:02Z failure", true},
+		{"2025-01-02T10:00:01Z second", false},
+		{"2025-01-02T10:00:03Z last", false},
+	}, lines)
+}
+
+func TestDemuxLogStream_TruncatedFrame(t *testing.T) {
+	// Arrange
+	var stream bytes.Buffer
+	stream.Write(newLogFrame(1, "2025-01-02T10:00:00Z first\n"))
+	frame := newLogFrame(1, "2025-01-02T10:00:01Z second\n")
+	stream.Write(frame[:len(frame)-4])
+	var rawLines []string
+
+	// Act
+	err := demuxLogStream(&stream, func(rawLine string, stderr bool) {
+		rawLines = append(rawLines, rawLine)
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2025-01-02T10:00:00Z first"}, rawLines)
+}
+
+func TestDemuxLogStream_UnknownStream(t *testing.T) {
+	// Act
+	err := demuxLogStream(bytes.NewReader(newLogFrame(9, "2025-01-02T10:00:00Z message\n")), func(rawLine string, stderr bool) {})
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestFilterLogContainers(t *testing.T) {
+	deployedNames := []string{"eureka-combined-mod-users-sc", "eureka-mgr-tenants", "eureka-combined-mod-users", "eureka-mgr-applications", "eureka-other-mod-orders"}
+
+	testCases := []struct {
+		name        string
+		moduleNames []string
+		sidecar     bool
+		expected    []string
+	}{
+		{"TestFilterLogContainers_AllModules", nil, false, []string{"eureka-combined-mod-users", "eureka-mgr-applications", "eureka-mgr-tenants"}},
+		{"TestFilterLogContainers_Sidecar", []string{"mod-users"}, true, []string{"eureka-combined-mod-users", "eureka-combined-mod-users-sc"}},
+		{"TestFilterLogContainers_ManagementModule", []string{"mgr-tenants"}, false, []string{"eureka-mgr-tenants"}},
+		{"TestFilterLogContainers_NotFound", []string{"mod-orders"}, false, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			containerNames := filterLogContainers(deployedNames, "combined", tc.moduleNames, tc.sidecar)
+
+			// Assert
+			assert.Equal(t, tc.expected, containerNames)
+		})
+	}
+}
+
+func TestLogParser_Parse(t *testing.T) {
+	// Arrange
+	parser := &logParser{container: "eureka-combined-mod-orders"}
+
+	// Act
+	first := parser.parse("2025-01-02T10:00:00.123456789Z 10:00:00 [] [] [] [] ERROR OrdersService Failed to open order", false)
+	stackTrace := parser.parse("\tat org.folio.orders.OrdersService.open(OrdersService.java:42)", false)
+	warning := parser.parse("2025-01-02T10:00:01Z WARNING: deprecated configuration\r", true)
+	plain := parser.parse("2025-01-02T10:00:02Z Started in 2.1s", false)
+
+	// Assert
+	assert.Equal(t, "eureka-combined-mod-orders", first.Container)
+	assert.Equal(t, time.Date(2025, 1, 2, 10, 0, 0, 123456789, time.UTC), first.Timestamp)
+	assert.Equal(t, constant.ErrorLevel, first.Level)
+	assert.Equal(t, "10:00:00 [] [] [] [] ERROR OrdersService Failed to open order", first.Text)
+	assert.Equal(t, first.Timestamp, stackTrace.Timestamp)
+	assert.Equal(t, constant.ErrorLevel, stackTrace.Level)
+	assert.Equal(t, constant.WarnLevel, warning.Level)
+	assert.True(t, warning.Stderr)
+	assert.Equal(t, "WARNING: deprecated configuration", warning.Text)
+	assert.Empty(t, plain.Level)
+}
+
+func TestWriteLogLines_InterleavesAndFilters(t *testing.T) {
+	// Arrange
+	base := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	lines := []models.LogLine{
+		{Container: "mod-orders", Timestamp: base.Add(2 * time.Second), Level: constant.ErrorLevel, Text: "order 1 failed"},
+		{Container: "mod-orders", Timestamp: base.Add(3 * time.Second), Level: constant.InfoLevel, Text: "order 2 opened"},
+		{Container: "mod-orders-sc", Timestamp: base, Level: constant.WarnLevel, Text: "order 1 slow request"},
+		{Container: "mod-orders-sc", Timestamp: base.Add(time.Second), Text: "order 1 no level"},
+	}
+
+	testCases := []struct {
+		name     string
+		options  models.LogOptions
+		expected []string
+	}{
+		{"TestWriteLogLines_NoFilter", models.LogOptions{}, []string{"order 1 slow request", "order 1 no level", "order 1 failed", "order 2 opened"}},
+		{"TestWriteLogLines_Level", models.LogOptions{Level: constant.WarnLevel}, []string{"order 1 slow request", "order 1 failed"}},
+		{"TestWriteLogLines_Grep", models.LogOptions{Grep: regexp.MustCompile(`order 1 (failed|no)`)}, []string{"order 1 no level", "order 1 failed"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var texts []string
+
+			// Act
+			writeLogLines(slices.Clone(lines), tc.options, func(line models.LogLine) {
+				texts = append(texts, line.Text)
+			})
+
+			// Assert
+			assert.Equal(t, tc.expected, texts)
+		})
+	}
+}
+
+func TestGetFollowSince(t *testing.T) {
+	// Arrange
+	startedAt := time.Unix(1735812000, 0)
+	history := [][]models.LogLine{
+		{{Timestamp: time.Unix(1735811000, 5)}, {Timestamp: time.Unix(1735811990, 999999999)}},
+		nil,
+	}
+
+	// Act
+	since := getFollowSince([]string{"mod-orders", "mod-orders-sc"}, history, startedAt)
+
+	// Assert
+	assert.Equal(t, map[string]string{
+		"mod-orders":    "1735811991.000000000",
+		"mod-orders-sc": "1735812000.000000000",
+	}, since)
+}
+
+func TestGetContainerDrift(t *testing.T) {
+	desired := &models.Container{
+		Config: &container.Config{Image: "folioorg/mod-orders:13.0.0", Env: []string{"DB_HOST=postgres", "JAVA_OPTIONS=-Xmx512m"}},
+		HostConfig: &container.HostConfig{
+			PortBindings: nat.PortMap{"8081": {{HostPort: "30001"}}, "5005": {{HostPort: "30002"}}},
+			Resources:    container.Resources{CPUCount: 1, Memory: 600, MemoryReservation: 500, MemorySwap: 700},
+		},
+	}
+	newInspect := func() container.InspectResponse {
+		return container.InspectResponse{
+			ContainerJSONBase: &container.ContainerJSONBase{HostConfig: &container.HostConfig{
+				PortBindings: nat.PortMap{"8081/tcp": {{HostPort: "30101"}}, "5005/tcp": {{HostPort: "30102"}}},
+				Resources:    container.Resources{Memory: 600, MemoryReservation: 500, MemorySwap: -1},
+			}},
+			Config: &container.Config{Image: "folioorg/mod-orders:13.0.0", Env: []string{"PATH=/usr/bin", "DB_HOST=postgres", "JAVA_OPTIONS=-Xmx512m"}},
+		}
+	}
+
+	t.Run("TestGetContainerDrift_NoDrift", func(t *testing.T) {
+		// Act
+		reason := getContainerDrift("eureka-combined-mod-orders", desired, newInspect(), 0)
+
+		// Assert
+		assert.Empty(t, reason)
+	})
+
+	t.Run("TestGetContainerDrift_Image", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect()
+		inspect.Config.Image = "folioorg/mod-orders:12.0.0"
+
+		// Act
+		reason := getContainerDrift("eureka-combined-mod-orders", desired, inspect, 0)
+
+		// Assert
+		assert.Equal(t, "container eureka-combined-mod-orders runs image folioorg/mod-orders:12.0.0 instead of folioorg/mod-orders:13.0.0", reason)
+	})
+
+	t.Run("TestGetContainerDrift_Env", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect()
+		inspect.Config.Env = []string{"DB_HOST=postgres", "JAVA_OPTIONS=-Xmx256m"}
+
+		// Act
+		reason := getContainerDrift("eureka-combined-mod-orders", desired, inspect, 0)
+
+		// Assert
+		assert.Equal(t, "container eureka-combined-mod-orders has a different JAVA_OPTIONS environment variable", reason)
+	})
+
+	t.Run("TestGetContainerDrift_Ports", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect()
+		inspect.HostConfig.PortBindings = nat.PortMap{"8082/tcp": {{HostPort: "30101"}}, "5005/tcp": {{HostPort: "30102"}}}
+
+		// Act
+		reason := getContainerDrift("eureka-combined-mod-orders", desired, inspect, 0)
+
+		// Assert
+		assert.Equal(t, "container eureka-combined-mod-orders publishes ports [5005/tcp 8082/tcp] instead of [5005/tcp 8081/tcp]", reason)
+	})
+
+	t.Run("TestGetContainerDrift_FixedHostPort", func(t *testing.T) {
+		// Act
+		reason := getContainerDrift("eureka-combined-mod-orders", desired, newInspect(), 30001)
+
+		// Assert
+		assert.Equal(t, "container eureka-combined-mod-orders does not publish host port 30001", reason)
+	})
+
+	t.Run("TestGetContainerDrift_Resources", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect()
+		inspect.HostConfig.Resources.Memory = 1024
+
+		// Act
+		reason := getContainerDrift("eureka-combined-mod-orders", desired, inspect, 0)
+
+		// Assert
+		assert.Equal(t, "container eureka-combined-mod-orders has different resources", reason)
+	})
+}
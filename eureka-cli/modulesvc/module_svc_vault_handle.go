@@ -15,6 +15,20 @@ import (
 // ModuleVaultHandler defines the interface for module Vault operations
 type ModuleVaultHandler interface {
 	GetVaultRootToken(client *client.Client) (string, error)
+	GetVaultContainerID(client *client.Client) (string, error)
+}
+
+// GetVaultContainerID returns the id of the Vault container, the id changes together with the root token when Vault is redeployed
+func (ms *ModuleSvc) GetVaultContainerID(client *client.Client) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	inspect, err := client.ContainerInspect(ctx, constant.VaultContainer)
+	if err != nil {
+		return "", err
+	}
+
+	return inspect.ID, nil
 }
 
 func (ms *ModuleSvc) GetVaultRootToken(client *client.Client) (string, error) {
@@ -0,0 +1,298 @@
+package portproxysvc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// PortProxyProcessor defines the interface for port proxy operations
+type PortProxyProcessor interface {
+	PortProxyForwarder
+	PortProxyManager
+}
+
+// PortProxyForwarder defines the interface for running a port proxy in the current process
+type PortProxyForwarder interface {
+	Forward(ctx context.Context, proxy *models.PortProxy) error
+}
+
+// PortProxyManager defines the interface for starting, stopping and listing background port proxies
+type PortProxyManager interface {
+	StartDetached(proxy *models.PortProxy, args []string) (*models.PortProxy, error)
+	Stop(name string) (*models.PortProxy, error)
+	ListPortProxies() ([]models.PortProxy, error)
+}
+
+// PortProxySvc provides functionality for forwarding TCP traffic from a sidecar hostname on the host network
+// to a sidecar deployed as a container, it replaces platform-specific tools like netsh portproxy
+type PortProxySvc struct {
+	Action *action.Action
+}
+
+// New creates a new PortProxySvc instance
+func New(action *action.Action) *PortProxySvc {
+	return &PortProxySvc{Action: action}
+}
+
+// Forward accepts connections on the listen address and forwards them to the target address until the context is cancelled,
+// the pid file of the proxy is kept for as long as the listener is open
+func (ps *PortProxySvc) Forward(ctx context.Context, proxy *models.PortProxy) error {
+	if err := ps.checkNotRunning(proxy.Name); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", proxy.ListenAddress)
+	if err != nil {
+		return appErrors.PortProxyListenFailed(proxy.ListenAddress, err)
+	}
+	defer func() {
+		_ = listener.Close()
+	}()
+
+	proxy.PID, proxy.StartedAt = os.Getpid(), time.Now().UTC()
+	pidFile, err := ps.writePidFile(proxy)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(pidFile)
+	}()
+	slog.Info(ps.Action.Name, "text", "Started port proxy", "from", proxy.ListenAddress, "to", proxy.TargetAddress, "pid", proxy.PID)
+
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				slog.Info(ps.Action.Name, "text", "Stopped port proxy", "from", proxy.ListenAddress)
+				return nil
+			}
+			return err
+		}
+		go ps.forwardConnection(conn, proxy.TargetAddress)
+	}
+}
+
+func (ps *PortProxySvc) forwardConnection(conn net.Conn, targetAddress string) {
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	target, err := net.DialTimeout("tcp", targetAddress, constant.PortProxyDialTimeout)
+	if err != nil {
+		slog.Warn(ps.Action.Name, "text", "Failed to connect to port proxy target", "from", conn.RemoteAddr(), "to", targetAddress, "error", err)
+		return
+	}
+	defer func() {
+		_ = target.Close()
+	}()
+	slog.Debug(ps.Action.Name, "text", "Forwarding connection", "from", conn.RemoteAddr(), "to", targetAddress)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go copyConnection(&wg, target, conn)
+	go copyConnection(&wg, conn, target)
+	wg.Wait()
+}
+
+// copyConnection copies src to dst and half-closes dst, so the peer sees the end of the stream
+// while the opposite direction may still be transferring
+func copyConnection(wg *sync.WaitGroup, dst net.Conn, src net.Conn) {
+	defer wg.Done()
+	_, _ = io.Copy(dst, src)
+	if tcpConn, ok := dst.(*net.TCPConn); ok {
+		_ = tcpConn.CloseWrite()
+		return
+	}
+	_ = dst.Close()
+}
+
+// StartDetached starts the current executable with the given arguments as a background process running the port proxy
+// in the foreground and waits until the process has written its pid file
+func (ps *PortProxySvc) StartDetached(proxy *models.PortProxy, args []string) (*models.PortProxy, error) {
+	if err := ps.checkNotRunning(proxy.Name); err != nil {
+		return nil, err
+	}
+
+	proxiesDir, err := helpers.GetHomePortProxiesDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(proxiesDir, 0755); err != nil {
+		return nil, err
+	}
+	logFile := filepath.Join(proxiesDir, fmt.Sprintf("%s.log", proxy.Name))
+	output, err := os.Create(logFile)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = output.Close()
+	}()
+
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(executable, args...)
+	cmd.Stdout, cmd.Stderr = output, output
+	cmd.SysProcAttr = getDetachedProcessAttr()
+	if err := cmd.Start(); err != nil {
+		return nil, appErrors.PortProxyStartFailed(proxy.Name, logFile, err)
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+	deadline := time.After(constant.PortProxyStartTimeout)
+	for {
+		select {
+		case err := <-exited:
+			return nil, appErrors.PortProxyStartFailed(proxy.Name, logFile, err)
+		case <-deadline:
+			_ = cmd.Process.Kill()
+			return nil, appErrors.PortProxyStartTimeout(proxy.Name, logFile)
+		case <-time.After(constant.PortProxyPollInterval):
+			started, err := ps.readPidFile(proxy.Name)
+			if err == nil && started.PID == cmd.Process.Pid {
+				started.LogFile, started.Running = logFile, true
+				_ = cmd.Process.Release()
+				return started, nil
+			}
+		}
+	}
+}
+
+// Stop terminates the process of a running port proxy and removes its pid file
+func (ps *PortProxySvc) Stop(name string) (*models.PortProxy, error) {
+	proxy, err := ps.readPidFile(name)
+	if err != nil {
+		return nil, appErrors.PortProxyNotFound(name)
+	}
+
+	if isProcessRunning(proxy.PID) {
+		if err := terminateProcess(proxy.PID); err != nil {
+			return nil, err
+		}
+		slog.Info(ps.Action.Name, "text", "Stopped port proxy process", "name", name, "pid", proxy.PID)
+	}
+	if err := ps.removePidFile(name); err != nil {
+		return nil, err
+	}
+
+	return proxy, nil
+}
+
+// ListPortProxies returns the port proxies recorded in pid files, a proxy whose process is gone is reported as not running
+func (ps *PortProxySvc) ListPortProxies() ([]models.PortProxy, error) {
+	proxiesDir, err := helpers.GetHomePortProxiesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(proxiesDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	var proxies []models.PortProxy
+	for _, entry := range entries {
+		name, found := strings.CutSuffix(entry.Name(), constant.PortProxyPidFileExtension)
+		if !found || entry.IsDir() {
+			continue
+		}
+		proxy, err := ps.readPidFile(name)
+		if err != nil {
+			slog.Warn(ps.Action.Name, "text", "Skipping unreadable port proxy pid file", "name", entry.Name(), "error", err)
+			continue
+		}
+		proxy.Running = isProcessRunning(proxy.PID)
+		proxies = append(proxies, *proxy)
+	}
+	slices.SortFunc(proxies, func(a, b models.PortProxy) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return proxies, nil
+}
+
+func (ps *PortProxySvc) checkNotRunning(name string) error {
+	proxy, err := ps.readPidFile(name)
+	if err != nil {
+		return nil
+	}
+	if isProcessRunning(proxy.PID) {
+		return appErrors.PortProxyAlreadyRunning(name, proxy.PID)
+	}
+	slog.Debug(ps.Action.Name, "text", "Removing stale port proxy pid file", "name", name, "pid", proxy.PID)
+
+	return ps.removePidFile(name)
+}
+
+func (ps *PortProxySvc) getPidFilePath(name string) (string, error) {
+	proxiesDir, err := helpers.GetHomePortProxiesDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(proxiesDir, name+constant.PortProxyPidFileExtension), nil
+}
+
+func (ps *PortProxySvc) readPidFile(name string) (*models.PortProxy, error) {
+	pidFile, err := ps.getPidFilePath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var proxy models.PortProxy
+	if err := helpers.ReadJSONFromFile(pidFile, &proxy); err != nil {
+		return nil, err
+	}
+
+	return &proxy, nil
+}
+
+func (ps *PortProxySvc) writePidFile(proxy *models.PortProxy) (string, error) {
+	pidFile, err := ps.getPidFilePath(proxy.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(pidFile), 0755); err != nil {
+		return "", err
+	}
+
+	return pidFile, helpers.WriteJSONToFile(pidFile, proxy)
+}
+
+func (ps *PortProxySvc) removePidFile(name string) error {
+	pidFile, err := ps.getPidFilePath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(pidFile); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
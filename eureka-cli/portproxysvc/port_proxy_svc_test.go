@@ -0,0 +1,158 @@
+package portproxysvc_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/portproxysvc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPortProxySvc(t *testing.T) *portproxysvc.PortProxySvc {
+	t.Setenv("HOME", t.TempDir())
+	return portproxysvc.New(&action.Action{Name: "test-action"})
+}
+
+func writeTestPidFile(t *testing.T, proxy models.PortProxy) string {
+	proxiesDir, err := helpers.GetHomePortProxiesDir()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(proxiesDir, 0755))
+
+	pidFile := filepath.Join(proxiesDir, proxy.Name+constant.PortProxyPidFileExtension)
+	require.NoError(t, helpers.WriteJSONToFile(pidFile, proxy))
+
+	return pidFile
+}
+
+func startEchoServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() { _ = conn.Close() }()
+				line, _ := bufio.NewReader(conn).ReadString('\n')
+				_, _ = conn.Write([]byte("echo: " + line))
+			}()
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func getFreeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	return listener.Addr().String()
+}
+
+func TestForward_ForwardsConnections(t *testing.T) {
+	// Arrange
+	svc := newTestPortProxySvc(t)
+	proxy := &models.PortProxy{Name: "mod-orders-sc", ListenAddress: getFreeAddress(t), TargetAddress: startEchoServer(t)}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	// Act
+	go func() { done <- svc.Forward(ctx, proxy) }()
+	var proxies []models.PortProxy
+	require.Eventually(t, func() bool {
+		proxies, _ = svc.ListPortProxies()
+		return len(proxies) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	conn, err := net.Dial("tcp", proxy.ListenAddress)
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("ping\n"))
+	require.NoError(t, err)
+	response, err := bufio.NewReader(conn).ReadString('\n')
+	_ = conn.Close()
+	cancel()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "echo: ping\n", response)
+	assert.Equal(t, os.Getpid(), proxies[0].PID)
+	assert.True(t, proxies[0].Running)
+	assert.NoError(t, <-done)
+	proxies, err = svc.ListPortProxies()
+	assert.NoError(t, err)
+	assert.Empty(t, proxies)
+}
+
+func TestForward_AlreadyRunning(t *testing.T) {
+	// Arrange
+	svc := newTestPortProxySvc(t)
+	writeTestPidFile(t, models.PortProxy{Name: "mod-orders-sc", PID: os.Getpid()})
+
+	// Act
+	err := svc.Forward(context.Background(), &models.PortProxy{Name: "mod-orders-sc", ListenAddress: getFreeAddress(t)})
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrInvalidInput)
+}
+
+func TestStop(t *testing.T) {
+	t.Run("TestStop_StalePidFile", func(t *testing.T) {
+		// Arrange
+		svc := newTestPortProxySvc(t)
+		pidFile := writeTestPidFile(t, models.PortProxy{Name: "mod-orders-sc", ListenAddress: "mod-orders-sc.eureka:8081", PID: -1})
+
+		// Act
+		proxy, err := svc.Stop("mod-orders-sc")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "mod-orders-sc.eureka:8081", proxy.ListenAddress)
+		assert.NoFileExists(t, pidFile)
+	})
+
+	t.Run("TestStop_NotFound", func(t *testing.T) {
+		// Arrange
+		svc := newTestPortProxySvc(t)
+
+		// Act
+		proxy, err := svc.Stop("mod-orders-sc")
+
+		// Assert
+		assert.Nil(t, proxy)
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+}
+
+func TestListPortProxies(t *testing.T) {
+	// Arrange
+	svc := newTestPortProxySvc(t)
+	writeTestPidFile(t, models.PortProxy{Name: "mod-users-sc", PID: -1})
+	writeTestPidFile(t, models.PortProxy{Name: "mod-orders-sc", PID: os.Getpid()})
+
+	// Act
+	proxies, err := svc.ListPortProxies()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, proxies, 2)
+	assert.Equal(t, "mod-orders-sc", proxies[0].Name)
+	assert.True(t, proxies[0].Running)
+	assert.Equal(t, "mod-users-sc", proxies[1].Name)
+	assert.False(t, proxies[1].Running)
+}
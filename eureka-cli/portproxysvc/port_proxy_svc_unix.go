@@ -0,0 +1,34 @@
+//go:build !windows
+
+package portproxysvc
+
+import (
+	"os"
+	"syscall"
+)
+
+// getDetachedProcessAttr starts the process in a new session, so it is not stopped together with the terminal
+func getDetachedProcessAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
+
+func isProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+
+	return process.Signal(syscall.Signal(0)) == nil
+}
+
+func terminateProcess(pid int) error {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	return process.Signal(syscall.SIGTERM)
+}
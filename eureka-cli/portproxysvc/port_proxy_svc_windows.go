@@ -0,0 +1,45 @@
+//go:build windows
+
+package portproxysvc
+
+import (
+	"os"
+	"syscall"
+)
+
+const (
+	createNewProcessGroup = 0x00000200
+	detachedProcess       = 0x00000008
+)
+
+// getDetachedProcessAttr starts the process without a console in a new process group, so it outlives the terminal
+func getDetachedProcessAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{CreationFlags: createNewProcessGroup | detachedProcess, HideWindow: true}
+}
+
+func isProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	handle, err := syscall.OpenProcess(syscall.PROCESS_QUERY_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = syscall.CloseHandle(handle)
+	}()
+
+	var exitCode uint32
+	const stillActive = 259
+
+	return syscall.GetExitCodeProcess(handle, &exitCode) == nil && exitCode == stillActive
+}
+
+func terminateProcess(pid int) error {
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+
+	return process.Kill()
+}
@@ -19,8 +19,10 @@ import (
 type RegistryProcessor interface {
 	GetNamespace(version string) string
 	GetModules(verbose bool) (*models.ProxyModulesByRegistry, error)
+	GetLockedModules(lock *models.Lock) *models.ProxyModulesByRegistry
 	ExtractModuleMetadata(modules *models.ProxyModulesByRegistry)
 	GetAuthorizationToken() (string, error)
+	SnapshotRegistry(snapshotName string) (*models.RegistrySnapshot, error)
 }
 
 // RegistrySvc provides functionality for interacting with module registries
@@ -79,7 +81,27 @@ func (rs *RegistrySvc) GetModules(verbose bool) (*models.ProxyModulesByRegistry,
 	if err != nil {
 		return nil, err
 	}
+	modules := splitModulesByRegistry(allModules)
+	if verbose {
+		slog.Info(rs.Action.Name, "text", "LSP modules split", "folio", len(modules.FolioModules), "eureka", len(modules.EurekaModules))
+	}
+
+	return modules, nil
+}
 
+// GetLockedModules returns the modules pinned in a lock file instead of resolving them from the LSP/FAR registries
+func (rs *RegistrySvc) GetLockedModules(lock *models.Lock) *models.ProxyModulesByRegistry {
+	var allModules []models.ApplicationModule
+	for _, module := range lock.Modules {
+		allModules = append(allModules, models.ApplicationModule{ID: module.ID, Name: module.Name, Version: module.Version})
+	}
+	modules := splitModulesByRegistry(allModules)
+	slog.Info(rs.Action.Name, "text", "Locked modules split", "folio", len(modules.FolioModules), "eureka", len(modules.EurekaModules))
+
+	return modules
+}
+
+func splitModulesByRegistry(allModules []models.ApplicationModule) *models.ProxyModulesByRegistry {
 	var folioModules, eurekaModules []*models.ProxyModule
 	for _, m := range allModules {
 		proxy := &models.ProxyModule{
@@ -93,26 +115,19 @@ func (rs *RegistrySvc) GetModules(verbose bool) (*models.ProxyModulesByRegistry,
 		}
 	}
 
-	if verbose {
-		slog.Info(rs.Action.Name, "text", "LSP modules split", "folio", len(folioModules), "eureka", len(eurekaModules))
-	}
-
 	return &models.ProxyModulesByRegistry{
 		FolioModules:  folioModules,
 		EurekaModules: eurekaModules,
-	}, nil
+	}
 }
 
 func (rs *RegistrySvc) getFlattenedModuleVersions() ([]models.ApplicationModule, error) {
 	var descriptor models.PlatformDescriptor
-	if err := rs.HTTPClient.GetRetryReturnStruct(rs.Action.ConfigLspURL, map[string]string{}, &descriptor); err != nil {
+	if err := rs.getPlatformDescriptor(&descriptor); err != nil {
 		return nil, err
 	}
 
-	slog.Info(rs.Action.Name, "text", "Fetched LSP platform descriptor", "name", descriptor.Name, "version", descriptor.Version)
-
-	applications := append(descriptor.Applications.Required, descriptor.Applications.Optional...)
-	applications = append(applications, descriptor.Applications.Experimental...)
+	slog.Info(rs.Action.Name, "text", "Fetched LSP platform descriptor", "name", descriptor.Name, "version", descriptor.Version, "offline", rs.Action.IsOffline())
 
 	var modules []models.ApplicationModule
 	for _, component := range descriptor.EurekaComponents {
@@ -129,6 +144,7 @@ func (rs *RegistrySvc) getFlattenedModuleVersions() ([]models.ApplicationModule,
 		appID   string
 	}
 
+	applications := getPlatformApplications(descriptor)
 	results := make([]result, len(applications))
 	var wg sync.WaitGroup
 	wg.Add(len(applications))
@@ -138,32 +154,15 @@ func (rs *RegistrySvc) getFlattenedModuleVersions() ([]models.ApplicationModule,
 		go func(innerIdx int, innerApp models.PlatformApplication) {
 			defer wg.Done()
 			appID := fmt.Sprintf("%s-%s", innerApp.Name, innerApp.Version)
-			farURL := fmt.Sprintf("%s/applications?query=id==%s", rs.Action.ConfigFarURL, appID)
 
 			var response models.ApplicationsResponse
-			if err := rs.HTTPClient.GetRetryReturnStruct(farURL, map[string]string{}, &response); err != nil {
+			if err := rs.getApplicationDescriptors(appID, &response); err != nil {
 				results[innerIdx] = result{appID: appID, err: err}
 				return
 			}
 			slog.Info(rs.Action.Name, "text", "Fetched FAR application descriptor", "appId", appID)
 
-			var appModules []models.ApplicationModule
-			for _, appDescriptor := range response.ApplicationDescriptors {
-				for _, key := range []string{"modules", "uiModules"} {
-					for _, raw := range helpers.GetAnySlice(appDescriptor, key) {
-						entry, ok := raw.(map[string]any)
-						if !ok {
-							continue
-						}
-						appModules = append(appModules, models.ApplicationModule{
-							ID:      helpers.GetString(entry, "id"),
-							Name:    helpers.GetString(entry, "name"),
-							Version: helpers.GetString(entry, "version"),
-						})
-					}
-				}
-			}
-			results[innerIdx] = result{appID: appID, modules: appModules}
+			results[innerIdx] = result{appID: appID, modules: getApplicationModules(response)}
 		}(idx, app)
 	}
 	wg.Wait()
@@ -178,6 +177,62 @@ func (rs *RegistrySvc) getFlattenedModuleVersions() ([]models.ApplicationModule,
 	return modules, nil
 }
 
+func (rs *RegistrySvc) getPlatformDescriptor(descriptor any) error {
+	if rs.Action.IsOffline() {
+		return readSnapshotEntry(rs.Action.GetSnapshotPlatformDescriptorPath(), descriptor)
+	}
+
+	return rs.HTTPClient.GetRetryReturnStruct(rs.Action.ConfigLspURL, map[string]string{}, descriptor)
+}
+
+func (rs *RegistrySvc) getApplicationDescriptors(appID string, response any) error {
+	if rs.Action.IsOffline() {
+		return readSnapshotEntry(rs.Action.GetSnapshotApplicationPath(appID), response)
+	}
+
+	return rs.fetchApplicationDescriptors(appID, response)
+}
+
+func (rs *RegistrySvc) fetchApplicationDescriptors(appID string, response any) error {
+	farURL := fmt.Sprintf("%s/applications?query=id==%s", rs.Action.ConfigFarURL, appID)
+
+	return rs.HTTPClient.GetRetryReturnStruct(farURL, map[string]string{}, response)
+}
+
+func readSnapshotEntry(entryPath string, data any) error {
+	if err := helpers.ReadJSONFromFile(entryPath, data); err != nil {
+		return appErrors.RegistrySnapshotEntryNotFound(entryPath, err)
+	}
+
+	return nil
+}
+
+func getPlatformApplications(descriptor models.PlatformDescriptor) []models.PlatformApplication {
+	applications := append(descriptor.Applications.Required, descriptor.Applications.Optional...)
+	return append(applications, descriptor.Applications.Experimental...)
+}
+
+func getApplicationModules(response models.ApplicationsResponse) []models.ApplicationModule {
+	var appModules []models.ApplicationModule
+	for _, appDescriptor := range response.ApplicationDescriptors {
+		for _, key := range []string{"modules", "uiModules"} {
+			for _, raw := range helpers.GetAnySlice(appDescriptor, key) {
+				entry, ok := raw.(map[string]any)
+				if !ok {
+					continue
+				}
+				appModules = append(appModules, models.ApplicationModule{
+					ID:      helpers.GetString(entry, "id"),
+					Name:    helpers.GetString(entry, "name"),
+					Version: helpers.GetString(entry, "version"),
+				})
+			}
+		}
+	}
+
+	return appModules
+}
+
 func isEurekaModule(name string) bool {
 	return strings.HasSuffix(name, "-keycloak") ||
 		strings.HasPrefix(name, "mgr-") ||
@@ -0,0 +1,152 @@
+package registrysvc
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// SnapshotRegistry fetches the LSP platform descriptor, its FAR application descriptors and the module descriptors
+// of every application module and writes them into a snapshot directory usable by the offline mode,
+// module descriptors missing from the registry are recorded in the manifest instead of failing the snapshot
+func (rs *RegistrySvc) SnapshotRegistry(snapshotName string) (*models.RegistrySnapshot, error) {
+	slog.Info(rs.Action.Name, "text", "FETCHING LSP PLATFORM DESCRIPTOR", "url", rs.Action.ConfigLspURL)
+	var descriptor models.PlatformDescriptor
+	if err := rs.HTTPClient.GetRetryReturnStruct(rs.Action.ConfigLspURL, map[string]string{}, &descriptor); err != nil {
+		return nil, err
+	}
+
+	snapshot := &models.RegistrySnapshot{
+		Name:            getSnapshotName(snapshotName, descriptor),
+		CreatedAt:       time.Now().UTC(),
+		LspURL:          rs.Action.ConfigLspURL,
+		FarURL:          rs.Action.ConfigFarURL,
+		RegistryURL:     rs.Action.ConfigRegistryURL,
+		Platform:        descriptor.Name,
+		PlatformVersion: descriptor.Version,
+	}
+	snapshotDir, err := rs.createSnapshotDir(snapshot.Name)
+	if err != nil {
+		return nil, err
+	}
+	rs.Action.RegistrySnapshotDir = snapshotDir
+	if err := helpers.WriteJSONToFile(rs.Action.GetSnapshotPlatformDescriptorPath(), descriptor); err != nil {
+		return nil, err
+	}
+
+	slog.Info(rs.Action.Name, "text", "FETCHING FAR APPLICATION DESCRIPTORS", "snapshot", snapshot.Name)
+	var moduleIDs []string
+	for _, component := range descriptor.EurekaComponents {
+		moduleIDs = append(moduleIDs, fmt.Sprintf("%s-%s", component.Name, component.Version))
+	}
+	applications := getPlatformApplications(descriptor)
+	appModules := make([][]models.ApplicationModule, len(applications))
+	err = helpers.RunParallel(len(applications), constant.DefaultParallelism, func(idx int) error {
+		appID := fmt.Sprintf("%s-%s", applications[idx].Name, applications[idx].Version)
+		var response models.ApplicationsResponse
+		if err := rs.fetchApplicationDescriptors(appID, &response); err != nil {
+			return appErrors.FARFetchFailed(appID, err)
+		}
+		if err := helpers.WriteJSONToFile(rs.Action.GetSnapshotApplicationPath(appID), response); err != nil {
+			return err
+		}
+		appModules[idx] = getApplicationModules(response)
+		slog.Info(rs.Action.Name, "text", "Saved FAR application descriptor", "appId", appID)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for idx, app := range applications {
+		snapshot.Applications = append(snapshot.Applications, fmt.Sprintf("%s-%s", app.Name, app.Version))
+		for _, module := range appModules[idx] {
+			moduleIDs = append(moduleIDs, module.ID)
+		}
+	}
+	slices.Sort(moduleIDs)
+	moduleIDs = slices.Compact(moduleIDs)
+
+	slog.Info(rs.Action.Name, "text", "FETCHING MODULE DESCRIPTORS", "count", len(moduleIDs))
+	missing := make([]bool, len(moduleIDs))
+	err = helpers.RunParallel(len(moduleIDs), constant.DefaultParallelism, func(idx int) error {
+		var moduleDescriptor map[string]any
+		if err := rs.HTTPClient.GetReturnStruct(rs.Action.GetModuleURL(moduleIDs[idx]), map[string]string{}, &moduleDescriptor); err != nil {
+			slog.Warn(rs.Action.Name, "text", "Module descriptor is unavailable in the registry", "module", moduleIDs[idx], "error", err)
+			missing[idx] = true
+			return nil
+		}
+
+		return helpers.WriteJSONToFile(rs.Action.GetSnapshotModuleDescriptorPath(moduleIDs[idx]), moduleDescriptor)
+	})
+	if err != nil {
+		return nil, err
+	}
+	for idx, moduleID := range moduleIDs {
+		if missing[idx] {
+			snapshot.MissingModules = append(snapshot.MissingModules, moduleID)
+		} else {
+			snapshot.Modules++
+		}
+	}
+
+	// The manifest is written last so that an interrupted snapshot is never picked up by the offline mode
+	if err := helpers.WriteJSONToFile(filepath.Join(snapshotDir, constant.RegistrySnapshotManifestFile), snapshot); err != nil {
+		return nil, err
+	}
+	slog.Info(rs.Action.Name, "text", "Saved registry snapshot", "name", snapshot.Name, "dir", snapshotDir,
+		"applications", len(snapshot.Applications), "modules", snapshot.Modules, "missingModules", len(snapshot.MissingModules))
+
+	return snapshot, nil
+}
+
+var snapshotNameRegexp = regexp.MustCompile(constant.EnvironmentSnapshotNamePattern)
+
+func getSnapshotName(snapshotName string, descriptor models.PlatformDescriptor) string {
+	if snapshotName != "" {
+		return snapshotName
+	}
+	if descriptor.Name == "" || descriptor.Version == "" {
+		return time.Now().UTC().Format("20060102-150405")
+	}
+
+	return strings.NewReplacer("/", "-", "\\", "-", " ", "-").Replace(fmt.Sprintf("%s-%s", descriptor.Name, descriptor.Version))
+}
+
+// createSnapshotDir creates the directory of a new snapshot, an existing snapshot is only replaced with the overwrite flag
+// while the leftovers of a snapshot that failed before its manifest was written are always removed
+func (rs *RegistrySvc) createSnapshotDir(snapshotName string) (string, error) {
+	if !snapshotNameRegexp.MatchString(snapshotName) {
+		return "", appErrors.EnvironmentSnapshotNameInvalid(snapshotName)
+	}
+	snapshotsDir, err := helpers.GetHomeRegistrySnapshotsDir()
+	if err != nil {
+		return "", err
+	}
+
+	snapshotDir := filepath.Join(snapshotsDir, snapshotName)
+	_, err = os.Stat(filepath.Join(snapshotDir, constant.RegistrySnapshotManifestFile))
+	if err == nil && (rs.Action.Param == nil || !rs.Action.Param.Overwrite) {
+		return "", appErrors.RegistrySnapshotAlreadyExists(snapshotName)
+	}
+	if err := os.RemoveAll(snapshotDir); err != nil {
+		return "", err
+	}
+	for _, dir := range []string{constant.RegistrySnapshotFarDir, constant.RegistrySnapshotModulesDir} {
+		if err := os.MkdirAll(filepath.Join(snapshotDir, dir), 0755); err != nil {
+			return "", err
+		}
+	}
+
+	return snapshotDir, nil
+}
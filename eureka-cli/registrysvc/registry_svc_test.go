@@ -2,9 +2,14 @@ package registrysvc_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
 	"github.com/folio-org/eureka-setup/eureka-cli/models"
 	"github.com/folio-org/eureka-setup/eureka-cli/registrysvc"
@@ -808,3 +813,198 @@ func TestGetSidecarName_MultipleEdgeModules(t *testing.T) {
 		assert.Equal(t, "mod-users-sc", modules.FolioModules[2].Metadata.SidecarName)
 	})
 }
+
+// ==================== Registry Snapshot Tests ====================
+
+func TestSnapshotRegistry_Success(t *testing.T) {
+	// Arrange
+	t.Setenv("HOME", t.TempDir())
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+	act.ConfigFarURL = "http://far.example.com"
+	act.ConfigRegistryURL = "http://registry.example.com"
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	descriptor := buildLSPResponse(
+		[]models.PlatformApplication{{Name: "app-core", Version: "1.0.0"}},
+		nil, nil,
+		[]models.PlatformApplication{{Name: "mgr-tenants", Version: "3.0.0"}},
+	)
+	descriptor.Name, descriptor.Version = "folio-lsp", "R1-2025"
+	stubLSP(mockHTTP, act.ConfigLspURL, descriptor)
+	stubFAR(mockHTTP, act.ConfigFarURL, "app-core", "1.0.0", []any{
+		map[string]any{"id": "mod-users-2.0.0", "name": "mod-users", "version": "2.0.0"},
+		map[string]any{"id": "mod-missing-1.0.0", "name": "mod-missing", "version": "1.0.0"},
+	})
+	for _, moduleID := range []string{"mgr-tenants-3.0.0", "mod-users-2.0.0"} {
+		mockHTTP.On("GetReturnStruct", act.GetModuleURL(moduleID), mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				*args.Get(2).(*map[string]any) = map[string]any{"id": moduleID}
+			}).Return(nil)
+	}
+	mockHTTP.On("GetReturnStruct", act.GetModuleURL("mod-missing-1.0.0"), mock.Anything, mock.Anything).
+		Return(errors.New("404 Not Found"))
+
+	// Act
+	snapshot, err := svc.SnapshotRegistry("")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "folio-lsp-R1-2025", snapshot.Name)
+	assert.Equal(t, []string{"app-core-1.0.0"}, snapshot.Applications)
+	assert.Equal(t, 2, snapshot.Modules)
+	assert.Equal(t, []string{"mod-missing-1.0.0"}, snapshot.MissingModules)
+	assert.FileExists(t, filepath.Join(act.RegistrySnapshotDir, constant.RegistrySnapshotManifestFile))
+	assert.FileExists(t, act.GetSnapshotApplicationPath("app-core-1.0.0"))
+	assert.FileExists(t, act.GetSnapshotModuleDescriptorPath("mod-users-2.0.0"))
+	assert.NoFileExists(t, act.GetSnapshotModuleDescriptorPath("mod-missing-1.0.0"))
+	mockHTTP.AssertExpectations(t)
+}
+
+func TestSnapshotRegistry_FARFetchError(t *testing.T) {
+	// Arrange
+	t.Setenv("HOME", t.TempDir())
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+	act.ConfigFarURL = "http://far.example.com"
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	stubLSP(mockHTTP, act.ConfigLspURL, buildLSPResponse([]models.PlatformApplication{{Name: "app-core", Version: "1.0.0"}}, nil, nil, nil))
+	mockHTTP.On("GetRetryReturnStruct", act.ConfigFarURL+"/applications?query=id==app-core-1.0.0", mock.Anything, mock.Anything).
+		Return(errors.New("FAR timeout"))
+
+	// Act
+	snapshot, err := svc.SnapshotRegistry("broken")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, snapshot)
+	assert.NoFileExists(t, filepath.Join(act.RegistrySnapshotDir, constant.RegistrySnapshotManifestFile))
+}
+
+func TestSnapshotRegistry_InvalidName(t *testing.T) {
+	for _, snapshotName := range []string{"..", "../..", "nested/name", ".hidden"} {
+		t.Run("TestSnapshotRegistry_InvalidName_"+snapshotName, func(t *testing.T) {
+			// Arrange
+			home := t.TempDir()
+			t.Setenv("HOME", home)
+			mockHTTP := &testhelpers.MockHTTPClient{}
+			act := testhelpers.NewMockAction()
+			act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+			svc := registrysvc.New(act, mockHTTP, &MockAWSSvc{})
+			stubLSP(mockHTTP, act.ConfigLspURL, buildLSPResponse(nil, nil, nil, nil))
+			sentinel := filepath.Join(home, ".eureka", "keep.txt")
+			assert.NoError(t, os.MkdirAll(filepath.Dir(sentinel), 0755))
+			assert.NoError(t, os.WriteFile(sentinel, []byte("keep"), 0644))
+
+			// Act
+			snapshot, err := svc.SnapshotRegistry(snapshotName)
+
+			// Assert
+			assert.ErrorIs(t, err, appErrors.ErrInvalidInput)
+			assert.Nil(t, snapshot)
+			assert.FileExists(t, sentinel)
+		})
+	}
+}
+
+func TestSnapshotRegistry_ExistingSnapshot(t *testing.T) {
+	newSvc := func(overwrite bool) (*registrysvc.RegistrySvc, *action.Action) {
+		mockHTTP := &testhelpers.MockHTTPClient{}
+		act := testhelpers.NewMockAction()
+		act.ConfigLspURL = "http://lsp.example.com/descriptor.json"
+		act.Param = &action.Param{Overwrite: overwrite}
+		stubLSP(mockHTTP, act.ConfigLspURL, buildLSPResponse(nil, nil, nil, nil))
+
+		return registrysvc.New(act, mockHTTP, &MockAWSSvc{}), act
+	}
+
+	t.Run("TestSnapshotRegistry_ExistingSnapshot_Refused", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		svc, _ := newSvc(false)
+		_, err := svc.SnapshotRegistry("R1-2025")
+		assert.NoError(t, err)
+
+		// Act
+		snapshot, err := svc.SnapshotRegistry("R1-2025")
+
+		// Assert
+		assert.ErrorIs(t, err, appErrors.ErrInvalidInput)
+		assert.Contains(t, err.Error(), "--overwrite")
+		assert.Nil(t, snapshot)
+	})
+
+	t.Run("TestSnapshotRegistry_ExistingSnapshot_Overwritten", func(t *testing.T) {
+		// Arrange
+		t.Setenv("HOME", t.TempDir())
+		svc, act := newSvc(true)
+		_, err := svc.SnapshotRegistry("R1-2025")
+		assert.NoError(t, err)
+
+		// Act
+		snapshot, err := svc.SnapshotRegistry("R1-2025")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "R1-2025", snapshot.Name)
+		assert.FileExists(t, filepath.Join(act.RegistrySnapshotDir, constant.RegistrySnapshotManifestFile))
+	})
+}
+
+func TestGetModules_Offline(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.Param.Offline = true
+	act.RegistrySnapshotDir = t.TempDir()
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	assert.NoError(t, os.MkdirAll(filepath.Join(act.RegistrySnapshotDir, constant.RegistrySnapshotFarDir), 0755))
+	descriptor := buildLSPResponse(
+		[]models.PlatformApplication{{Name: "app-core", Version: "1.0.0"}},
+		nil, nil,
+		[]models.PlatformApplication{{Name: "mgr-tenants", Version: "3.0.0"}},
+	)
+	assert.NoError(t, helpers.WriteJSONToFile(act.GetSnapshotPlatformDescriptorPath(), descriptor))
+	assert.NoError(t, helpers.WriteJSONToFile(act.GetSnapshotApplicationPath("app-core-1.0.0"), models.ApplicationsResponse{
+		ApplicationDescriptors: []map[string]any{{"modules": []any{
+			map[string]any{"id": "mod-users-2.0.0", "name": "mod-users", "version": "2.0.0"},
+		}}},
+	}))
+
+	// Act
+	result, err := svc.GetModules(false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.FolioModules, 1)
+	assert.Len(t, result.EurekaModules, 1)
+	mockHTTP.AssertNotCalled(t, "GetRetryReturnStruct", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetModules_OfflineMissingApplication(t *testing.T) {
+	// Arrange
+	mockHTTP := &testhelpers.MockHTTPClient{}
+	mockAWS := &MockAWSSvc{}
+	act := testhelpers.NewMockAction()
+	act.ConfigRegistrySnapshot = "R1-2025"
+	act.RegistrySnapshotDir = t.TempDir()
+	svc := registrysvc.New(act, mockHTTP, mockAWS)
+
+	descriptor := buildLSPResponse([]models.PlatformApplication{{Name: "app-core", Version: "1.0.0"}}, nil, nil, nil)
+	assert.NoError(t, helpers.WriteJSONToFile(act.GetSnapshotPlatformDescriptorPath(), descriptor))
+
+	// Act
+	result, err := svc.GetModules(false)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, err.Error(), "app-core-1.0.0")
+}
@@ -2,9 +2,11 @@ package runconfig
 
 import (
 	"log/slog"
+	"os"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
 	"github.com/folio-org/eureka-setup/eureka-cli/awssvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/configsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/consortiumsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/dockerclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/errors"
@@ -12,20 +14,28 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/gitclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/interceptmodulesvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/journalsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/kafkaclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/kafkasvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/keycloaksvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/kongsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/locksvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/managementsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/moduleenv"
 	"github.com/folio-org/eureka-setup/eureka-cli/moduleprops"
 	"github.com/folio-org/eureka-setup/eureka-cli/modulesvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/portproxysvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/registrysvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/searchsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/snapshotsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/systemsvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/tenantsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/tokencachesvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/uisvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/upgrademodulesvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/usersvc"
 	"github.com/folio-org/eureka-setup/eureka-cli/vaultclient"
+	"github.com/folio-org/eureka-setup/eureka-cli/vaultsvc"
 )
 
 // RunConfig is a central container of all dependencies (services)
@@ -43,6 +53,7 @@ type Infrastructure struct {
 	HTTPClient   httpclient.HTTPClientRunner
 	DockerClient dockerclient.DockerClientRunner
 	VaultClient  vaultclient.VaultClientRunner
+	KafkaClient  kafkaclient.KafkaClientRunner
 }
 
 type Services struct {
@@ -62,6 +73,14 @@ type Services struct {
 	SearchSvc          searchsvc.SearchProcessor
 	InterceptModuleSvc interceptmodulesvc.InterceptModuleProcessor
 	UpgradeModuleSvc   upgrademodulesvc.UpgradeModuleProcessor
+	JournalSvc         journalsvc.JournalProcessor
+	LockSvc            locksvc.LockProcessor
+	PortProxySvc       portproxysvc.PortProxyProcessor
+	SystemSvc          systemsvc.SystemProcessor
+	ConfigSvc          configsvc.ConfigProcessor
+	VaultSvc           vaultsvc.VaultProcessor
+	SnapshotSvc        snapshotsvc.SnapshotProcessor
+	TokenCacheSvc      tokencachesvc.TokenCacheProcessor
 }
 
 func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
@@ -71,11 +90,11 @@ func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
 	if logger == nil {
 		return nil, errors.LoggerNil()
 	}
-	execSvc := execsvc.New(action)
+	tokenCacheSvc := tokencachesvc.New(action)
+	execSvc, httpClient, dockerClient := newRunners(action, logger, tokenCacheSvc)
 	gitclient := gitclient.New(action)
-	httpClient := httpclient.New(action, logger)
-	dockerClient := dockerclient.New(action, execSvc)
 	vaultClient := vaultclient.New(action, httpClient)
+	kafkaClient := kafkaclient.New(action)
 	awsSvc := awssvc.New(action)
 	registrySvc := registrysvc.New(action, httpClient, awsSvc)
 	moduleEnv := moduleenv.New(action)
@@ -84,6 +103,8 @@ func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
 	consortiumSvc := consortiumsvc.New(action, httpClient, userSvc)
 	tenantSvc := tenantsvc.New(action, consortiumSvc)
 	managementSvc := managementsvc.New(action, httpClient, tenantSvc)
+	kafkaSvc := kafkasvc.New(action, kafkaClient)
+	vaultSvc := vaultsvc.New(action, vaultClient)
 
 	return &RunConfig{
 		Infrastructure: &Infrastructure{
@@ -94,11 +115,12 @@ func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
 			HTTPClient:   httpClient,
 			DockerClient: dockerClient,
 			VaultClient:  vaultClient,
+			KafkaClient:  kafkaClient,
 		},
 		Services: &Services{
 			AWSSvc:             awsSvc,
 			KongSvc:            kongsvc.New(action, httpClient),
-			KafkaSvc:           kafkasvc.New(action, execSvc),
+			KafkaSvc:           kafkaSvc,
 			KeycloakSvc:        keycloaksvc.New(action, httpClient, vaultClient, managementSvc),
 			RegistrySvc:        registrySvc,
 			ModuleProps:        moduleprops.New(action),
@@ -112,6 +134,29 @@ func New(action *action.Action, logger *slog.Logger) (*RunConfig, error) {
 			SearchSvc:          searchsvc.New(action, httpClient),
 			InterceptModuleSvc: interceptmodulesvc.New(action, moduleSvc, managementSvc),
 			UpgradeModuleSvc:   upgrademodulesvc.New(action, execSvc, moduleSvc, managementSvc),
+			JournalSvc:         journalsvc.New(action),
+			LockSvc:            locksvc.New(action, registrySvc),
+			PortProxySvc:       portproxysvc.New(action),
+			SystemSvc:          systemsvc.New(action, httpClient, kafkaSvc),
+			ConfigSvc:          configsvc.New(action),
+			VaultSvc:           vaultSvc,
+			SnapshotSvc:        snapshotsvc.New(action, vaultSvc, kafkaSvc),
+			TokenCacheSvc:      tokenCacheSvc,
 		},
 	}, nil
 }
+
+// newRunners creates the command, HTTP and Docker runners, swapping them
+// for recording implementations that only print the planned operations in dry run mode,
+// the HTTP client retries a request rejected with a stale cached access token with a refreshed one
+func newRunners(action *action.Action, logger *slog.Logger, tokenRefresher httpclient.TokenRefresher) (execsvc.CommandRunner, httpclient.HTTPClientRunner, dockerclient.DockerClientRunner) {
+	httpClient := httpclient.New(action, logger)
+	httpClient.TokenRefresher = tokenRefresher
+	if !action.IsDryRun() {
+		execSvc := execsvc.New(action)
+		return execSvc, httpClient, dockerclient.New(action, execSvc)
+	}
+	execSvc := execsvc.NewDryRun(action, os.Stdout)
+
+	return execSvc, httpclient.NewDryRun(action, httpClient, os.Stdout), dockerclient.NewDryRun(action, execSvc, os.Stdout)
+}
@@ -6,6 +6,9 @@ import (
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/dockerclient"
+	"github.com/folio-org/eureka-setup/eureka-cli/execsvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
 	"github.com/folio-org/eureka-setup/eureka-cli/runconfig"
 	"github.com/stretchr/testify/assert"
 )
@@ -46,6 +49,11 @@ func TestNew_Success(t *testing.T) {
 	assert.NotNil(t, config.UISvc)
 	assert.NotNil(t, config.SearchSvc)
 	assert.NotNil(t, config.InterceptModuleSvc)
+	assert.NotNil(t, config.JournalSvc)
+	assert.NotNil(t, config.ConfigSvc)
+	assert.NotNil(t, config.VaultSvc)
+	assert.NotNil(t, config.SnapshotSvc)
+	assert.NotNil(t, config.TokenCacheSvc)
 }
 
 func TestNew_NilAction(t *testing.T) {
@@ -127,3 +135,39 @@ func TestNew_DependencyWiring(t *testing.T) {
 	// TenantSvc should be created before UISvc
 	assert.NotNil(t, config.UISvc)
 }
+
+func TestNew_DryRun(t *testing.T) {
+	// Arrange
+	action := &action.Action{
+		Name:  "test-action",
+		Param: &action.Param{DryRun: true},
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	// Act
+	config, err := runconfig.New(action, logger)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.IsType(t, &execsvc.DryRunExecSvc{}, config.ExecSvc)
+	assert.IsType(t, &httpclient.DryRunHTTPClient{}, config.HTTPClient)
+	assert.IsType(t, &dockerclient.DryRunDockerClient{}, config.DockerClient)
+}
+
+func TestNew_WithoutDryRun(t *testing.T) {
+	// Arrange
+	action := &action.Action{
+		Name:  "test-action",
+		Param: &action.Param{},
+	}
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	// Act
+	config, err := runconfig.New(action, logger)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.IsType(t, &execsvc.ExecSvc{}, config.ExecSvc)
+	assert.IsType(t, &httpclient.HTTPClient{}, config.HTTPClient)
+	assert.IsType(t, &dockerclient.DockerClient{}, config.DockerClient)
+}
@@ -0,0 +1,182 @@
+package snapshotsvc
+
+import (
+	"cmp"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/kafkasvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/vaultsvc"
+)
+
+// SnapshotProcessor defines the interface for environment snapshot operations
+type SnapshotProcessor interface {
+	SnapshotStore
+	SnapshotDatabaseManager
+	SnapshotSecretManager
+	SnapshotOffsetManager
+	SnapshotTenantDataManager
+}
+
+// SnapshotStore defines the interface for reading and writing the environment snapshots in the home directory
+type SnapshotStore interface {
+	GetSnapshotDir(name string) (string, error)
+	CreateSnapshotDir(name string) (string, error)
+	ListSnapshots() ([]models.EnvironmentSnapshotSummary, error)
+	ReadManifest(name string) (*models.EnvironmentSnapshot, error)
+	WriteManifest(snapshotDir string, snapshot *models.EnvironmentSnapshot) error
+}
+
+// SnapshotSvc provides functionality for saving the state of the whole environment into a named snapshot
+// under ~/.eureka/snapshots and restoring it, the module containers must be undeployed while restoring,
+// it also exports and imports the module schemas of a single tenant
+type SnapshotSvc struct {
+	Action   *action.Action
+	VaultSvc vaultsvc.VaultProcessor
+	KafkaSvc kafkasvc.KafkaProcessor
+}
+
+// New creates a new SnapshotSvc instance
+func New(action *action.Action, vaultSvc vaultsvc.VaultProcessor, kafkaSvc kafkasvc.KafkaProcessor) *SnapshotSvc {
+	return &SnapshotSvc{Action: action, VaultSvc: vaultSvc, KafkaSvc: kafkaSvc}
+}
+
+var snapshotNameRegexp = regexp.MustCompile(constant.EnvironmentSnapshotNamePattern)
+
+// GetSnapshotDir returns the directory of a snapshot, the name must be usable as a directory name
+func (ss *SnapshotSvc) GetSnapshotDir(name string) (string, error) {
+	if !snapshotNameRegexp.MatchString(name) {
+		return "", appErrors.EnvironmentSnapshotNameInvalid(name)
+	}
+	snapshotsDir, err := helpers.GetHomeEnvironmentSnapshotsDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(snapshotsDir, name), nil
+}
+
+// CreateSnapshotDir creates the directory of a new snapshot, the leftovers of a snapshot that failed
+// before its manifest was written are removed, the directory is not created in dry run mode
+func (ss *SnapshotSvc) CreateSnapshotDir(name string) (string, error) {
+	snapshotDir, err := ss.GetSnapshotDir(name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(filepath.Join(snapshotDir, constant.EnvironmentSnapshotManifestFile)); err == nil {
+		return "", appErrors.EnvironmentSnapshotAlreadyExists(name)
+	}
+	if ss.Action.IsDryRun() {
+		return snapshotDir, nil
+	}
+
+	if err := os.RemoveAll(snapshotDir); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(snapshotDir, 0755); err != nil {
+		return "", err
+	}
+
+	return snapshotDir, nil
+}
+
+// ListSnapshots returns the complete snapshots sorted by creation date, together with the total size of their files
+func (ss *SnapshotSvc) ListSnapshots() ([]models.EnvironmentSnapshotSummary, error) {
+	snapshotsDir, err := helpers.GetHomeEnvironmentSnapshotsDir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(snapshotsDir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	summaries := []models.EnvironmentSnapshotSummary{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		snapshot, err := ss.ReadManifest(entry.Name())
+		if err != nil {
+			slog.Debug(ss.Action.Name, "text", "Skipping incomplete snapshot", "name", entry.Name(), "error", err)
+			continue
+		}
+		size, err := getDirSize(filepath.Join(snapshotsDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, models.EnvironmentSnapshotSummary{
+			Name:      entry.Name(),
+			Profile:   snapshot.Profile,
+			CreatedAt: snapshot.CreatedAt,
+			Size:      size,
+		})
+	}
+	slices.SortFunc(summaries, func(a, b models.EnvironmentSnapshotSummary) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.Name, b.Name))
+	})
+
+	return summaries, nil
+}
+
+// ReadManifest reads the manifest of a snapshot, a snapshot without a manifest is not found
+func (ss *SnapshotSvc) ReadManifest(name string) (*models.EnvironmentSnapshot, error) {
+	snapshotDir, err := ss.GetSnapshotDir(name)
+	if err != nil {
+		return nil, err
+	}
+	manifestPath := filepath.Join(snapshotDir, constant.EnvironmentSnapshotManifestFile)
+	if _, err := os.Stat(manifestPath); err != nil {
+		return nil, appErrors.EnvironmentSnapshotNotFound(name)
+	}
+
+	var snapshot models.EnvironmentSnapshot
+	if err := helpers.ReadJSONFromFile(manifestPath, &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// WriteManifest writes the manifest of a snapshot, which marks the snapshot as complete
+func (ss *SnapshotSvc) WriteManifest(snapshotDir string, snapshot *models.EnvironmentSnapshot) error {
+	if ss.Action.IsDryRun() {
+		slog.Info(ss.Action.Name, "text", "Skipping writing of snapshot manifest in dry run mode", "name", snapshot.Name)
+		return nil
+	}
+
+	if err := helpers.WriteJSONToFile(filepath.Join(snapshotDir, constant.EnvironmentSnapshotManifestFile), snapshot); err != nil {
+		return err
+	}
+	slog.Info(ss.Action.Name, "text", "Wrote snapshot manifest", "name", snapshot.Name, "path", snapshotDir)
+
+	return nil
+}
+
+func getDirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+
+		return nil
+	})
+
+	return size, err
+}
@@ -0,0 +1,218 @@
+package snapshotsvc
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+)
+
+// SnapshotDatabaseManager defines the interface for dumping and restoring the PostgreSQL databases
+type SnapshotDatabaseManager interface {
+	DumpDatabases(client *client.Client, snapshotDir string) ([]string, error)
+	RestoreDatabases(client *client.Client, snapshotDir string) error
+}
+
+var (
+	listDatabasesCommand     = []string{"psql", "-U", "postgres", "-d", "postgres", "-Atc", "SELECT datname FROM pg_database WHERE NOT datistemplate ORDER BY datname"}
+	dumpDatabasesCommand     = []string{"pg_dumpall", "-U", "postgres", "--clean", "--if-exists"}
+	terminateBackendsCommand = []string{"psql", "-U", "postgres", "-d", "postgres", "-Atc", "SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE pid <> pg_backend_pid() AND datname IS NOT NULL"}
+	restoreDatabasesCommand  = []string{"psql", "-U", "postgres", "-d", "postgres", "-q", "-v", "ON_ERROR_STOP=1", "-f", "-"}
+
+	// skippedRestoreStatements recreate the role running the restore, which can neither be dropped nor created again
+	skippedRestoreStatements = map[string]bool{"DROP ROLE IF EXISTS postgres;": true, "CREATE ROLE postgres;": true}
+
+	// databaseClientContainers hold open connections to their databases and cache their state, e.g. the Keycloak realms
+	databaseClientContainers = []string{constant.KongContainer, constant.KeycloakContainer}
+)
+
+// DumpDatabases writes a gzipped pg_dumpall of all databases and roles of the postgres container into the snapshot
+// and returns the names of the dumped databases, nothing is dumped in dry run mode
+func (ss *SnapshotSvc) DumpDatabases(client *client.Client, snapshotDir string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDatabaseSnapshot)
+	defer cancel()
+
+	if ss.Action.IsDryRun() {
+		slog.Info(ss.Action.Name, "text", "Skipping dumping of databases in dry run mode", "container", constant.PostgreSQLContainer)
+		return []string{}, nil
+	}
+
+	var databaseList bytes.Buffer
+	if err := ss.execCommand(ctx, client, listDatabasesCommand, nil, nil, &databaseList); err != nil {
+		return nil, err
+	}
+	databases := strings.Fields(databaseList.String())
+
+	dumpFile, err := os.OpenFile(filepath.Join(snapshotDir, constant.EnvironmentSnapshotDatabaseFile), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer helpers.CloseFile(dumpFile)
+
+	gzipWriter := gzip.NewWriter(dumpFile)
+	if err := ss.execCommand(ctx, client, dumpDatabasesCommand, nil, nil, gzipWriter); err != nil {
+		return nil, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+	slog.Info(ss.Action.Name, "text", "Dumped databases", "databases", databases, "path", dumpFile.Name())
+
+	return databases, nil
+}
+
+// RestoreDatabases replaces all databases and roles of the postgres container with the dump of the snapshot, Kong and
+// Keycloak are stopped while restoring and started again afterwards, nothing is restored in dry run mode
+func (ss *SnapshotSvc) RestoreDatabases(client *client.Client, snapshotDir string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDatabaseSnapshot)
+	defer cancel()
+
+	if ss.Action.IsDryRun() {
+		slog.Info(ss.Action.Name, "text", "Skipping restoring of databases in dry run mode", "container", constant.PostgreSQLContainer)
+		return nil
+	}
+
+	dumpFile, err := os.Open(filepath.Join(snapshotDir, constant.EnvironmentSnapshotDatabaseFile))
+	if err != nil {
+		return err
+	}
+	defer helpers.CloseFile(dumpFile)
+	gzipReader, err := gzip.NewReader(bufio.NewReader(dumpFile))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	for _, containerName := range databaseClientContainers {
+		if err := client.ContainerStop(ctx, containerName, container.StopOptions{}); err != nil && !errdefs.IsNotFound(err) {
+			return err
+		}
+		slog.Info(ss.Action.Name, "text", "Stopped container", "container", containerName)
+	}
+	restoreErr := ss.restoreDump(ctx, client, gzipReader)
+	for _, containerName := range databaseClientContainers {
+		if err := client.ContainerStart(ctx, containerName, container.StartOptions{}); err != nil && !errdefs.IsNotFound(err) {
+			return errors.Join(restoreErr, err)
+		}
+		slog.Info(ss.Action.Name, "text", "Started container", "container", containerName)
+	}
+	if restoreErr != nil {
+		return restoreErr
+	}
+	slog.Info(ss.Action.Name, "text", "Restored databases", "path", dumpFile.Name())
+
+	return nil
+}
+
+// restoreDump closes the remaining connections to the databases and replays the dump
+func (ss *SnapshotSvc) restoreDump(ctx context.Context, client *client.Client, dump io.Reader) error {
+	if err := ss.execCommand(ctx, client, terminateBackendsCommand, nil, nil, io.Discard); err != nil {
+		return err
+	}
+
+	filteredDump := filterRestoreDump(dump)
+	defer func() {
+		_ = filteredDump.Close()
+	}()
+
+	return ss.execCommand(ctx, client, restoreDatabasesCommand, nil, filteredDump, io.Discard)
+}
+
+// filterRestoreDump streams the dump without the skipped statements, it reads line by line so that
+// the long COPY data lines are passed through unchanged
+func filterRestoreDump(dump io.Reader) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		bufferedDump := bufio.NewReader(dump)
+		for {
+			line, err := bufferedDump.ReadString('\n')
+			if line != "" && !skippedRestoreStatements[strings.TrimRight(line, "\r\n")] {
+				if _, writeErr := io.WriteString(writer, line); writeErr != nil {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				_ = writer.Close()
+				return
+			} else if err != nil {
+				_ = writer.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	return reader
+}
+
+// execCommand runs a command with the extra environment variables in the postgres container, streaming the stdin reader
+// into the command and its stdout into the writer, the command fails unless it exits with code 0
+func (ss *SnapshotSvc) execCommand(ctx context.Context, client *client.Client, command []string, env []string, stdin io.Reader, stdout io.Writer) error {
+	exec, err := client.ContainerExecCreate(ctx, constant.PostgreSQLContainer, container.ExecOptions{
+		AttachStdin:  stdin != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+		Env:          env,
+		Cmd:          command,
+	})
+	if err != nil {
+		return err
+	}
+
+	attach, err := client.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return err
+	}
+	defer attach.Close()
+
+	stdinErr := make(chan error, 1)
+	if stdin != nil {
+		go func() {
+			_, err := io.Copy(attach.Conn, stdin)
+			if closeErr := attach.CloseWrite(); err == nil {
+				err = closeErr
+			}
+			stdinErr <- err
+		}()
+	} else {
+		stdinErr <- nil
+	}
+
+	var stderr bytes.Buffer
+	if _, err := stdcopy.StdCopy(stdout, &stderr, attach.Reader); err != nil {
+		return err
+	}
+	copyStdinErr := <-stdinErr
+
+	// A command that exits early breaks the stdin stream, its stderr explains why
+	execInspect, err := client.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return errors.Join(copyStdinErr, err)
+	}
+	if execInspect.ExitCode != 0 {
+		return appErrors.EnvironmentSnapshotCommandFailed(constant.PostgreSQLContainer, command[:1], execInspect.ExitCode, strings.TrimSpace(stderr.String()))
+	}
+	if copyStdinErr != nil {
+		return copyStdinErr
+	}
+	if stderr.Len() > 0 {
+		slog.Debug(ss.Action.Name, "text", "Command finished with warnings", "command", command[0], "output", strings.TrimSpace(stderr.String()))
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package snapshotsvc
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// SnapshotOffsetManager defines the interface for saving and restoring the committed Kafka consumer group offsets
+type SnapshotOffsetManager interface {
+	SaveConsumerGroupOffsets() ([]models.SnapshotConsumerGroup, error)
+	RestoreConsumerGroupOffsets(consumerGroups []models.SnapshotConsumerGroup) int
+}
+
+// SaveConsumerGroupOffsets returns the offsets committed by every consumer group of the cluster,
+// groups that disappear while being read and partitions without a committed offset are left out
+func (ss *SnapshotSvc) SaveConsumerGroupOffsets() ([]models.SnapshotConsumerGroup, error) {
+	groupIDs, err := ss.KafkaSvc.ListConsumerGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	consumerGroups := []models.SnapshotConsumerGroup{}
+	for _, groupID := range groupIDs {
+		description, err := ss.KafkaSvc.DescribeConsumerGroup(groupID, "")
+		if errors.Is(err, appErrors.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		consumerGroup := models.SnapshotConsumerGroup{GroupID: groupID, Offsets: []models.SnapshotPartitionOffset{}}
+		for _, offset := range description.Offsets {
+			if offset.CommittedOffset >= 0 {
+				consumerGroup.Offsets = append(consumerGroup.Offsets, models.SnapshotPartitionOffset{
+					Topic:     offset.Topic,
+					Partition: offset.Partition,
+					Offset:    offset.CommittedOffset,
+				})
+			}
+		}
+		if len(consumerGroup.Offsets) > 0 {
+			consumerGroups = append(consumerGroups, consumerGroup)
+		}
+	}
+	slog.Info(ss.Action.Name, "text", "Saved consumer group offsets", "consumerGroups", len(consumerGroups))
+
+	return consumerGroups, nil
+}
+
+// RestoreConsumerGroupOffsets moves the offsets of every saved consumer group to the end of its partitions and returns
+// the number of restored groups. Kafka itself is not part of the snapshot, so the messages after the saved offsets were
+// produced after the snapshot was taken, committing the saved offsets would replay them into the restored databases,
+// the consumers therefore start with the messages produced after the restore. A group that cannot be restored,
+// e.g. because it is still consuming or its topics were deleted, is skipped with a warning
+func (ss *SnapshotSvc) RestoreConsumerGroupOffsets(consumerGroups []models.SnapshotConsumerGroup) int {
+	var restored int
+	for _, consumerGroup := range consumerGroups {
+		offsets := make(map[models.KafkaTopicPartition]int64, len(consumerGroup.Offsets))
+		for _, offset := range consumerGroup.Offsets {
+			offsets[models.KafkaTopicPartition{Topic: offset.Topic, Partition: offset.Partition}] = constant.KafkaOffsetLatest
+		}
+		if _, err := ss.KafkaSvc.RestoreOffsets(consumerGroup.GroupID, offsets); err != nil {
+			slog.Warn(ss.Action.Name, "text", "Consumer group offsets were not restored", "consumerGroup", consumerGroup.GroupID, "error", err)
+			continue
+		}
+		restored++
+	}
+
+	return restored
+}
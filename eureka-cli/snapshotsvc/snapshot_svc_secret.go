@@ -0,0 +1,90 @@
+package snapshotsvc
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+)
+
+// SnapshotSecretManager defines the interface for saving and restoring the Vault secrets
+type SnapshotSecretManager interface {
+	SaveSecrets(snapshotDir string) (int, error)
+	RestoreSecrets(snapshotDir string) (int, error)
+}
+
+// SaveSecrets writes all secrets of the KV v2 secrets engine into the snapshot with owner-only permissions
+// and returns the number of saved secrets, the secrets are read but not written in dry run mode
+func (ss *SnapshotSvc) SaveSecrets(snapshotDir string) (int, error) {
+	secrets := make(map[string]map[string]any)
+	if err := ss.collectSecrets("", secrets); err != nil {
+		return 0, err
+	}
+	if ss.Action.IsDryRun() {
+		slog.Info(ss.Action.Name, "text", "Skipping saving of secrets in dry run mode", "secrets", len(secrets))
+		return len(secrets), nil
+	}
+
+	data, err := json.MarshalIndent(secrets, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	if err := os.WriteFile(filepath.Join(snapshotDir, constant.EnvironmentSnapshotSecretsFile), data, 0600); err != nil {
+		return 0, err
+	}
+	slog.Info(ss.Action.Name, "text", "Saved secrets", "secrets", len(secrets))
+
+	return len(secrets), nil
+}
+
+// RestoreSecrets writes the keys of the secrets saved in the snapshot back into Vault
+// and returns the number of restored secrets, secrets created after the snapshot are kept
+func (ss *SnapshotSvc) RestoreSecrets(snapshotDir string) (int, error) {
+	secrets := make(map[string]map[string]any)
+	if err := helpers.ReadJSONFromFile(filepath.Join(snapshotDir, constant.EnvironmentSnapshotSecretsFile), &secrets); err != nil {
+		return 0, err
+	}
+	for _, secretPath := range slices.Sorted(maps.Keys(secrets)) {
+		if err := ss.VaultSvc.SetSecretKeys(secretPath, secrets[secretPath]); err != nil {
+			return 0, err
+		}
+	}
+	slog.Info(ss.Action.Name, "text", "Restored secrets", "secrets", len(secrets))
+
+	return len(secrets), nil
+}
+
+// collectSecrets reads the secrets under a path recursively, folder names end with a slash
+func (ss *SnapshotSvc) collectSecrets(secretPath string, secrets map[string]map[string]any) error {
+	names, err := ss.VaultSvc.ListSecrets(secretPath)
+	if errors.Is(err, appErrors.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		childPath := secretPath + name
+		if strings.HasSuffix(name, "/") {
+			if err := ss.collectSecrets(childPath, secrets); err != nil {
+				return err
+			}
+			continue
+		}
+		secret, err := ss.VaultSvc.GetSecret(childPath)
+		if err != nil {
+			return err
+		}
+		secrets[childPath] = secret
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package snapshotsvc
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// SnapshotTenantDataManager defines the interface for exporting and importing the module schemas of a single tenant
+type SnapshotTenantDataManager interface {
+	ExportTenantData(client *client.Client, tenantName string, moduleIDs []string, filePath string) (*models.TenantDataArchive, error)
+	ReadTenantDataArchive(filePath string) (*models.TenantDataArchive, error)
+	ImportTenantData(client *client.Client, tenantName string, filePath string, archive *models.TenantDataArchive) error
+	GetTenantDataModuleMismatches(archive *models.TenantDataArchive, moduleIDs []string) []models.TenantDataModuleMismatch
+}
+
+var (
+	tenantNameRegexp       = regexp.MustCompile(constant.TenantNamePattern)
+	tenantDataSchemaRegexp = regexp.MustCompile(constant.TenantDataSchemaPattern)
+)
+
+// databaseSettings hold the connection settings of the module database taken from the environment block of the config
+type databaseSettings struct {
+	host     string
+	port     string
+	database string
+	username string
+	password string
+}
+
+func (ss *SnapshotSvc) getDatabaseSettings() databaseSettings {
+	env := ss.Action.ConfigGlobalEnv
+	return databaseSettings{
+		host:     action.GetConfigEnv("DB_HOST", env),
+		port:     action.GetConfigEnv("DB_PORT", env),
+		database: action.GetConfigEnv("DB_DATABASE", env),
+		username: action.GetConfigEnv("DB_USERNAME", env),
+		password: action.GetConfigEnv("DB_PASSWORD", env),
+	}
+}
+
+// command returns a PostgreSQL client command connecting to the module database
+func (ds databaseSettings) command(name string, args ...string) []string {
+	return append([]string{name, "-h", ds.host, "-p", ds.port, "-U", ds.username, "-d", ds.database}, args...)
+}
+
+// env returns the environment variables passing the password to the PostgreSQL client commands
+func (ds databaseSettings) env() []string {
+	return []string{"PGPASSWORD=" + ds.password}
+}
+
+// ExportTenantData writes a gzipped tar archive with a pg_dump of all <tenant>_mod_* schemas of the module database
+// and a manifest recording the entitled module of every schema, nothing is exported in dry run mode
+func (ss *SnapshotSvc) ExportTenantData(client *client.Client, tenantName string, moduleIDs []string, filePath string) (*models.TenantDataArchive, error) {
+	if !tenantNameRegexp.MatchString(tenantName) {
+		return nil, appErrors.TenantNameInvalid(tenantName)
+	}
+	settings := ss.getDatabaseSettings()
+	archive := &models.TenantDataArchive{
+		Tenant:    tenantName,
+		Profile:   ss.Action.ConfigProfileName,
+		Database:  settings.database,
+		CreatedAt: time.Now().UTC(),
+		Schemas:   []models.TenantDataSchema{},
+	}
+	if ss.Action.IsDryRun() {
+		slog.Info(ss.Action.Name, "text", "Skipping exporting of tenant data in dry run mode", "tenant", tenantName, "path", filePath)
+		return archive, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDatabaseSnapshot)
+	defer cancel()
+
+	schemaPrefix := tenantName + constant.TenantDataSchemaInfix
+	listSchemasCommand := settings.command("psql", "-Atc", fmt.Sprintf("SELECT nspname FROM pg_namespace WHERE starts_with(nspname, '%s') ORDER BY nspname", schemaPrefix))
+	var schemaList bytes.Buffer
+	if err := ss.execCommand(ctx, client, listSchemasCommand, settings.env(), nil, &schemaList); err != nil {
+		return nil, err
+	}
+	schemaNames := strings.Fields(schemaList.String())
+	if len(schemaNames) == 0 {
+		return nil, appErrors.TenantDataSchemasNotFound(tenantName, settings.database)
+	}
+
+	dumpArgs := []string{}
+	for _, schemaName := range schemaNames {
+		moduleName := strings.ReplaceAll(strings.TrimPrefix(schemaName, tenantName+"_"), "_", "-")
+		archive.Schemas = append(archive.Schemas, models.TenantDataSchema{Name: schemaName, ModuleID: findModuleID(moduleName, moduleIDs)})
+		dumpArgs = append(dumpArgs, "-n", schemaName)
+	}
+
+	dumpFile, err := os.CreateTemp("", "eureka-tenant-data-*.sql")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(dumpFile.Name())
+	}()
+	defer helpers.CloseFile(dumpFile)
+
+	if err := ss.execCommand(ctx, client, settings.command("pg_dump", dumpArgs...), settings.env(), nil, dumpFile); err != nil {
+		return nil, err
+	}
+	if err := writeTenantDataArchive(filePath, archive, dumpFile); err != nil {
+		return nil, err
+	}
+	slog.Info(ss.Action.Name, "text", "Exported tenant data", "tenant", tenantName, "schemas", len(archive.Schemas), "path", filePath)
+
+	return archive, nil
+}
+
+// ReadTenantDataArchive reads the manifest of a tenant data archive
+func (ss *SnapshotSvc) ReadTenantDataArchive(filePath string) (*models.TenantDataArchive, error) {
+	var archive models.TenantDataArchive
+	if err := readTenantDataEntry(filePath, constant.TenantDataManifestFile, func(entry io.Reader) error {
+		return json.NewDecoder(entry).Decode(&archive)
+	}); err != nil {
+		return nil, err
+	}
+	if !tenantNameRegexp.MatchString(archive.Tenant) {
+		return nil, appErrors.TenantNameInvalid(archive.Tenant)
+	}
+
+	return &archive, nil
+}
+
+// ImportTenantData replaces the module schemas of a tenant with the schemas of a tenant data archive in a single
+// transaction, the schemas are renamed when the archive was exported from another tenant, nothing is imported in dry run mode
+func (ss *SnapshotSvc) ImportTenantData(client *client.Client, tenantName string, filePath string, archive *models.TenantDataArchive) error {
+	if !tenantNameRegexp.MatchString(tenantName) {
+		return appErrors.TenantNameInvalid(tenantName)
+	}
+	archivePrefix := archive.Tenant + constant.TenantDataSchemaInfix
+	for _, schema := range archive.Schemas {
+		if !strings.HasPrefix(schema.Name, archivePrefix) || !tenantDataSchemaRegexp.MatchString(schema.Name) {
+			return appErrors.TenantDataSchemaInvalid(schema.Name, archivePrefix)
+		}
+	}
+	if ss.Action.IsDryRun() {
+		slog.Info(ss.Action.Name, "text", "Skipping importing of tenant data in dry run mode", "tenant", tenantName, "path", filePath)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDatabaseSnapshot)
+	defer cancel()
+
+	settings := ss.getDatabaseSettings()
+	tenantPrefix := tenantName + constant.TenantDataSchemaInfix
+	var dropSchemas strings.Builder
+	for _, schema := range archive.Schemas {
+		_, _ = fmt.Fprintf(&dropSchemas, "DROP SCHEMA IF EXISTS %s CASCADE;\n", tenantPrefix+strings.TrimPrefix(schema.Name, archivePrefix))
+	}
+
+	restoreCommand := settings.command("psql", "-q", "-v", "ON_ERROR_STOP=1", "--single-transaction", "-f", "-")
+	if err := readTenantDataEntry(filePath, constant.TenantDataSchemasFile, func(entry io.Reader) error {
+		dump := renameSchemas(entry, archivePrefix, tenantPrefix)
+		defer func() {
+			_ = dump.Close()
+		}()
+
+		return ss.execCommand(ctx, client, restoreCommand, settings.env(), io.MultiReader(strings.NewReader(dropSchemas.String()), dump), io.Discard)
+	}); err != nil {
+		return err
+	}
+	slog.Info(ss.Action.Name, "text", "Imported tenant data", "tenant", tenantName, "schemas", len(archive.Schemas), "path", filePath)
+
+	return nil
+}
+
+// GetTenantDataModuleMismatches returns the schemas of the archive whose exported module version differs from
+// the module entitled for the tenant, a module that is no longer entitled has a blank tenant module id
+func (ss *SnapshotSvc) GetTenantDataModuleMismatches(archive *models.TenantDataArchive, moduleIDs []string) []models.TenantDataModuleMismatch {
+	mismatches := []models.TenantDataModuleMismatch{}
+	for _, schema := range archive.Schemas {
+		if schema.ModuleID == "" {
+			continue
+		}
+		moduleID := findModuleID(helpers.GetModuleNameFromID(schema.ModuleID), moduleIDs)
+		if moduleID != schema.ModuleID {
+			mismatches = append(mismatches, models.TenantDataModuleMismatch{Schema: schema.Name, ArchiveModuleID: schema.ModuleID, TenantModuleID: moduleID})
+		}
+	}
+
+	return mismatches
+}
+
+func findModuleID(moduleName string, moduleIDs []string) string {
+	for _, moduleID := range moduleIDs {
+		if helpers.GetModuleNameFromID(moduleID) == moduleName {
+			return moduleID
+		}
+	}
+
+	return ""
+}
+
+// writeTenantDataArchive writes the manifest followed by the schema dump, so that the manifest can be read without unpacking the dump
+func writeTenantDataArchive(filePath string, archive *models.TenantDataArchive, dumpFile *os.File) error {
+	manifest, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return err
+	}
+	dumpInfo, err := dumpFile.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err := dumpFile.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	archiveFile, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer helpers.CloseFile(archiveFile)
+
+	gzipWriter := gzip.NewWriter(archiveFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := tarWriter.WriteHeader(&tar.Header{Name: constant.TenantDataManifestFile, Mode: 0600, Size: int64(len(manifest)), ModTime: archive.CreatedAt}); err != nil {
+		return err
+	}
+	if _, err := tarWriter.Write(manifest); err != nil {
+		return err
+	}
+	if err := tarWriter.WriteHeader(&tar.Header{Name: constant.TenantDataSchemasFile, Mode: 0600, Size: dumpInfo.Size(), ModTime: archive.CreatedAt}); err != nil {
+		return err
+	}
+	if _, err := io.Copy(tarWriter, dumpFile); err != nil {
+		return err
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+// readTenantDataEntry passes the content of an entry of a tenant data archive to the read function
+func readTenantDataEntry(filePath string, entryName string, read func(entry io.Reader) error) error {
+	archiveFile, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer helpers.CloseFile(archiveFile)
+
+	gzipReader, err := gzip.NewReader(bufio.NewReader(archiveFile))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			return appErrors.TenantDataArchiveEntryNotFound(filePath, entryName)
+		}
+		if err != nil {
+			return err
+		}
+		if header.Name == entryName {
+			return read(tarReader)
+		}
+	}
+}
+
+// renameSchemas replaces the schema prefix of the archive tenant with the prefix of the target tenant in the identifiers of
+// the statements and COPY headers of the dump, the rows of COPY blocks are left as they are so that data mentioning the
+// archive tenant is restored unchanged, the dump is returned unchanged when both tenants are the same
+func renameSchemas(dump io.Reader, archivePrefix, tenantPrefix string) io.ReadCloser {
+	if archivePrefix == tenantPrefix {
+		return io.NopCloser(dump)
+	}
+
+	identifier := regexp.MustCompile(`(^|[^A-Za-z0-9_$])` + regexp.QuoteMeta(archivePrefix))
+	replacement := "${1}" + tenantPrefix
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		reader := bufio.NewReader(dump)
+		copyData := false
+		for {
+			line, err := reader.ReadString('\n')
+			if len(line) > 0 {
+				switch {
+				case copyData:
+					copyData = strings.TrimRight(line, "\r\n") != `\.`
+				case strings.HasPrefix(line, "COPY ") && strings.HasSuffix(strings.TrimRight(line, "\r\n"), "FROM stdin;"):
+					copyData = true
+					line = identifier.ReplaceAllString(line, replacement)
+				default:
+					line = identifier.ReplaceAllString(line, replacement)
+				}
+				if _, writeErr := io.WriteString(pipeWriter, line); writeErr != nil {
+					_ = pipeWriter.CloseWithError(writeErr)
+					return
+				}
+			}
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				_ = pipeWriter.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	return pipeReader
+}
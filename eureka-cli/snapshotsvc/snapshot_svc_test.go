@@ -0,0 +1,516 @@
+package snapshotsvc
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/kafkasvc"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockVaultSvc is a mock for vaultsvc.VaultProcessor
+type mockVaultSvc struct {
+	mock.Mock
+}
+
+func (m *mockVaultSvc) ListSecrets(secretPath string) ([]string, error) {
+	args := m.Called(secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockVaultSvc) GetSecret(secretPath string) (map[string]any, error) {
+	args := m.Called(secretPath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]any), args.Error(1)
+}
+
+func (m *mockVaultSvc) SetSecretKeys(secretPath string, values map[string]any) error {
+	args := m.Called(secretPath, values)
+	return args.Error(0)
+}
+
+func (m *mockVaultSvc) DeleteSecretKey(secretPath string, key string) error {
+	args := m.Called(secretPath, key)
+	return args.Error(0)
+}
+
+func (m *mockVaultSvc) DeleteSecret(secretPath string) error {
+	args := m.Called(secretPath)
+	return args.Error(0)
+}
+
+var testPartition = models.KafkaTopicPartition{Topic: "folio.diku.users", Partition: 0}
+
+func newTestSvc(t *testing.T) (*SnapshotSvc, *mockVaultSvc, *testhelpers.MockKafkaClient) {
+	t.Setenv("HOME", t.TempDir())
+	action := testhelpers.NewMockAction()
+	action.ConfigProfileName = "combined"
+	mockVault := &mockVaultSvc{}
+	mockKafkaClient := &testhelpers.MockKafkaClient{}
+
+	return New(action, mockVault, kafkasvc.New(action, mockKafkaClient)), mockVault, mockKafkaClient
+}
+
+func writeTestSnapshot(t *testing.T, svc *SnapshotSvc, name string, createdAt time.Time, dumpSize int) {
+	snapshotDir, err := svc.CreateSnapshotDir(name)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, constant.EnvironmentSnapshotDatabaseFile), make([]byte, dumpSize), 0600))
+	require.NoError(t, svc.WriteManifest(snapshotDir, &models.EnvironmentSnapshot{Name: name, Profile: "combined", CreatedAt: createdAt}))
+}
+
+func TestGetSnapshotDir(t *testing.T) {
+	t.Run("TestGetSnapshotDir_Success", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+
+		// Act
+		snapshotDir, err := svc.GetSnapshotDir("before-upgrade_1.0")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, filepath.Join(os.Getenv("HOME"), constant.ConfigDir, constant.EnvironmentSnapshotDir, "before-upgrade_1.0"), snapshotDir)
+	})
+
+	for _, name := range []string{"", "../before", ".hidden", "before upgrade"} {
+		t.Run("TestGetSnapshotDir_InvalidName", func(t *testing.T) {
+			// Arrange
+			svc, _, _ := newTestSvc(t)
+
+			// Act
+			_, err := svc.GetSnapshotDir(name)
+
+			// Assert
+			assert.ErrorIs(t, err, errors.ErrInvalidInput)
+		})
+	}
+}
+
+func TestCreateSnapshotDir(t *testing.T) {
+	t.Run("TestCreateSnapshotDir_ReplacesIncompleteSnapshot", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+		snapshotDir, err := svc.CreateSnapshotDir("before-upgrade")
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, constant.EnvironmentSnapshotDatabaseFile), []byte("partial"), 0600))
+
+		// Act
+		snapshotDir, err = svc.CreateSnapshotDir("before-upgrade")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoFileExists(t, filepath.Join(snapshotDir, constant.EnvironmentSnapshotDatabaseFile))
+		assert.DirExists(t, snapshotDir)
+	})
+
+	t.Run("TestCreateSnapshotDir_AlreadyExists", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+		writeTestSnapshot(t, svc, "before-upgrade", time.Now(), 1)
+
+		// Act
+		_, err := svc.CreateSnapshotDir("before-upgrade")
+
+		// Assert
+		assert.Equal(t, errors.EnvironmentSnapshotAlreadyExists("before-upgrade"), err)
+	})
+
+	t.Run("TestCreateSnapshotDir_DryRun", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+		svc.Action.Param.DryRun = true
+
+		// Act
+		snapshotDir, err := svc.CreateSnapshotDir("before-upgrade")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoDirExists(t, snapshotDir)
+	})
+}
+
+func TestListSnapshots(t *testing.T) {
+	t.Run("TestListSnapshots_NoSnapshots", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+
+		// Act
+		snapshots, err := svc.ListSnapshots()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, snapshots)
+	})
+
+	t.Run("TestListSnapshots_SortedByCreationDate", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+		createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		writeTestSnapshot(t, svc, "newer", createdAt.Add(time.Hour), 10)
+		writeTestSnapshot(t, svc, "older", createdAt, 20)
+		_, err := svc.CreateSnapshotDir("incomplete")
+		require.NoError(t, err)
+
+		// Act
+		snapshots, err := svc.ListSnapshots()
+
+		// Assert
+		assert.NoError(t, err)
+		require.Len(t, snapshots, 2)
+		assert.Equal(t, "older", snapshots[0].Name)
+		assert.Equal(t, "combined", snapshots[0].Profile)
+		assert.True(t, createdAt.Equal(snapshots[0].CreatedAt))
+		assert.Equal(t, "newer", snapshots[1].Name)
+		assert.Greater(t, snapshots[0].Size, int64(20))
+	})
+}
+
+func TestReadManifest_NotFound(t *testing.T) {
+	// Arrange
+	svc, _, _ := newTestSvc(t)
+
+	// Act
+	snapshot, err := svc.ReadManifest("missing")
+
+	// Assert
+	assert.Equal(t, errors.EnvironmentSnapshotNotFound("missing"), err)
+	assert.Nil(t, snapshot)
+}
+
+func TestSaveSecrets(t *testing.T) {
+	// Arrange
+	svc, mockVault, _ := newTestSvc(t)
+	snapshotDir, err := svc.CreateSnapshotDir("before-upgrade")
+	require.NoError(t, err)
+	mockVault.On("ListSecrets", "").Return([]string{"folio/"}, nil)
+	mockVault.On("ListSecrets", "folio/").Return([]string{"diku", "ecs/"}, nil)
+	mockVault.On("ListSecrets", "folio/ecs/").Return([]string{"university"}, nil)
+	mockVault.On("GetSecret", "folio/diku").Return(map[string]any{"mod-users": "s3cr3t"}, nil)
+	mockVault.On("GetSecret", "folio/ecs/university").Return(map[string]any{"mod-orders": "0rd3rs"}, nil)
+
+	// Act
+	count, err := svc.SaveSecrets(snapshotDir)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	secretsPath := filepath.Join(snapshotDir, constant.EnvironmentSnapshotSecretsFile)
+	info, err := os.Stat(secretsPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	var secrets map[string]map[string]any
+	require.NoError(t, helpers.ReadJSONFromFile(secretsPath, &secrets))
+	assert.Equal(t, map[string]map[string]any{
+		"folio/diku":           {"mod-users": "s3cr3t"},
+		"folio/ecs/university": {"mod-orders": "0rd3rs"},
+	}, secrets)
+	mockVault.AssertExpectations(t)
+}
+
+func TestSaveSecrets_NoSecrets(t *testing.T) {
+	// Arrange
+	svc, mockVault, _ := newTestSvc(t)
+	snapshotDir, err := svc.CreateSnapshotDir("before-upgrade")
+	require.NoError(t, err)
+	mockVault.On("ListSecrets", "").Return(nil, errors.VaultSecretNotFound(""))
+
+	// Act
+	count, err := svc.SaveSecrets(snapshotDir)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestRestoreSecrets(t *testing.T) {
+	// Arrange
+	svc, mockVault, _ := newTestSvc(t)
+	snapshotDir, err := svc.CreateSnapshotDir("before-upgrade")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(snapshotDir, constant.EnvironmentSnapshotSecretsFile), []byte(`{"folio/diku": {"mod-users": "s3cr3t"}}`), 0600))
+	mockVault.On("SetSecretKeys", "folio/diku", map[string]any{"mod-users": "s3cr3t"}).Return(nil)
+
+	// Act
+	count, err := svc.RestoreSecrets(snapshotDir)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	mockVault.AssertExpectations(t)
+}
+
+func TestSaveConsumerGroupOffsets(t *testing.T) {
+	// Arrange
+	svc, _, mockClient := newTestSvc(t)
+	mockClient.On("ListConsumerGroups").Return([]string{"folio-mod-users", "expired"}, nil)
+	mockClient.On("DescribeConsumerGroup", "folio-mod-users").Return(&models.KafkaConsumerGroup{GroupID: "folio-mod-users", State: constant.KafkaGroupStateEmpty}, nil)
+	mockClient.On("FetchCommittedOffsets", "folio-mod-users").Return(map[models.KafkaTopicPartition]int64{testPartition: 5}, nil)
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{testPartition}, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{testPartition: 9}, nil)
+	mockClient.On("DescribeConsumerGroup", "expired").Return(&models.KafkaConsumerGroup{GroupID: "expired", State: constant.KafkaGroupStateDead}, nil)
+
+	// Act
+	consumerGroups, err := svc.SaveConsumerGroupOffsets()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SnapshotConsumerGroup{{
+		GroupID: "folio-mod-users",
+		Offsets: []models.SnapshotPartitionOffset{{Topic: testPartition.Topic, Partition: 0, Offset: 5}},
+	}}, consumerGroups)
+	mockClient.AssertExpectations(t)
+}
+
+func TestRestoreConsumerGroupOffsets(t *testing.T) {
+	// Arrange
+	svc, _, mockClient := newTestSvc(t)
+	offsets := map[models.KafkaTopicPartition]int64{testPartition: 12}
+	mockClient.On("DescribeConsumerGroup", "folio-mod-users").Return(&models.KafkaConsumerGroup{State: constant.KafkaGroupStateEmpty}, nil)
+	mockClient.On("FetchCommittedOffsets", "folio-mod-users").Return(map[models.KafkaTopicPartition]int64{testPartition: 9}, nil)
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{testPartition}, int64(constant.KafkaOffsetEarliest)).
+		Return(map[models.KafkaTopicPartition]int64{testPartition: 0}, nil)
+	mockClient.On("ListOffsets", []models.KafkaTopicPartition{testPartition}, int64(constant.KafkaOffsetLatest)).
+		Return(map[models.KafkaTopicPartition]int64{testPartition: 12}, nil)
+	mockClient.On("CommitOffsets", "folio-mod-users", offsets).Return(nil)
+	active := &models.KafkaConsumerGroup{State: constant.KafkaGroupStateStable, Members: []models.KafkaConsumerGroupMember{{MemberID: "member-1"}}}
+	mockClient.On("DescribeConsumerGroup", "folio-mod-orders").Return(active, nil)
+
+	// Act
+	restored := svc.RestoreConsumerGroupOffsets([]models.SnapshotConsumerGroup{
+		{GroupID: "folio-mod-users", Offsets: []models.SnapshotPartitionOffset{{Topic: testPartition.Topic, Partition: 0, Offset: 5}}},
+		{GroupID: "folio-mod-orders", Offsets: []models.SnapshotPartitionOffset{{Topic: "folio.diku.orders", Partition: 0, Offset: 3}}},
+	})
+
+	// Assert
+	assert.Equal(t, 1, restored)
+	mockClient.AssertExpectations(t)
+}
+
+func TestDumpDatabases_DryRun(t *testing.T) {
+	// Arrange
+	svc, _, _ := newTestSvc(t)
+	svc.Action.Param.DryRun = true
+
+	// Act
+	databases, err := svc.DumpDatabases(nil, t.TempDir())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, databases)
+}
+
+func TestFilterRestoreDump(t *testing.T) {
+	// Arrange
+	copyLine := "1\t" + strings.Repeat("x", 128*1024) + "\n"
+	dump := "DROP DATABASE IF EXISTS folio;\nDROP ROLE IF EXISTS folio_admin;\nDROP ROLE IF EXISTS postgres;\n" +
+		"CREATE ROLE folio_admin;\nCREATE ROLE postgres;\nALTER ROLE postgres WITH SUPERUSER;\n" + copyLine + "\\."
+
+	// Act
+	filteredDump := filterRestoreDump(strings.NewReader(dump))
+	output, err := io.ReadAll(filteredDump)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "DROP DATABASE IF EXISTS folio;\nDROP ROLE IF EXISTS folio_admin;\n"+
+		"CREATE ROLE folio_admin;\nALTER ROLE postgres WITH SUPERUSER;\n"+copyLine+"\\.", string(output))
+	assert.Contains(t, strings.Join(restoreDatabasesCommand, " "), "-v ON_ERROR_STOP=1")
+}
+
+func TestExportTenantData_InvalidTenantName(t *testing.T) {
+	// Arrange
+	svc, _, _ := newTestSvc(t)
+
+	// Act
+	archive, err := svc.ExportTenantData(nil, "diku'; DROP SCHEMA public; --", nil, "diku.tar.gz")
+
+	// Assert
+	assert.ErrorIs(t, err, errors.ErrInvalidInput)
+	assert.Nil(t, archive)
+}
+
+func TestExportTenantData_DryRun(t *testing.T) {
+	// Arrange
+	svc, _, _ := newTestSvc(t)
+	svc.Action.Param.DryRun = true
+	svc.Action.ConfigGlobalEnv = map[string]string{"db_database": "folio"}
+	filePath := filepath.Join(t.TempDir(), "diku.tar.gz")
+
+	// Act
+	archive, err := svc.ExportTenantData(nil, "diku", []string{"mod-users-19.4.0"}, filePath)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "diku", archive.Tenant)
+	assert.Equal(t, "combined", archive.Profile)
+	assert.Equal(t, "folio", archive.Database)
+	assert.Empty(t, archive.Schemas)
+	assert.NoFileExists(t, filePath)
+}
+
+func TestReadTenantDataArchive(t *testing.T) {
+	t.Run("TestReadTenantDataArchive_Success", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+		filePath := filepath.Join(t.TempDir(), "diku.tar.gz")
+		dumpFile, err := os.CreateTemp(t.TempDir(), "dump-*.sql")
+		require.NoError(t, err)
+		_, err = dumpFile.WriteString("CREATE SCHEMA diku_mod_users;\n")
+		require.NoError(t, err)
+		archive := &models.TenantDataArchive{
+			Tenant:    "diku",
+			Profile:   "combined",
+			Database:  "folio",
+			CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+			Schemas:   []models.TenantDataSchema{{Name: "diku_mod_users", ModuleID: "mod-users-19.4.0"}},
+		}
+		require.NoError(t, writeTenantDataArchive(filePath, archive, dumpFile))
+
+		// Act
+		result, err := svc.ReadTenantDataArchive(filePath)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, archive, result)
+		var dump []byte
+		require.NoError(t, readTenantDataEntry(filePath, constant.TenantDataSchemasFile, func(entry io.Reader) error {
+			dump, err = io.ReadAll(entry)
+			return err
+		}))
+		assert.Equal(t, "CREATE SCHEMA diku_mod_users;\n", string(dump))
+	})
+
+	t.Run("TestReadTenantDataArchive_ManifestNotFound", func(t *testing.T) {
+		// Arrange
+		svc, _, _ := newTestSvc(t)
+		filePath := filepath.Join(t.TempDir(), "empty.tar.gz")
+		archiveFile, err := os.Create(filePath)
+		require.NoError(t, err)
+		gzipWriter := gzip.NewWriter(archiveFile)
+		require.NoError(t, tar.NewWriter(gzipWriter).Close())
+		require.NoError(t, gzipWriter.Close())
+		require.NoError(t, archiveFile.Close())
+
+		// Act
+		result, err := svc.ReadTenantDataArchive(filePath)
+
+		// Assert
+		assert.Equal(t, errors.TenantDataArchiveEntryNotFound(filePath, constant.TenantDataManifestFile), err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestImportTenantData_InvalidSchemaName(t *testing.T) {
+	for _, schemaName := range []string{"public", "fs09000000_mod_users", "diku_mod_users; DROP SCHEMA public", "diku_mod_Users"} {
+		t.Run("TestImportTenantData_InvalidSchemaName_"+schemaName, func(t *testing.T) {
+			// Arrange
+			svc, _, _ := newTestSvc(t)
+			archive := &models.TenantDataArchive{
+				Tenant:  "diku",
+				Schemas: []models.TenantDataSchema{{Name: "diku_mod_inventory"}, {Name: schemaName}},
+			}
+
+			// Act
+			err := svc.ImportTenantData(nil, "fs09000000", "diku.tar.gz", archive)
+
+			// Assert
+			assert.Equal(t, errors.TenantDataSchemaInvalid(schemaName, "diku_mod_"), err)
+		})
+	}
+}
+
+func TestGetTenantDataModuleMismatches(t *testing.T) {
+	// Arrange
+	svc, _, _ := newTestSvc(t)
+	archive := &models.TenantDataArchive{
+		Tenant: "diku",
+		Schemas: []models.TenantDataSchema{
+			{Name: "diku_mod_inventory_storage", ModuleID: "mod-inventory-storage-28.0.0"},
+			{Name: "diku_mod_notes", ModuleID: "mod-notes-6.0.0"},
+			{Name: "diku_mod_pubsub"},
+			{Name: "diku_mod_users", ModuleID: "mod-users-19.4.0"},
+		},
+	}
+	moduleIDs := []string{"mod-inventory-storage-28.1.0", "mod-users-19.4.0"}
+
+	// Act
+	mismatches := svc.GetTenantDataModuleMismatches(archive, moduleIDs)
+
+	// Assert
+	assert.Equal(t, []models.TenantDataModuleMismatch{
+		{Schema: "diku_mod_inventory_storage", ArchiveModuleID: "mod-inventory-storage-28.0.0", TenantModuleID: "mod-inventory-storage-28.1.0"},
+		{Schema: "diku_mod_notes", ArchiveModuleID: "mod-notes-6.0.0", TenantModuleID: ""},
+	}, mismatches)
+}
+
+func TestRenameSchemas(t *testing.T) {
+	t.Run("TestRenameSchemas_OtherTenant", func(t *testing.T) {
+		// Arrange
+		dump := "CREATE SCHEMA diku_mod_users;\nALTER SCHEMA diku_mod_users OWNER TO diku_mod_users;\nSELECT 1"
+
+		// Act
+		result, err := io.ReadAll(renameSchemas(strings.NewReader(dump), "diku_mod_", "fs09000000_mod_"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "CREATE SCHEMA fs09000000_mod_users;\nALTER SCHEMA fs09000000_mod_users OWNER TO fs09000000_mod_users;\nSELECT 1", string(result))
+	})
+
+	t.Run("TestRenameSchemas_CopyData", func(t *testing.T) {
+		// Arrange
+		dump := "COPY diku_mod_users.users (id, jsonb) FROM stdin;\n" +
+			"1\t{\"note\": \"moved from diku_mod_users\"}\n" +
+			"\\.\n" +
+			"ALTER TABLE ONLY diku_mod_users.users ADD CONSTRAINT users_pkey PRIMARY KEY (id);\n"
+
+		// Act
+		result, err := io.ReadAll(renameSchemas(strings.NewReader(dump), "diku_mod_", "fs09000000_mod_"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "COPY fs09000000_mod_users.users (id, jsonb) FROM stdin;\n"+
+			"1\t{\"note\": \"moved from diku_mod_users\"}\n"+
+			"\\.\n"+
+			"ALTER TABLE ONLY fs09000000_mod_users.users ADD CONSTRAINT users_pkey PRIMARY KEY (id);\n", string(result))
+	})
+
+	t.Run("TestRenameSchemas_IdentifierPrefix", func(t *testing.T) {
+		// Arrange
+		dump := "CREATE FUNCTION \"diku_mod_users\".f() RETURNS text AS $$ SELECT 'xdiku_mod_users' $$;\n"
+
+		// Act
+		result, err := io.ReadAll(renameSchemas(strings.NewReader(dump), "diku_mod_", "fs09000000_mod_"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "CREATE FUNCTION \"fs09000000_mod_users\".f() RETURNS text AS $$ SELECT 'xdiku_mod_users' $$;\n", string(result))
+	})
+
+	t.Run("TestRenameSchemas_SameTenant", func(t *testing.T) {
+		// Arrange
+		dump := "CREATE SCHEMA diku_mod_users;\n"
+
+		// Act
+		result, err := io.ReadAll(renameSchemas(strings.NewReader(dump), "diku_mod_", "diku_mod_"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, dump, string(result))
+	})
+}
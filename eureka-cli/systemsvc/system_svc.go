@@ -0,0 +1,296 @@
+package systemsvc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
+)
+
+// SystemProcessor defines the interface for system container operations
+type SystemProcessor interface {
+	SystemReadinessChecker
+}
+
+// SystemReadinessChecker defines the interface for system container readiness check operations
+type SystemReadinessChecker interface {
+	WaitForSystemReadiness(client *client.Client, serviceNames []string) error
+}
+
+// KafkaBrokerChecker defines the interface for the Kafka broker readiness check used by the Kafka probe
+type KafkaBrokerChecker interface {
+	CheckBrokerReadiness() error
+}
+
+// SystemSvc provides functionality for checking the readiness of the system containers deployed with Docker Compose
+type SystemSvc struct {
+	Action           *action.Action
+	HTTPClient       httpclient.HTTPClientRunner
+	KafkaSvc         KafkaBrokerChecker
+	ReadinessTimeout time.Duration
+	ReadinessWait    time.Duration
+}
+
+// systemProbe checks that a running system component accepts requests from the host
+type systemProbe func(ctx context.Context, client *client.Client, containerName string) error
+
+// New creates a new SystemSvc instance
+func New(action *action.Action, httpClient httpclient.HTTPClientRunner, kafkaSvc KafkaBrokerChecker) *SystemSvc {
+	return &SystemSvc{
+		Action:     action,
+		HTTPClient: httpClient,
+		KafkaSvc:   kafkaSvc,
+	}
+}
+
+// WaitForSystemReadiness waits in parallel until every given compose service, or every service of the compose project
+// when none is given, passes its Docker healthcheck and the probe of the component, all components share a single deadline
+// and the error reports the last reason of every component that did not become ready
+func (ss *SystemSvc) WaitForSystemReadiness(client *client.Client, serviceNames []string) error {
+	if ss.Action.IsDryRun() {
+		slog.Info(ss.Action.Name, "text", "Skipping system readiness check in dry run mode")
+		return nil
+	}
+
+	containerNames, err := ss.getServiceContainers(client)
+	if err != nil {
+		return err
+	}
+	if len(serviceNames) == 0 {
+		for serviceName := range containerNames {
+			serviceNames = append(serviceNames, serviceName)
+		}
+	}
+	slices.Sort(serviceNames)
+	serviceNames = slices.Compact(serviceNames)
+
+	timeout := helpers.DefaultDuration(ss.ReadinessTimeout, constant.SystemReadinessTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		failures = make([]string, len(serviceNames))
+	)
+	for idx, serviceName := range serviceNames {
+		containerName, ok := containerNames[serviceName]
+		if !ok {
+			failures[idx] = fmt.Sprintf("%s: %s", serviceName, errors.SystemContainerNotFound(serviceName))
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := ss.waitForComponent(ctx, client, serviceName, containerName); err != nil {
+				failures[idx] = fmt.Sprintf("%s: %s", serviceName, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	failures = slices.DeleteFunc(failures, func(failure string) bool {
+		return failure == ""
+	})
+	if len(failures) > 0 {
+		return errors.SystemNotReady(timeout, failures)
+	}
+
+	return nil
+}
+
+func (ss *SystemSvc) getServiceContainers(client *client.Client) (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), constant.ContextTimeoutDockerList)
+	defer cancel()
+
+	summaries, err := client.ContainerList(ctx, container.ListOptions{
+		All: true,
+		Filters: filters.NewArgs(filters.KeyValuePair{
+			Key:   "label",
+			Value: fmt.Sprintf("%s=%s", constant.DockerComposeLabelKey, constant.DockerComposeProject),
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	containerNames := make(map[string]string)
+	for _, summary := range summaries {
+		serviceName := summary.Labels[constant.DockerComposeServiceKey]
+		if serviceName == "" || len(summary.Names) == 0 {
+			continue
+		}
+		containerNames[serviceName] = strings.TrimPrefix(summary.Names[0], "/")
+	}
+
+	return containerNames, nil
+}
+
+func (ss *SystemSvc) waitForComponent(ctx context.Context, client *client.Client, serviceName string, containerName string) error {
+	startedAt := time.Now()
+	waitDuration := helpers.DefaultDuration(ss.ReadinessWait, constant.SystemReadinessWait)
+	var lastErr error
+	for {
+		err := ss.checkComponent(ctx, client, serviceName, containerName)
+		if err == nil {
+			slog.Info(ss.Action.Name, "text", "Component is ready", "service", serviceName, "elapsed", time.Since(startedAt).Round(time.Millisecond))
+			return nil
+		}
+		slog.Debug(ss.Action.Name, "text", "Component is unready", "service", serviceName, "reason", err)
+		// A check cut short by the shared deadline keeps the previous reason, which explains why the component is unready
+		if ctx.Err() == nil || lastErr == nil {
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			return lastErr
+		case <-time.After(waitDuration):
+		}
+	}
+}
+
+func (ss *SystemSvc) checkComponent(ctx context.Context, client *client.Client, serviceName string, containerName string) error {
+	inspect, err := client.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return err
+	}
+	if err := getContainerReadiness(inspect); err != nil || !inspect.State.Running {
+		return err
+	}
+
+	probe, ok := ss.getProbes()[serviceName]
+	if !ok {
+		return nil
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutSystemProbe)
+	defer cancel()
+
+	return probe(probeCtx, client, containerName)
+}
+
+// getContainerReadiness reports why a container is not ready yet based on its state and healthcheck status,
+// a one-shot container that exited successfully counts as ready
+func getContainerReadiness(inspect container.InspectResponse) error {
+	state := inspect.State
+	if state == nil {
+		return errors.SystemContainerNotRunning(inspect.Name, "unknown", 0)
+	}
+	if state.Status == container.StateExited && state.ExitCode == 0 {
+		return nil
+	}
+	if !state.Running || state.Restarting {
+		return errors.SystemContainerNotRunning(strings.TrimPrefix(inspect.Name, "/"), string(state.Status), state.ExitCode)
+	}
+	if state.Health != nil && state.Health.Status != container.Healthy && state.Health.Status != container.NoHealthcheck {
+		var lastOutput string
+		if count := len(state.Health.Log); count > 0 && state.Health.Log[count-1] != nil {
+			lastOutput = strings.TrimSpace(state.Health.Log[count-1].Output)
+		}
+		return errors.SystemContainerUnhealthy(strings.TrimPrefix(inspect.Name, "/"), string(state.Health.Status), lastOutput)
+	}
+
+	return nil
+}
+
+// ==================== Probes ====================
+
+func (ss *SystemSvc) getProbes() map[string]systemProbe {
+	return map[string]systemProbe{
+		constant.PostgreSQLContainer: ss.probePostgres,
+		constant.KafkaContainer:      ss.probeKafka,
+		constant.VaultContainer:      ss.probeVault,
+		constant.KeycloakContainer:   ss.probeKeycloak,
+		constant.KongContainer:       ss.probeKong,
+	}
+}
+
+func (ss *SystemSvc) probePostgres(ctx context.Context, client *client.Client, containerName string) error {
+	return execProbe(ctx, client, containerName, []string{"pg_isready", "--username", "postgres"})
+}
+
+func (ss *SystemSvc) probeKafka(_ context.Context, _ *client.Client, _ string) error {
+	return ss.KafkaSvc.CheckBrokerReadiness()
+}
+
+func (ss *SystemSvc) probeVault(_ context.Context, _ *client.Client, _ string) error {
+	var sealStatus struct {
+		Sealed bool `json:"sealed"`
+	}
+	if err := ss.HTTPClient.GetReturnStruct(ss.Action.GetRequestURL(constant.VaultServerPort, "/v1/sys/seal-status"), map[string]string{}, &sealStatus); err != nil {
+		return err
+	}
+	if sealStatus.Sealed {
+		return errors.VaultSealed()
+	}
+
+	return nil
+}
+
+func (ss *SystemSvc) probeKeycloak(_ context.Context, _ *client.Client, _ string) error {
+	return ss.pingEndpoint(fmt.Sprintf("%s/realms/master", constant.KeycloakHTTP))
+}
+
+func (ss *SystemSvc) probeKong(_ context.Context, _ *client.Client, _ string) error {
+	return ss.pingEndpoint(ss.Action.GetRequestURL(constant.KongAdminPort, "/status"))
+}
+
+func (ss *SystemSvc) pingEndpoint(requestURL string) error {
+	statusCode, err := ss.HTTPClient.Ping(requestURL)
+	if err != nil {
+		return err
+	}
+	if statusCode != http.StatusOK {
+		return errors.SystemEndpointNotReady(requestURL, statusCode)
+	}
+
+	return nil
+}
+
+// execProbe runs a command inside the container and fails unless the command exits with code 0
+func execProbe(ctx context.Context, client *client.Client, containerName string, command []string) error {
+	exec, err := client.ContainerExecCreate(ctx, containerName, container.ExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          command,
+	})
+	if err != nil {
+		return err
+	}
+
+	attach, err := client.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return err
+	}
+	defer attach.Close()
+
+	var output bytes.Buffer
+	if _, err := stdcopy.StdCopy(&output, &output, attach.Reader); err != nil {
+		return err
+	}
+
+	execInspect, err := client.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return err
+	}
+	if execInspect.ExitCode != 0 {
+		return errors.SystemProbeFailed(containerName, command, execInspect.ExitCode, strings.TrimSpace(output.String()))
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package systemsvc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockKafkaSvc is a mock for KafkaBrokerChecker
+type mockKafkaSvc struct {
+	mock.Mock
+}
+
+func (m *mockKafkaSvc) CheckBrokerReadiness() error {
+	args := m.Called()
+	return args.Error(0)
+}
+
+// newDockerClient creates a Docker client backed by a test server answering container list and inspect requests from the given containers
+func newDockerClient(t *testing.T, containers map[string]container.InspectResponse) *client.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body any
+		path := r.URL.Path[strings.Index(r.URL.Path, "/containers/"):]
+		if path == "/containers/json" {
+			var summaries []container.Summary
+			for serviceName, inspect := range containers {
+				summaries = append(summaries, container.Summary{
+					Names:  []string{inspect.Name},
+					Labels: map[string]string{constant.DockerComposeServiceKey: serviceName},
+				})
+			}
+			body = summaries
+		} else {
+			containerName := strings.TrimSuffix(strings.TrimPrefix(path, "/containers/"), "/json")
+			for _, inspect := range containers {
+				if inspect.Name == "/"+containerName {
+					body = inspect
+				}
+			}
+		}
+		if body == nil {
+			http.Error(w, `{"message":"not found"}`, http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+
+	dockerClient, err := client.NewClientWithOpts(
+		client.WithHost("tcp://"+server.Listener.Addr().String()),
+		client.WithVersion("1.47"),
+	)
+	require.NoError(t, err)
+
+	return dockerClient
+}
+
+func newInspect(containerName string, status container.ContainerState, health container.HealthStatus) container.InspectResponse {
+	state := &container.State{Status: status, Running: status == container.StateRunning}
+	if health != "" {
+		state.Health = &container.Health{Status: health}
+	}
+
+	return container.InspectResponse{ContainerJSONBase: &container.ContainerJSONBase{Name: "/" + containerName, State: state}}
+}
+
+func newTestSvc(dryRun bool) (*SystemSvc, *testhelpers.MockHTTPClient, *mockKafkaSvc) {
+	mockHTTPClient := &testhelpers.MockHTTPClient{}
+	mockKafka := &mockKafkaSvc{}
+	svc := New(action.New("test-action", "http://localhost:%s", &action.Param{DryRun: dryRun}), mockHTTPClient, mockKafka)
+	svc.ReadinessTimeout, svc.ReadinessWait = 200*time.Millisecond, 10*time.Millisecond
+
+	return svc, mockHTTPClient, mockKafka
+}
+
+func TestGetContainerReadiness(t *testing.T) {
+	t.Run("TestGetContainerReadiness_Healthy", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect("kong", container.StateRunning, container.Healthy)
+
+		// Act
+		err := getContainerReadiness(inspect)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("TestGetContainerReadiness_NoHealthcheck", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect("keycloak-internal", container.StateRunning, "")
+
+		// Act
+		err := getContainerReadiness(inspect)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("TestGetContainerReadiness_OneShotCompleted", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect("createbuckets", container.StateExited, "")
+
+		// Act
+		err := getContainerReadiness(inspect)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("TestGetContainerReadiness_ExitedWithError", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect("vault", container.StateExited, "")
+		inspect.State.ExitCode = 1
+
+		// Act
+		err := getContainerReadiness(inspect)
+
+		// Assert
+		assert.EqualError(t, err, "container vault is exited with exit code 1")
+	})
+
+	t.Run("TestGetContainerReadiness_Starting", func(t *testing.T) {
+		// Arrange
+		inspect := newInspect("postgres", container.StateRunning, container.Starting)
+		inspect.State.Health.Log = []*container.HealthcheckResult{{ExitCode: 1, Output: "no response\n"}}
+
+		// Act
+		err := getContainerReadiness(inspect)
+
+		// Assert
+		assert.EqualError(t, err, "container postgres healthcheck is starting: no response")
+	})
+}
+
+func TestWaitForSystemReadiness_DryRun(t *testing.T) {
+	// Arrange
+	svc, mockHTTPClient, mockKafka := newTestSvc(true)
+
+	// Act
+	err := svc.WaitForSystemReadiness(nil, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTPClient.AssertExpectations(t)
+	mockKafka.AssertExpectations(t)
+}
+
+func TestWaitForSystemReadiness_Ready(t *testing.T) {
+	// Arrange
+	svc, mockHTTPClient, mockKafka := newTestSvc(false)
+	dockerClient := newDockerClient(t, map[string]container.InspectResponse{
+		constant.KafkaContainer:    newInspect("kafka", container.StateRunning, container.Healthy),
+		constant.KeycloakContainer: newInspect("keycloak-internal", container.StateRunning, ""),
+		constant.KongContainer:     newInspect("kong", container.StateRunning, container.Healthy),
+		"createbuckets":            newInspect("createbuckets", container.StateExited, ""),
+	})
+	mockKafka.On("CheckBrokerReadiness").Return(nil)
+	mockHTTPClient.On("Ping", constant.KeycloakHTTP+"/realms/master").Return(http.StatusOK, nil)
+	mockHTTPClient.On("Ping", "http://localhost:8001/status").Return(http.StatusOK, nil)
+
+	// Act
+	err := svc.WaitForSystemReadiness(dockerClient, nil)
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTPClient.AssertExpectations(t)
+	mockKafka.AssertExpectations(t)
+}
+
+func TestWaitForSystemReadiness_RetriesUntilReady(t *testing.T) {
+	// Arrange
+	svc, mockHTTPClient, _ := newTestSvc(false)
+	dockerClient := newDockerClient(t, map[string]container.InspectResponse{
+		constant.KongContainer: newInspect("kong", container.StateRunning, container.Healthy),
+	})
+	mockHTTPClient.On("Ping", "http://localhost:8001/status").Return(http.StatusServiceUnavailable, nil).Twice()
+	mockHTTPClient.On("Ping", "http://localhost:8001/status").Return(http.StatusOK, nil).Once()
+
+	// Act
+	err := svc.WaitForSystemReadiness(dockerClient, []string{constant.KongContainer})
+
+	// Assert
+	assert.NoError(t, err)
+	mockHTTPClient.AssertNumberOfCalls(t, "Ping", 3)
+}
+
+func TestWaitForSystemReadiness_Timeout(t *testing.T) {
+	// Arrange
+	svc, mockHTTPClient, _ := newTestSvc(false)
+	unhealthy := newInspect("postgres", container.StateRunning, container.Unhealthy)
+	unhealthy.State.Health.Log = []*container.HealthcheckResult{{ExitCode: 1, Output: "no response"}}
+	dockerClient := newDockerClient(t, map[string]container.InspectResponse{
+		constant.PostgreSQLContainer: unhealthy,
+		constant.VaultContainer:      newInspect("vault", container.StateRunning, container.Healthy),
+	})
+	mockHTTPClient.On("GetReturnStruct", "http://localhost:8200/v1/sys/seal-status", map[string]string{}, mock.Anything).
+		Run(func(args mock.Arguments) {
+			_ = json.Unmarshal([]byte(`{"sealed":true}`), args.Get(2))
+		}).
+		Return(nil)
+
+	// Act
+	err := svc.WaitForSystemReadiness(dockerClient, []string{constant.VaultContainer, constant.PostgreSQLContainer, "minio"})
+
+	// Assert
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "system components did not become ready within 200ms")
+	assert.Contains(t, err.Error(), "minio: resource not found: system container for compose service minio")
+	assert.Contains(t, err.Error(), "postgres: container postgres healthcheck is unhealthy: no response")
+	assert.Contains(t, err.Error(), "vault: resource not ready: vault is sealed")
+}
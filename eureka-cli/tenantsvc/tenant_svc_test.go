@@ -62,6 +62,12 @@ func (m *MockConsortiumSvc) EnableCentralOrdering(centralTenant string) error {
 	return args.Error(0)
 }
 
+// ConsortiumUserMigrator interface methods
+func (m *MockConsortiumSvc) MigrateConsortiumUsers(centralTenant string, consortiumID string, consortiumTenants models.SortedConsortiumTenants, deleteUsers bool) []models.ConsortiumUserMigration {
+	args := m.Called(centralTenant, consortiumID, consortiumTenants, deleteUsers)
+	return args.Get(0).([]models.ConsortiumUserMigration)
+}
+
 // ==================== Constructor Tests ====================
 
 func TestNew(t *testing.T) {
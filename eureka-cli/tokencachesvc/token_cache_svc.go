@@ -0,0 +1,220 @@
+package tokencachesvc
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/constant"
+	"github.com/folio-org/eureka-setup/eureka-cli/helpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+)
+
+// TokenCacheProcessor defines the interface for token cache operations
+type TokenCacheProcessor interface {
+	TokenCacheReader
+	TokenCacheRefresher
+	TokenCacheManager
+}
+
+// TokenCacheReader defines the interface for reading tokens through the cache
+type TokenCacheReader interface {
+	GetAccessToken(key models.TokenCacheKey, fetchFn func() (string, error)) (string, error)
+	GetRootToken(key models.TokenCacheKey, fetchFn func() (string, error)) (string, error)
+}
+
+// TokenCacheRefresher defines the interface for replacing an access token rejected by the server
+type TokenCacheRefresher interface {
+	RefreshAccessToken(staleToken string) (string, error)
+}
+
+// TokenCacheManager defines the interface for clearing the token cache
+type TokenCacheManager interface {
+	Clear() (int, error)
+	GetTokenCacheFilePath() (string, error)
+}
+
+// TokenCacheSvc provides functionality for sharing the Vault root token and the Keycloak access tokens
+// across CLI invocations through a file in the home config directory that only the user can read
+type TokenCacheSvc struct {
+	Action    *action.Action
+	mu        sync.Mutex
+	fetchFns  map[string]func() (string, error)
+	tokenKeys map[string]models.TokenCacheKey
+	tokens    map[string]string
+}
+
+// New creates a new TokenCacheSvc instance
+func New(action *action.Action) *TokenCacheSvc {
+	return &TokenCacheSvc{
+		Action:    action,
+		fetchFns:  map[string]func() (string, error){},
+		tokenKeys: map[string]models.TokenCacheKey{},
+		tokens:    map[string]string{},
+	}
+}
+
+// GetAccessToken returns the cached JWT access token of the key, a token that expires within the expiry
+// margin is refreshed proactively with the fetch function so that it does not expire while it is used
+func (ts *TokenCacheSvc) GetAccessToken(key models.TokenCacheKey, fetchFn func() (string, error)) (string, error) {
+	return ts.getToken(key, fetchFn, true)
+}
+
+// GetRootToken returns the cached token of the key, the token has no expiry so the key
+// must change when the token is revoked, e.g. by containing the id of the issuing container
+func (ts *TokenCacheSvc) GetRootToken(key models.TokenCacheKey, fetchFn func() (string, error)) (string, error) {
+	return ts.getToken(key, fetchFn, false)
+}
+
+// RefreshAccessToken replaces an access token served by this cache that the server rejected, e.g. because Keycloak
+// was redeployed before the token expired, the cached entry is dropped and a new token is fetched with the fetch function
+// of its key, a token that was already replaced returns its replacement and a token unknown to the cache returns blank
+func (ts *TokenCacheSvc) RefreshAccessToken(staleToken string) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	key, ok := ts.tokenKeys[staleToken]
+	if !ok {
+		return "", nil
+	}
+	if token := ts.tokens[key.String()]; token != staleToken {
+		return token, nil
+	}
+
+	slog.Info(ts.Action.Name, "text", "Refreshing rejected access token", "key", key.String())
+	cache := ts.readCache()
+	if _, ok := cache.Entries[key.String()]; ok {
+		delete(cache.Entries, key.String())
+		if err := ts.writeCache(cache); err != nil {
+			slog.Warn(ts.Action.Name, "text", "Failed to write token cache", "error", err)
+		}
+	}
+
+	return ts.getTokenLocked(key, ts.fetchFns[key.String()], true)
+}
+
+func (ts *TokenCacheSvc) getToken(key models.TokenCacheKey, fetchFn func() (string, error), expires bool) (string, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	return ts.getTokenLocked(key, fetchFn, expires)
+}
+
+func (ts *TokenCacheSvc) getTokenLocked(key models.TokenCacheKey, fetchFn func() (string, error), expires bool) (string, error) {
+	cache := ts.readCache()
+	if entry, ok := cache.Entries[key.String()]; ok && entry.Token != "" && (!expires || time.Until(entry.ExpiresAt) > constant.TokenCacheExpiryMargin) {
+		slog.Debug(ts.Action.Name, "text", "Using cached token", "key", key.String(), "expiresAt", entry.ExpiresAt)
+		ts.rememberToken(key, entry.Token, fetchFn, expires)
+		return entry.Token, nil
+	}
+
+	token, err := fetchFn()
+	if err != nil || token == "" {
+		return token, err
+	}
+	ts.rememberToken(key, token, fetchFn, expires)
+	entry := models.TokenCacheEntry{TokenCacheKey: key, Token: token}
+	if expires {
+		expiresAt, err := helpers.GetAccessTokenExpiry(token)
+		if err != nil {
+			slog.Debug(ts.Action.Name, "text", "Not caching token without expiry", "key", key.String(), "error", err)
+			return token, nil
+		}
+		entry.ExpiresAt = expiresAt
+	}
+	if ts.Action.IsDryRun() {
+		return token, nil
+	}
+
+	cache.Entries[key.String()] = entry
+	if err := ts.writeCache(cache); err != nil {
+		slog.Warn(ts.Action.Name, "text", "Failed to write token cache", "error", err)
+	}
+
+	return token, nil
+}
+
+// rememberToken keeps the key and the fetch function of an access token served during this invocation so that it can be refreshed
+func (ts *TokenCacheSvc) rememberToken(key models.TokenCacheKey, token string, fetchFn func() (string, error), expires bool) {
+	if !expires {
+		return
+	}
+	ts.fetchFns[key.String()] = fetchFn
+	ts.tokenKeys[token] = key
+	ts.tokens[key.String()] = token
+}
+
+// Clear removes the token cache file and returns the number of tokens it held
+func (ts *TokenCacheSvc) Clear() (int, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	filePath, err := ts.GetTokenCacheFilePath()
+	if err != nil {
+		return 0, err
+	}
+	count := len(ts.readCache().Entries)
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (ts *TokenCacheSvc) GetTokenCacheFilePath() (string, error) {
+	return helpers.GetHomeTokenCacheFilePath()
+}
+
+// readCache reads the token cache, a missing or unreadable cache is treated as empty so that the tokens are fetched again
+func (ts *TokenCacheSvc) readCache() *models.TokenCache {
+	cache := &models.TokenCache{}
+	filePath, err := ts.GetTokenCacheFilePath()
+	if err == nil {
+		err = helpers.ReadJSONFromFile(filePath, cache)
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		slog.Warn(ts.Action.Name, "text", "Ignoring unreadable token cache", "error", err)
+		cache = &models.TokenCache{}
+	}
+	if cache.Entries == nil {
+		cache.Entries = map[string]models.TokenCacheEntry{}
+	}
+
+	return cache
+}
+
+// writeCache replaces the token cache file with a file that only the user can read and write,
+// the file is renamed into place so that a concurrent invocation never reads a partial cache
+func (ts *TokenCacheSvc) writeCache(cache *models.TokenCache) error {
+	filePath, err := ts.GetTokenCacheFilePath()
+	if err != nil {
+		return err
+	}
+	tempFile, err := os.CreateTemp(filepath.Dir(filePath), constant.TokenCacheFile+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tempFile.Name())
+	}()
+
+	if err := tempFile.Chmod(0600); err != nil {
+		helpers.CloseFile(tempFile)
+		return err
+	}
+	if err := json.NewEncoder(tempFile).Encode(cache); err != nil {
+		helpers.CloseFile(tempFile)
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), filePath)
+}
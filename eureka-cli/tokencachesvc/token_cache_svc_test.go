@@ -0,0 +1,274 @@
+package tokencachesvc_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	"github.com/folio-org/eureka-setup/eureka-cli/models"
+	"github.com/folio-org/eureka-setup/eureka-cli/tokencachesvc"
+	"github.com/stretchr/testify/assert"
+)
+
+var masterKey = models.TokenCacheKey{Profile: "combined", Realm: "master", Client: "folio-backend-admin-client", GrantType: "client_credentials"}
+
+func newTestTokenCacheSvc(t *testing.T) *tokencachesvc.TokenCacheSvc {
+	t.Setenv("HOME", t.TempDir())
+	return tokencachesvc.New(&action.Action{Name: "test-action", Param: &action.Param{}})
+}
+
+func newTestAccessToken(expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, `{"exp":%d}`, expiresAt.Unix()))
+	return "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"
+}
+
+// countingFetch returns a fetch function that returns the token and counts its calls
+func countingFetch(token string, calls *int) func() (string, error) {
+	return func() (string, error) {
+		*calls++
+		return token, nil
+	}
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	act := &action.Action{Name: "test-action"}
+
+	// Act
+	result := tokencachesvc.New(act)
+
+	// Assert
+	assert.NotNil(t, result)
+	assert.Equal(t, act, result.Action)
+}
+
+func TestGetAccessToken(t *testing.T) {
+	t.Run("TestGetAccessToken_CachedAcrossInstances", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		token := newTestAccessToken(time.Now().Add(5 * time.Minute))
+		calls := 0
+
+		// Act
+		first, errFirst := svc.GetAccessToken(masterKey, countingFetch(token, &calls))
+		second, errSecond := tokencachesvc.New(svc.Action).GetAccessToken(masterKey, countingFetch("other-token", &calls))
+
+		// Assert
+		assert.NoError(t, errFirst)
+		assert.NoError(t, errSecond)
+		assert.Equal(t, token, first)
+		assert.Equal(t, token, second)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("TestGetAccessToken_RefreshesTokenAboutToExpire", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		expiringToken := newTestAccessToken(time.Now().Add(10 * time.Second))
+		freshToken := newTestAccessToken(time.Now().Add(5 * time.Minute))
+		calls := 0
+		_, err := svc.GetAccessToken(masterKey, countingFetch(expiringToken, &calls))
+		assert.NoError(t, err)
+
+		// Act
+		result, err := svc.GetAccessToken(masterKey, countingFetch(freshToken, &calls))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, freshToken, result)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("TestGetAccessToken_KeysAreSeparate", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		tenantKey := models.TokenCacheKey{Profile: "combined", Realm: "diku", Client: "sidecar-module-access-client", GrantType: "password"}
+		masterToken := newTestAccessToken(time.Now().Add(5 * time.Minute))
+		tenantToken := newTestAccessToken(time.Now().Add(6 * time.Minute))
+		calls := 0
+
+		// Act
+		_, _ = svc.GetAccessToken(masterKey, countingFetch(masterToken, &calls))
+		result, err := svc.GetAccessToken(tenantKey, countingFetch(tenantToken, &calls))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, tenantToken, result)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("TestGetAccessToken_TokenWithoutExpiryIsNotCached", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		calls := 0
+
+		// Act
+		_, _ = svc.GetAccessToken(masterKey, countingFetch("opaque-token", &calls))
+		result, err := svc.GetAccessToken(masterKey, countingFetch("opaque-token", &calls))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "opaque-token", result)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("TestGetAccessToken_FetchError", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+
+		// Act
+		result, err := svc.GetAccessToken(masterKey, func() (string, error) { return "", assert.AnError })
+
+		// Assert
+		assert.Equal(t, assert.AnError, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("TestGetAccessToken_DryRunDoesNotWrite", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		svc.Action.Param.DryRun = true
+		token := newTestAccessToken(time.Now().Add(5 * time.Minute))
+		calls := 0
+
+		// Act
+		_, _ = svc.GetAccessToken(masterKey, countingFetch(token, &calls))
+		_, err := svc.GetAccessToken(masterKey, countingFetch(token, &calls))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		filePath, _ := svc.GetTokenCacheFilePath()
+		assert.NoFileExists(t, filePath)
+	})
+
+	t.Run("TestGetAccessToken_CorruptedCacheIsIgnored", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		filePath, err := svc.GetTokenCacheFilePath()
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(filePath, []byte("{not-json"), 0600))
+		token := newTestAccessToken(time.Now().Add(5 * time.Minute))
+		calls := 0
+
+		// Act
+		result, err := svc.GetAccessToken(masterKey, countingFetch(token, &calls))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, token, result)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestGetRootToken(t *testing.T) {
+	t.Run("TestGetRootToken_CachedWithoutExpiry", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		vaultKey := models.TokenCacheKey{Profile: "combined", Realm: "vault", Client: "container-1", GrantType: "root"}
+		calls := 0
+
+		// Act
+		_, _ = svc.GetRootToken(vaultKey, countingFetch("hvs.root-token", &calls))
+		result, err := svc.GetRootToken(vaultKey, countingFetch("hvs.other-token", &calls))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "hvs.root-token", result)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("TestGetRootToken_FileIsPrivate", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		vaultKey := models.TokenCacheKey{Profile: "combined", Realm: "vault", Client: "container-1", GrantType: "root"}
+
+		// Act
+		_, err := svc.GetRootToken(vaultKey, func() (string, error) { return "hvs.root-token", nil })
+
+		// Assert
+		assert.NoError(t, err)
+		filePath, _ := svc.GetTokenCacheFilePath()
+		info, err := os.Stat(filePath)
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+}
+
+func TestRefreshAccessToken(t *testing.T) {
+	t.Run("TestRefreshAccessToken_ReplacesRejectedToken", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		staleToken := newTestAccessToken(time.Now().Add(5 * time.Minute))
+		freshToken := newTestAccessToken(time.Now().Add(10 * time.Minute))
+		tokens := []string{staleToken, freshToken}
+		calls := 0
+		_, err := svc.GetAccessToken(masterKey, func() (string, error) {
+			calls++
+			return tokens[calls-1], nil
+		})
+		assert.NoError(t, err)
+
+		// Act
+		refreshed, errRefresh := svc.RefreshAccessToken(staleToken)
+		repeated, errRepeated := svc.RefreshAccessToken(staleToken)
+		cached, errCached := tokencachesvc.New(svc.Action).GetAccessToken(masterKey, countingFetch("other-token", &calls))
+
+		// Assert
+		assert.NoError(t, errRefresh)
+		assert.NoError(t, errRepeated)
+		assert.NoError(t, errCached)
+		assert.Equal(t, freshToken, refreshed)
+		assert.Equal(t, freshToken, repeated)
+		assert.Equal(t, freshToken, cached)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("TestRefreshAccessToken_UnknownToken", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+
+		// Act
+		result, err := svc.RefreshAccessToken(newTestAccessToken(time.Now().Add(5 * time.Minute)))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	})
+}
+
+func TestClear(t *testing.T) {
+	t.Run("TestClear_RemovesCache", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+		calls := 0
+		_, _ = svc.GetAccessToken(masterKey, countingFetch(newTestAccessToken(time.Now().Add(5*time.Minute)), &calls))
+		_, _ = svc.GetRootToken(models.TokenCacheKey{Realm: "vault"}, countingFetch("hvs.root-token", &calls))
+
+		// Act
+		count, err := svc.Clear()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		filePath, _ := svc.GetTokenCacheFilePath()
+		assert.NoFileExists(t, filePath)
+		_, _ = svc.GetAccessToken(masterKey, countingFetch(newTestAccessToken(time.Now().Add(5*time.Minute)), &calls))
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("TestClear_NoCache", func(t *testing.T) {
+		// Arrange
+		svc := newTestTokenCacheSvc(t)
+
+		// Act
+		count, err := svc.Clear()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
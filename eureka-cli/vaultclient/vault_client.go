@@ -7,13 +7,20 @@ import (
 	"github.com/folio-org/eureka-setup/eureka-cli/constant"
 	"github.com/folio-org/eureka-setup/eureka-cli/httpclient"
 	"github.com/hashicorp/vault-client-go"
+	"github.com/hashicorp/vault-client-go/schema"
 )
 
+// secretMountPath is the mount path of the KV v2 secrets engine holding the FOLIO secrets
+const secretMountPath = "secret"
+
 // TODO Add testcontainers tests
 // VaultClientRunner defines the interface for Vault client operations
 type VaultClientRunner interface {
 	Create() (*vault.Client, error)
 	GetSecretKey(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) (map[string]any, error)
+	ListSecrets(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) ([]string, error)
+	PutSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string, data map[string]any) error
+	DeleteSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) error
 }
 
 // VaultClient provides functionality for interacting with HashiCorp Vault
@@ -46,10 +53,58 @@ func (vc *VaultClient) GetSecretKey(ctx context.Context, client *vault.Client, v
 	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutVaultClient)
 	defer cancel()
 
-	secret, err := client.Secrets.KvV2Read(ctx, secretPath, vault.WithMountPath("secret"))
+	secret, err := client.Secrets.KvV2Read(ctx, secretPath, vault.WithMountPath(secretMountPath))
 	if err != nil {
 		return nil, err
 	}
 
 	return secret.Data.Data, nil
 }
+
+// ListSecrets returns the names of the secrets and folders under a path, folder names end with a slash
+func (vc *VaultClient) ListSecrets(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) ([]string, error) {
+	err := client.SetToken(vaultRootToken)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutVaultClient)
+	defer cancel()
+
+	secrets, err := client.Secrets.KvV2List(ctx, secretPath, vault.WithMountPath(secretMountPath))
+	if err != nil {
+		return nil, err
+	}
+
+	return secrets.Data.Keys, nil
+}
+
+// PutSecret writes a new version of a secret replacing all of its keys
+func (vc *VaultClient) PutSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string, data map[string]any) error {
+	err := client.SetToken(vaultRootToken)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutVaultClient)
+	defer cancel()
+
+	_, err = client.Secrets.KvV2Write(ctx, secretPath, schema.KvV2WriteRequest{Data: data}, vault.WithMountPath(secretMountPath))
+
+	return err
+}
+
+// DeleteSecret permanently deletes all versions and the metadata of a secret
+func (vc *VaultClient) DeleteSecret(ctx context.Context, client *vault.Client, vaultRootToken string, secretPath string) error {
+	err := client.SetToken(vaultRootToken)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, constant.ContextTimeoutVaultClient)
+	defer cancel()
+
+	_, err = client.Secrets.KvV2DeleteMetadataAndAllVersions(ctx, secretPath, vault.WithMountPath(secretMountPath))
+
+	return err
+}
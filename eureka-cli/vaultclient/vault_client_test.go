@@ -1,10 +1,17 @@
 package vaultclient
 
 import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/hashicorp/vault-client-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNew(t *testing.T) {
@@ -50,3 +57,98 @@ func TestCreate_ReturnsValidClient(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, client, "Client should not be nil")
 }
+
+// newTestVaultServer starts a Vault API stub recording the method, path, token and body of the last request
+func newTestVaultServer(t *testing.T, status int, response string, request *http.Request, body *[]byte) *vault.Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*request = *r
+		*body, _ = io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := vault.New(vault.WithAddress(server.URL))
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestListSecrets(t *testing.T) {
+	t.Run("TestListSecrets_Success", func(t *testing.T) {
+		// Arrange
+		var (
+			request http.Request
+			body    []byte
+		)
+		client := newTestVaultServer(t, http.StatusOK, `{"data":{"keys":["diku","consortium/"]}}`, &request, &body)
+		vc := New(testhelpers.NewMockAction(), new(testhelpers.MockHTTPClient))
+
+		// Act
+		names, err := vc.ListSecrets(context.Background(), client, "root-token", "folio")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"diku", "consortium/"}, names)
+		assert.Equal(t, "/v1/secret/metadata/folio/", request.URL.Path)
+		assert.Equal(t, "true", request.URL.Query().Get("list"))
+		assert.Equal(t, "root-token", request.Header.Get("X-Vault-Token"))
+	})
+
+	t.Run("TestListSecrets_NotFound", func(t *testing.T) {
+		// Arrange
+		var (
+			request http.Request
+			body    []byte
+		)
+		client := newTestVaultServer(t, http.StatusNotFound, `{"errors":[]}`, &request, &body)
+		vc := New(testhelpers.NewMockAction(), new(testhelpers.MockHTTPClient))
+
+		// Act
+		names, err := vc.ListSecrets(context.Background(), client, "root-token", "missing")
+
+		// Assert
+		assert.True(t, vault.IsErrorStatus(err, http.StatusNotFound))
+		assert.Nil(t, names)
+	})
+}
+
+func TestPutSecret(t *testing.T) {
+	// Arrange
+	var (
+		request http.Request
+		body    []byte
+	)
+	client := newTestVaultServer(t, http.StatusOK, `{"data":{"version":2}}`, &request, &body)
+	vc := New(testhelpers.NewMockAction(), new(testhelpers.MockHTTPClient))
+
+	// Act
+	err := vc.PutSecret(context.Background(), client, "root-token", "folio/diku", map[string]any{"diku_admin": "admin"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPost, request.Method)
+	assert.Equal(t, "/v1/secret/data/folio/diku", request.URL.Path)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, map[string]any{"diku_admin": "admin"}, payload["data"])
+}
+
+func TestDeleteSecret(t *testing.T) {
+	// Arrange
+	var (
+		request http.Request
+		body    []byte
+	)
+	client := newTestVaultServer(t, http.StatusNoContent, "", &request, &body)
+	vc := New(testhelpers.NewMockAction(), new(testhelpers.MockHTTPClient))
+
+	// Act
+	err := vc.DeleteSecret(context.Background(), client, "root-token", "folio/diku")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodDelete, request.Method)
+	assert.Equal(t, "/v1/secret/metadata/folio/diku", request.URL.Path)
+}
@@ -0,0 +1,161 @@
+package vaultsvc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/action"
+	appErrors "github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/vaultclient"
+	"github.com/hashicorp/vault-client-go"
+)
+
+// VaultProcessor defines the interface for Vault secret operations
+type VaultProcessor interface {
+	ListSecrets(secretPath string) ([]string, error)
+	GetSecret(secretPath string) (map[string]any, error)
+	SetSecretKeys(secretPath string, values map[string]any) error
+	DeleteSecretKey(secretPath string, key string) error
+	DeleteSecret(secretPath string) error
+}
+
+// VaultSvc provides functionality for managing the secrets of the KV v2 secrets engine with the Vault root token
+type VaultSvc struct {
+	Action      *action.Action
+	VaultClient vaultclient.VaultClientRunner
+}
+
+// New creates a new VaultSvc instance
+func New(action *action.Action, vaultClient vaultclient.VaultClientRunner) *VaultSvc {
+	return &VaultSvc{Action: action, VaultClient: vaultClient}
+}
+
+// ListSecrets returns the sorted names of the secrets and folders under a path, e.g. the tenants under folio
+func (vs *VaultSvc) ListSecrets(secretPath string) ([]string, error) {
+	client, err := vs.VaultClient.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	secretPath = normalizeSecretPath(secretPath)
+	names, err := vs.VaultClient.ListSecrets(context.Background(), client, vs.Action.VaultRootToken, secretPath)
+	if err != nil {
+		return nil, handleSecretError(secretPath, err)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// GetSecret returns the keys and values of the latest version of a secret, e.g. folio/diku
+func (vs *VaultSvc) GetSecret(secretPath string) (map[string]any, error) {
+	client, err := vs.VaultClient.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	secretPath = normalizeSecretPath(secretPath)
+	secret, err := vs.VaultClient.GetSecretKey(context.Background(), client, vs.Action.VaultRootToken, secretPath)
+	if err != nil {
+		return nil, handleSecretError(secretPath, err)
+	}
+
+	return secret, nil
+}
+
+// SetSecretKeys adds or replaces keys of a secret while keeping its other keys, the secret is created when it does not exist
+func (vs *VaultSvc) SetSecretKeys(secretPath string, values map[string]any) error {
+	secretPath = normalizeSecretPath(secretPath)
+	secret, err := vs.GetSecret(secretPath)
+	if err != nil && !errors.Is(err, appErrors.ErrNotFound) {
+		return err
+	}
+	if secret == nil {
+		secret = make(map[string]any)
+	}
+	maps.Copy(secret, values)
+	keys := slices.Sorted(maps.Keys(values))
+	if vs.Action.IsDryRun() {
+		slog.Info(vs.Action.Name, "text", "Skipping writing of secret in dry run mode", "path", secretPath, "keys", keys)
+		return nil
+	}
+
+	if err := vs.putSecret(secretPath, secret); err != nil {
+		return err
+	}
+	slog.Info(vs.Action.Name, "text", "Set secret keys", "path", secretPath, "keys", keys)
+
+	return nil
+}
+
+// DeleteSecretKey removes a key from a secret while keeping its other keys
+func (vs *VaultSvc) DeleteSecretKey(secretPath string, key string) error {
+	secretPath = normalizeSecretPath(secretPath)
+	secret, err := vs.GetSecret(secretPath)
+	if err != nil {
+		return err
+	}
+	if _, ok := secret[key]; !ok {
+		return appErrors.VaultSecretKeyNotFound(secretPath, key)
+	}
+	delete(secret, key)
+	if vs.Action.IsDryRun() {
+		slog.Info(vs.Action.Name, "text", "Skipping deleting of secret key in dry run mode", "path", secretPath, "key", key)
+		return nil
+	}
+
+	if err := vs.putSecret(secretPath, secret); err != nil {
+		return err
+	}
+	slog.Info(vs.Action.Name, "text", "Deleted secret key", "path", secretPath, "key", key)
+
+	return nil
+}
+
+// DeleteSecret permanently deletes all versions of a secret
+func (vs *VaultSvc) DeleteSecret(secretPath string) error {
+	secretPath = normalizeSecretPath(secretPath)
+	if vs.Action.IsDryRun() {
+		slog.Info(vs.Action.Name, "text", "Skipping deleting of secret in dry run mode", "path", secretPath)
+		return nil
+	}
+
+	client, err := vs.VaultClient.Create()
+	if err != nil {
+		return err
+	}
+	if err := vs.VaultClient.DeleteSecret(context.Background(), client, vs.Action.VaultRootToken, secretPath); err != nil {
+		return handleSecretError(secretPath, err)
+	}
+	slog.Info(vs.Action.Name, "text", "Deleted secret", "path", secretPath)
+
+	return nil
+}
+
+func (vs *VaultSvc) putSecret(secretPath string, secret map[string]any) error {
+	client, err := vs.VaultClient.Create()
+	if err != nil {
+		return err
+	}
+
+	return vs.VaultClient.PutSecret(context.Background(), client, vs.Action.VaultRootToken, secretPath, secret)
+}
+
+// normalizeSecretPath removes the leading and trailing slashes of a path, e.g. /folio/diku/ becomes folio/diku
+func normalizeSecretPath(secretPath string) string {
+	return strings.Trim(secretPath, "/")
+}
+
+// handleSecretError reports a missing secret or folder as not found, any other error is returned as is
+func handleSecretError(secretPath string, err error) error {
+	if vault.IsErrorStatus(err, http.StatusNotFound) {
+		return appErrors.VaultSecretNotFound(secretPath)
+	}
+
+	return err
+}
@@ -0,0 +1,200 @@
+package vaultsvc_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/folio-org/eureka-setup/eureka-cli/errors"
+	"github.com/folio-org/eureka-setup/eureka-cli/internal/testhelpers"
+	"github.com/folio-org/eureka-setup/eureka-cli/vaultsvc"
+	"github.com/hashicorp/vault-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestVaultSvc(dryRun bool) (*vaultsvc.VaultSvc, *testhelpers.MockVaultClient, *vault.Client) {
+	action := testhelpers.NewMockAction()
+	action.Param.DryRun = dryRun
+	action.VaultRootToken = "root-token"
+	mockVault := &testhelpers.MockVaultClient{}
+	client := &vault.Client{}
+	mockVault.On("Create").Return(client, nil)
+
+	return vaultsvc.New(action, mockVault), mockVault, client
+}
+
+func TestNew(t *testing.T) {
+	// Arrange
+	action := testhelpers.NewMockAction()
+	mockVault := &testhelpers.MockVaultClient{}
+
+	// Act
+	svc := vaultsvc.New(action, mockVault)
+
+	// Assert
+	assert.NotNil(t, svc)
+	assert.Equal(t, action, svc.Action)
+	assert.Equal(t, mockVault, svc.VaultClient)
+}
+
+func TestListSecrets(t *testing.T) {
+	t.Run("TestListSecrets_Success", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, client := newTestVaultSvc(false)
+		mockVault.On("ListSecrets", mock.Anything, client, "root-token", "folio").Return([]string{"diku", "consortium/", "central"}, nil)
+
+		// Act
+		names, err := svc.ListSecrets("/folio/")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"central", "consortium/", "diku"}, names)
+		mockVault.AssertExpectations(t)
+	})
+
+	t.Run("TestListSecrets_NotFound", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, _ := newTestVaultSvc(false)
+		mockVault.On("ListSecrets", mock.Anything, mock.Anything, mock.Anything, "missing").
+			Return(nil, &vault.ResponseError{StatusCode: http.StatusNotFound})
+
+		// Act
+		names, err := svc.ListSecrets("missing")
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		assert.Nil(t, names)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	t.Run("TestGetSecret_Success", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, client := newTestVaultSvc(false)
+		mockVault.On("GetSecretKey", mock.Anything, client, "root-token", "folio/diku").Return(map[string]any{"diku_admin": "admin"}, nil)
+
+		// Act
+		secret, err := svc.GetSecret("folio/diku")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"diku_admin": "admin"}, secret)
+	})
+
+	t.Run("TestGetSecret_Error", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, _ := newTestVaultSvc(false)
+		expectedErr := errors.New("permission denied")
+		mockVault.On("GetSecretKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, expectedErr)
+
+		// Act
+		secret, err := svc.GetSecret("folio/diku")
+
+		// Assert
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, secret)
+	})
+}
+
+func TestSetSecretKeys(t *testing.T) {
+	t.Run("TestSetSecretKeys_MergesKeys", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, client := newTestVaultSvc(false)
+		mockVault.On("GetSecretKey", mock.Anything, client, "root-token", "folio/diku").
+			Return(map[string]any{"diku_admin": "admin", "mod-users": "old"}, nil)
+		mockVault.On("PutSecret", mock.Anything, client, "root-token", "folio/diku",
+			map[string]any{"diku_admin": "admin", "mod-users": "new"}).Return(nil)
+
+		// Act
+		err := svc.SetSecretKeys("folio/diku", map[string]any{"mod-users": "new"})
+
+		// Assert
+		assert.NoError(t, err)
+		mockVault.AssertExpectations(t)
+	})
+
+	t.Run("TestSetSecretKeys_CreatesMissingSecret", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, _ := newTestVaultSvc(false)
+		mockVault.On("GetSecretKey", mock.Anything, mock.Anything, mock.Anything, "folio/diku").
+			Return(nil, &vault.ResponseError{StatusCode: http.StatusNotFound})
+		mockVault.On("PutSecret", mock.Anything, mock.Anything, mock.Anything, "folio/diku", map[string]any{"mod-users": "new"}).Return(nil)
+
+		// Act
+		err := svc.SetSecretKeys("folio/diku", map[string]any{"mod-users": "new"})
+
+		// Assert
+		assert.NoError(t, err)
+		mockVault.AssertExpectations(t)
+	})
+
+	t.Run("TestSetSecretKeys_DryRun", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, _ := newTestVaultSvc(true)
+		mockVault.On("GetSecretKey", mock.Anything, mock.Anything, mock.Anything, "folio/diku").Return(map[string]any{}, nil)
+
+		// Act
+		err := svc.SetSecretKeys("folio/diku", map[string]any{"mod-users": "new"})
+
+		// Assert
+		assert.NoError(t, err)
+		mockVault.AssertNotCalled(t, "PutSecret", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteSecretKey(t *testing.T) {
+	t.Run("TestDeleteSecretKey_Success", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, _ := newTestVaultSvc(false)
+		mockVault.On("GetSecretKey", mock.Anything, mock.Anything, mock.Anything, "folio/diku").
+			Return(map[string]any{"diku_admin": "admin", "mod-users": "old"}, nil)
+		mockVault.On("PutSecret", mock.Anything, mock.Anything, mock.Anything, "folio/diku", map[string]any{"diku_admin": "admin"}).Return(nil)
+
+		// Act
+		err := svc.DeleteSecretKey("folio/diku", "mod-users")
+
+		// Assert
+		assert.NoError(t, err)
+		mockVault.AssertExpectations(t)
+	})
+
+	t.Run("TestDeleteSecretKey_KeyNotFound", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, _ := newTestVaultSvc(false)
+		mockVault.On("GetSecretKey", mock.Anything, mock.Anything, mock.Anything, "folio/diku").Return(map[string]any{"diku_admin": "admin"}, nil)
+
+		// Act
+		err := svc.DeleteSecretKey("folio/diku", "mod-users")
+
+		// Assert
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+		mockVault.AssertNotCalled(t, "PutSecret", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeleteSecret(t *testing.T) {
+	t.Run("TestDeleteSecret_Success", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, client := newTestVaultSvc(false)
+		mockVault.On("DeleteSecret", mock.Anything, client, "root-token", "folio/diku").Return(nil)
+
+		// Act
+		err := svc.DeleteSecret("folio/diku/")
+
+		// Assert
+		assert.NoError(t, err)
+		mockVault.AssertExpectations(t)
+	})
+
+	t.Run("TestDeleteSecret_DryRun", func(t *testing.T) {
+		// Arrange
+		svc, mockVault, _ := newTestVaultSvc(true)
+
+		// Act
+		err := svc.DeleteSecret("folio/diku")
+
+		// Assert
+		assert.NoError(t, err)
+		mockVault.AssertNotCalled(t, "DeleteSecret", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}